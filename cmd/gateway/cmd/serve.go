@@ -3,7 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,22 +12,48 @@ import (
 	"syscall"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 
 	"github.com/alphauslabs/jennah/cmd/gateway/service"
 	jennahv1connect "github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/auth"
 	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/egress"
 	"github.com/alphauslabs/jennah/internal/hashing"
+	"github.com/alphauslabs/jennah/internal/logging"
+	"github.com/alphauslabs/jennah/internal/metrics"
+	"github.com/alphauslabs/jennah/internal/policy"
+	"github.com/alphauslabs/jennah/internal/tracing"
+	"github.com/alphauslabs/jennah/internal/usage"
 )
 
 var (
-	port            string
-	workerIPs       string
-	gcpProject      string
-	spannerInstance string
-	spannerDatabase string
+	port              string
+	workerIPs         string
+	gcpProject        string
+	spannerInstance   string
+	spannerDatabase   string
+	adminEmails       string
+	defaultQuota      database.TenantQuota
+	oidcIssuers       []string
+	oidcAudiences     []string
+	trustProxyHeaders bool
+	workerSigningKey  string
+	tenantCacheSize   int
+	tenantCacheTTL    time.Duration
+	traceExporter     string
+	traceSampleRatio  float64
+	logFormat         string
+	logLevel          string
+	priceTablePath    string
+	policyPath        string
+	allowedWebhooks   []string
 )
 
+// defaultWorkerPort is the port of workers listed in --worker-ips without one.
+const defaultWorkerPort = "8081"
+
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the gateway server",
@@ -36,55 +63,192 @@ var serveCmd = &cobra.Command{
 
 func init() {
 	serveCmd.Flags().StringVar(&port, "port", "8080", "Port to listen on")
-	serveCmd.Flags().StringVar(&workerIPs, "worker-ips", "10.146.0.26", "Comma-separated list of worker IPs")
-	serveCmd.Flags().StringVar(&gcpProject, "gcp-project", "labs-169405", "GCP Project ID")
-	serveCmd.Flags().StringVar(&spannerInstance, "spanner-instance", "alphaus-dev", "Cloud Spanner instance")
+	serveCmd.Flags().StringVar(&workerIPs, "worker-ips", "", "Comma-separated list of worker addresses, as host or host:port; the port defaults to "+defaultWorkerPort+" (required)")
+	serveCmd.Flags().StringVar(&gcpProject, "gcp-project", "", "GCP Project ID (required)")
+	serveCmd.Flags().StringVar(&spannerInstance, "spanner-instance", "", "Cloud Spanner instance (required)")
 	serveCmd.Flags().StringVar(&spannerDatabase, "spanner-database", "main", "Cloud Spanner database")
+	serveCmd.Flags().StringVar(&workerSigningKey, "worker-signing-key", "", "Path to the PEM Ed25519 private key used to sign requests to workers (required)")
+	serveCmd.Flags().IntVar(&tenantCacheSize, "tenant-cache-size", 10000, "Max cached OAuth identity to tenant mappings")
+	serveCmd.Flags().DurationVar(&tenantCacheTTL, "tenant-cache-ttl", 5*time.Minute, "How long a cached identity to tenant mapping is trusted")
+	serveCmd.Flags().StringVar(&adminEmails, "admin-emails", "", "Comma-separated list of emails allowed to use the AdminService")
+	serveCmd.Flags().Int64Var(&defaultQuota.MaxConcurrentJobs, "default-max-concurrent-jobs", 0, "Default max active jobs per tenant (0 = unlimited)")
+	serveCmd.Flags().Int64Var(&defaultQuota.MaxSubmissionsPerMinute, "default-max-submissions-per-minute", 60, "Default max job submissions per minute per tenant (0 = unlimited)")
+	serveCmd.Flags().Int64Var(&defaultQuota.MaxCpuMilli, "default-max-cpu-milli", 0, "Default max vCPU in flight per tenant, in milli-cores (0 = unlimited)")
+	serveCmd.Flags().Int64Var(&defaultQuota.MaxMemoryMib, "default-max-memory-mib", 0, "Default max memory in flight per tenant, in MiB (0 = unlimited)")
+	serveCmd.Flags().Int64Var(&defaultQuota.MaxTaskCount, "default-max-task-count", 0, "Default max tasks per job (0 = unlimited)")
+	serveCmd.Flags().StringSliceVar(&oidcIssuers, "oidc-issuer", nil, "Trusted OIDC issuer as provider=issuer-url (repeatable), e.g. google=https://accounts.google.com")
+	serveCmd.Flags().StringSliceVar(&oidcAudiences, "oidc-audience", nil, "Accepted token audiences (repeatable), e.g. the OAuth client ID")
+	serveCmd.Flags().StringVar(&priceTablePath, "price-table", "", "Path to a YAML price table used to estimate job costs in GetUsage (see docs/usage.md)")
+	serveCmd.Flags().StringVar(&policyPath, "policy-file", "", "Path to a YAML job policy applied to every tenant's submissions (see docs/job-policy.md)")
+	serveCmd.Flags().StringSliceVar(&allowedWebhooks, "allowed-webhook-hosts", nil, "Internal hosts, IP addresses or CIDRs notification webhooks may point at anyway (repeatable), e.g. a local test server; only for testing, and the workers need the same setting")
+	serveCmd.Flags().StringVar(&traceExporter, "trace-exporter", tracing.ExporterNone, "Trace exporter: none, otlp (endpoint from OTEL_EXPORTER_OTLP_ENDPOINT) or stdout")
+	serveCmd.Flags().Float64Var(&traceSampleRatio, "trace-sample-ratio", 1, "Fraction of new traces to sample, between 0 and 1")
+	serveCmd.Flags().StringVar(&logFormat, "log-format", logging.FormatText, "Log output format: text or json")
+	serveCmd.Flags().StringVar(&logLevel, "log-level", "info", "Minimum log level: debug, info, warn or error")
+	serveCmd.Flags().BoolVar(&trustProxyHeaders, "trust-proxy-headers", false, "Trust X-OAuth-* headers from oauth2-proxy when no bearer token is sent. Only enable when the gateway is reachable solely through the proxy")
 }
 
 func runServe(cmd *cobra.Command, args []string) error {
-	log.Printf("Starting gateway")
+	if err := logging.Setup(logFormat, logLevel); err != nil {
+		return err
+	}
+	slog.Info("Starting gateway")
 
 	ctx := context.Background()
-	dbClient, err := database.NewClient(ctx, gcpProject, spannerInstance, spannerDatabase)
+
+	if gcpProject == "" {
+		return fmt.Errorf("--gcp-project is required")
+	}
+	if spannerInstance == "" {
+		return fmt.Errorf("--spanner-instance is required")
+	}
+	var workers []string
+	for _, ip := range strings.Split(workerIPs, ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			workers = append(workers, ip)
+		}
+	}
+	if len(workers) == 0 {
+		return fmt.Errorf("--worker-ips is required")
+	}
+	if traceSampleRatio < 0 || traceSampleRatio > 1 {
+		return fmt.Errorf("--trace-sample-ratio must be between 0 and 1")
+	}
+	shutdownTracing, err := tracing.Setup(ctx, "jennah-gateway", traceExporter, traceSampleRatio)
 	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Warn("Failed to flush traces", "error", err)
+		}
+	}()
+	// Trace context from API clients is untrusted: it is linked to, not continued
+	serverTracing, err := tracing.NewInterceptor(false)
+	if err != nil {
+		return err
+	}
+	clientTracing, err := tracing.NewInterceptor(false)
+	if err != nil {
+		return err
+	}
 
+	dbClient, err := database.NewClient(ctx, gcpProject, spannerInstance, spannerDatabase)
+	if err != nil {
 		return fmt.Errorf("failed to initialize database client: %w", err)
 	}
 	defer dbClient.Close()
-	log.Printf("Connected to Cloud Spanner: %s/%s/%s", gcpProject, spannerInstance, spannerDatabase)
+	slog.Info("Connected to Cloud Spanner", "project", gcpProject, "instance", spannerInstance, "database", spannerDatabase)
 
-	workers := strings.Split(workerIPs, ",")
-	for i, ip := range workers {
-		workers[i] = strings.TrimSpace(ip)
+	if workerSigningKey == "" {
+		return fmt.Errorf("--worker-signing-key is required")
+	}
+	workerSigner, err := auth.NewTokenSignerFromFile(workerSigningKey)
+	if err != nil {
+		return fmt.Errorf("failed to load worker signing key: %w", err)
 	}
-	log.Printf("Worker IPs: %v", workers)
+	slog.Info("Loaded worker request signing key", "path", workerSigningKey)
 
 	router := hashing.NewRouter(workers)
-	log.Printf("Initialized consistent hashing router with workers: %v", workers)
+	slog.Info("Initialized consistent hashing router", "workers", workers)
 
 	workerClients := make(map[string]jennahv1connect.DeploymentServiceClient)
 	httpClient := &http.Client{
 		Timeout: 30 * time.Second,
 	}
 	for _, workerIP := range workers {
-		workerURL := fmt.Sprintf("http://%s:8081", workerIP)
-		workerClients[workerIP] = jennahv1connect.NewDeploymentServiceClient(httpClient, workerURL)
-		log.Printf("Created client for worker at %s", workerURL)
+		workerURL := "http://" + workerAddress(workerIP)
+		workerClients[workerIP] = jennahv1connect.NewDeploymentServiceClient(httpClient, workerURL,
+			connect.WithInterceptors(metrics.NewInterceptor(), clientTracing, logging.NewInterceptor()))
+	}
+
+	var verifier *auth.Verifier
+	if len(oidcIssuers) > 0 {
+		issuers := make([]auth.Issuer, 0, len(oidcIssuers))
+		for _, raw := range oidcIssuers {
+			issuer, err := auth.ParseIssuer(raw)
+			if err != nil {
+				return err
+			}
+			issuers = append(issuers, issuer)
+		}
+		verifier, err = auth.NewVerifier(issuers, oidcAudiences)
+		if err != nil {
+			return fmt.Errorf("failed to initialize OIDC verifier: %w", err)
+		}
+		slog.Info("OIDC token verification enabled", "issuers", oidcIssuers)
+	}
+	if verifier == nil && !trustProxyHeaders {
+		return fmt.Errorf("no authentication configured: set --oidc-issuer or --trust-proxy-headers")
+	}
+	if trustProxyHeaders {
+		slog.Warn("Trusting X-OAuth-* proxy headers; the gateway must only be reachable through oauth2-proxy")
+	}
+	authInterceptor := service.NewAuthInterceptor(verifier, dbClient, trustProxyHeaders)
+
+	var admins []string
+	for _, email := range strings.Split(adminEmails, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			admins = append(admins, email)
+		}
+	}
+
+	var priceTable *usage.PriceTable
+	if priceTablePath != "" {
+		if priceTable, err = usage.LoadPriceTable(priceTablePath); err != nil {
+			return err
+		}
+		slog.Info("Loaded price table", "path", priceTablePath, "currency", priceTable.Currency, "prices", len(priceTable.Prices))
 	}
 
-	gatewayService := service.NewGatewayService(router, workerClients, dbClient)
+	var globalPolicy *policy.Policy
+	if policyPath != "" {
+		if globalPolicy, err = policy.Load(policyPath); err != nil {
+			return err
+		}
+		slog.Info("Loaded job policy", "path", policyPath, "allowed_registries", globalPolicy.AllowedRegistries,
+			"require_digest", globalPolicy.RequireDigest, "required_labels", globalPolicy.RequiredLabels)
+	}
 
+	webhookAllowlist, err := egress.ParseAllowlist(allowedWebhooks)
+	if err != nil {
+		return err
+	}
+	if webhookAllowlist != nil {
+		slog.Warn("Notification webhooks may point at internal hosts", "hosts", allowedWebhooks)
+	}
+
+	gatewayService := service.NewGatewayService(router, workerClients, dbClient, workerSigner, verifier, service.Config{
+		AdminEmails:      admins,
+		DefaultQuota:     defaultQuota,
+		TenantCacheSize:  tenantCacheSize,
+		TenantCacheTTL:   tenantCacheTTL,
+		PriceTable:       priceTable,
+		Policy:           globalPolicy,
+		WebhookAllowlist: webhookAllowlist,
+	})
+
+	metricsInterceptor := metrics.NewInterceptor()
+	loggingInterceptor := logging.NewInterceptor()
 	mux := http.NewServeMux()
-	path, handler := jennahv1connect.NewDeploymentServiceHandler(gatewayService)
+	path, handler := jennahv1connect.NewDeploymentServiceHandler(
+		gatewayService,
+		connect.WithInterceptors(metricsInterceptor, serverTracing, loggingInterceptor, authInterceptor, gatewayService.NewTenantAccessInterceptor()),
+	)
 	mux.Handle(path, handler)
-	log.Printf("Registered DeploymentService handler at path: %s", path)
+
+	adminPath, adminHandler := jennahv1connect.NewAdminServiceHandler(
+		service.NewAdminService(gatewayService),
+		connect.WithInterceptors(metricsInterceptor, serverTracing, loggingInterceptor, authInterceptor),
+	)
+	mux.Handle(adminPath, adminHandler)
 
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
-	log.Println("Health check endpoint: /health")
+
+	mux.Handle("/metrics", metrics.Handler())
 
 	addr := fmt.Sprintf("0.0.0.0:%s", port)
 	server := &http.Server{
@@ -99,30 +263,33 @@ func runServe(cmd *cobra.Command, args []string) error {
 	defer stop()
 
 	go func() {
-		log.Printf("Gateway listening on %s", addr)
-		log.Println("Available endpoints:")
-		log.Printf("  • POST %sGetCurrentTenant", path)
-		log.Printf("  • POST %sSubmitJob", path)
-		log.Printf("  • POST %sListJobs", path)
-		log.Printf("  • GET  /health")
-		log.Println("OAuth-enabled - tenantId auto-generated from auth headers")
-		log.Println("Database: Cloud Spanner (persistent tenant storage)")
-		log.Println("")
+		slog.Info("Gateway listening", "addr", addr, "services", []string{path, adminPath}, "endpoints", []string{"GET /health", "GET /metrics"})
 
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start server: %v", err)
+			slog.Error("Failed to start server", "error", err)
+			os.Exit(1)
 		}
 	}()
 
 	<-sigCtx.Done()
-	log.Println("Shutdown signal received, shutting down...")
+	slog.Info("Shutdown signal received, shutting down")
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	if err := server.Shutdown(shutdownCtx); err != nil {
-		log.Printf("Error during server shutdown: %v", err)
+		slog.Error("Error during server shutdown", "error", err)
 	}
 
-	log.Println("Gateway stopped")
+	slog.Info("Gateway stopped")
 	return nil
 }
+
+// workerAddress returns the host:port of a --worker-ips entry, which may leave
+// out the port. Tenants are hashed onto the entries as written, so the workers'
+// own --worker-ips must list them the same way.
+func workerAddress(entry string) string {
+	if _, _, err := net.SplitHostPort(entry); err == nil {
+		return entry
+	}
+	return net.JoinHostPort(strings.Trim(entry, "[]"), defaultWorkerPort)
+}
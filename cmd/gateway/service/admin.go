@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	jennahv1connect "github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// AdminService implements the platform admin API on top of the gateway's state.
+type AdminService struct {
+	jennahv1connect.UnimplementedAdminServiceHandler
+	gateway *GatewayService
+}
+
+func NewAdminService(gateway *GatewayService) *AdminService {
+	return &AdminService{gateway: gateway}
+}
+
+// requireAdmin checks that the authenticated caller is a configured admin. Admins
+// are listed by email, so the email must be verified by the identity provider;
+// otherwise anyone able to sign up with an admin's address would be one.
+func (a *AdminService) requireAdmin(ctx context.Context) (*OAuthUser, error) {
+	oauthUser, err := oauthUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if !oauthUser.EmailVerified || !a.gateway.admins[strings.ToLower(oauthUser.Email)] {
+		slog.WarnContext(ctx, "Admin access denied", "user", oauthUser.Email, "email_verified", oauthUser.EmailVerified)
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("admin access required"))
+	}
+	return oauthUser, nil
+}
+
+func (a *AdminService) GetTenantQuota(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetTenantQuotaRequest],
+) (*connect.Response[jennahv1.GetTenantQuotaResponse], error) {
+	if _, err := a.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.Msg.TenantId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tenantId is required"))
+	}
+
+	quota, isDefault, err := a.gateway.quotas.quotaFor(ctx, req.Msg.TenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get quota", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get quota: %w", err))
+	}
+
+	usage, err := a.gateway.dbClient.GetTenantUsage(ctx, req.Msg.TenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get usage", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get usage: %w", err))
+	}
+
+	return connect.NewResponse(&jennahv1.GetTenantQuotaResponse{
+		Quota: quotaToProto(quota),
+		Usage: &jennahv1.TenantUsage{
+			ActiveJobs: usage.ActiveJobs,
+			CpuMilli:   usage.CpuMilli,
+			MemoryMib:  usage.MemoryMib,
+		},
+		IsDefault: isDefault,
+	}), nil
+}
+
+func (a *AdminService) UpdateTenantQuota(
+	ctx context.Context,
+	req *connect.Request[jennahv1.UpdateTenantQuotaRequest],
+) (*connect.Response[jennahv1.UpdateTenantQuotaResponse], error) {
+	admin, err := a.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.TenantId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tenantId is required"))
+	}
+	q := req.Msg.Quota
+	if q == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("quota is required"))
+	}
+	if q.MaxConcurrentJobs < 0 || q.MaxSubmissionsPerMinute < 0 || q.MaxCpuMilli < 0 || q.MaxMemoryMib < 0 || q.MaxTaskCount < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("quota values must not be negative"))
+	}
+
+	if _, err := a.gateway.dbClient.GetTenant(ctx, req.Msg.TenantId); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tenant %s not found", req.Msg.TenantId))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	quota := &database.TenantQuota{
+		TenantId:                req.Msg.TenantId,
+		MaxConcurrentJobs:       q.MaxConcurrentJobs,
+		MaxSubmissionsPerMinute: q.MaxSubmissionsPerMinute,
+		MaxCpuMilli:             q.MaxCpuMilli,
+		MaxMemoryMib:            q.MaxMemoryMib,
+		MaxTaskCount:            q.MaxTaskCount,
+	}
+	if err := a.gateway.dbClient.UpsertTenantQuota(ctx, quota); err != nil {
+		slog.ErrorContext(ctx, "Failed to update quota", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Quota updated", "tenant_id", req.Msg.TenantId, "admin", admin.Email)
+	return connect.NewResponse(&jennahv1.UpdateTenantQuotaResponse{
+		Quota: quotaToProto(quota),
+	}), nil
+}
+
+func (a *AdminService) GetTenantPolicy(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetTenantPolicyRequest],
+) (*connect.Response[jennahv1.GetTenantPolicyResponse], error) {
+	if _, err := a.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.Msg.TenantId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tenantId is required"))
+	}
+
+	tenantPolicy, err := a.gateway.tenantPolicy(ctx, req.Msg.TenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get policy", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get policy: %w", err))
+	}
+
+	return connect.NewResponse(&jennahv1.GetTenantPolicyResponse{
+		Policy:       policyToProto(tenantPolicy),
+		GlobalPolicy: policyToProto(a.gateway.globalPolicy),
+	}), nil
+}
+
+func (a *AdminService) UpdateTenantPolicy(
+	ctx context.Context,
+	req *connect.Request[jennahv1.UpdateTenantPolicyRequest],
+) (*connect.Response[jennahv1.UpdateTenantPolicyResponse], error) {
+	admin, err := a.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.TenantId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tenantId is required"))
+	}
+	if req.Msg.Policy == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("policy is required"))
+	}
+	tenantPolicy, err := policyFromProto(req.Msg.Policy)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if _, err := a.gateway.dbClient.GetTenant(ctx, req.Msg.TenantId); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tenant %s not found", req.Msg.TenantId))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := a.gateway.dbClient.UpsertTenantPolicy(ctx, policyToDB(req.Msg.TenantId, tenantPolicy)); err != nil {
+		slog.ErrorContext(ctx, "Failed to update policy", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Policy updated", "tenant_id", req.Msg.TenantId, "admin", admin.Email)
+	return connect.NewResponse(&jennahv1.UpdateTenantPolicyResponse{
+		Policy: policyToProto(tenantPolicy),
+	}), nil
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// Reason recorded on a tenant while DeleteTenant is stopping its jobs. If
+// cancellation fails the tenant stays suspended with this reason until retried.
+const deletionSuspendReason = "deletion in progress"
+
+const maxDisplayNameLength = 255
+
+// How long DeleteTenant waits for the workers to send the tenant's queued job
+// events and notifications, including those for the jobs it cancelled, and how
+// often it checks. The wait must leave room, within the server's 15 second
+// write timeout, for cancelling the jobs and deleting the tenant; if the queue
+// has not drained by then the call fails and is retried.
+const (
+	outboxDrainTimeout  = 5 * time.Second
+	outboxDrainInterval = time.Second
+)
+
+func (a *AdminService) ListTenants(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListTenantsRequest],
+) (*connect.Response[jennahv1.ListTenantsResponse], error) {
+	if _, err := a.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tenants, err := a.gateway.dbClient.ListTenants(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list tenants", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	identities, err := a.gateway.dbClient.ListAllTenantIdentities(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list tenant identities", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTenants := make([]*jennahv1.Tenant, 0, len(tenants))
+	for _, tenant := range tenants {
+		protoTenants = append(protoTenants, tenantToProto(tenant, identities[tenant.TenantId]))
+	}
+
+	return connect.NewResponse(&jennahv1.ListTenantsResponse{
+		Tenants: protoTenants,
+	}), nil
+}
+
+func (a *AdminService) GetTenant(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetTenantRequest],
+) (*connect.Response[jennahv1.GetTenantResponse], error) {
+	if _, err := a.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tenant, err := a.getTenantProto(ctx, req.Msg.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&jennahv1.GetTenantResponse{
+		Tenant: tenant,
+	}), nil
+}
+
+func (a *AdminService) SuspendTenant(
+	ctx context.Context,
+	req *connect.Request[jennahv1.SuspendTenantRequest],
+) (*connect.Response[jennahv1.SuspendTenantResponse], error) {
+	admin, err := a.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.getTenant(ctx, req.Msg.TenantId); err != nil {
+		return nil, err
+	}
+
+	if err := a.gateway.dbClient.SuspendTenant(ctx, req.Msg.TenantId, strings.TrimSpace(req.Msg.Reason)); err != nil {
+		slog.ErrorContext(ctx, "Failed to suspend tenant", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	a.gateway.suspended.Set(req.Msg.TenantId, true)
+
+	tenant, err := a.getTenantProto(ctx, req.Msg.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Tenant suspended", "tenant_id", req.Msg.TenantId, "admin", admin.Email, "reason", req.Msg.Reason)
+	return connect.NewResponse(&jennahv1.SuspendTenantResponse{
+		Tenant: tenant,
+	}), nil
+}
+
+func (a *AdminService) ResumeTenant(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ResumeTenantRequest],
+) (*connect.Response[jennahv1.ResumeTenantResponse], error) {
+	admin, err := a.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.getTenant(ctx, req.Msg.TenantId); err != nil {
+		return nil, err
+	}
+
+	if err := a.gateway.dbClient.ResumeTenant(ctx, req.Msg.TenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to resume tenant", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	a.gateway.suspended.Set(req.Msg.TenantId, false)
+
+	tenant, err := a.getTenantProto(ctx, req.Msg.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Tenant resumed", "tenant_id", req.Msg.TenantId, "admin", admin.Email)
+	return connect.NewResponse(&jennahv1.ResumeTenantResponse{
+		Tenant: tenant,
+	}), nil
+}
+
+func (a *AdminService) UpdateTenant(
+	ctx context.Context,
+	req *connect.Request[jennahv1.UpdateTenantRequest],
+) (*connect.Response[jennahv1.UpdateTenantResponse], error) {
+	admin, err := a.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var displayName, contactEmail *string
+	if req.Msg.DisplayName != nil {
+		name := strings.TrimSpace(*req.Msg.DisplayName)
+		if len(name) > maxDisplayNameLength {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("displayName must be at most %d characters", maxDisplayNameLength))
+		}
+		displayName = &name
+	}
+	if req.Msg.ContactEmail != nil {
+		email := strings.ToLower(strings.TrimSpace(*req.Msg.ContactEmail))
+		if email != "" {
+			if _, err := mail.ParseAddress(email); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid contactEmail %q", *req.Msg.ContactEmail))
+			}
+		}
+		contactEmail = &email
+	}
+	if displayName == nil && contactEmail == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("displayName or contactEmail is required"))
+	}
+
+	if _, err := a.getTenant(ctx, req.Msg.TenantId); err != nil {
+		return nil, err
+	}
+	if err := a.gateway.dbClient.UpdateTenantProfile(ctx, req.Msg.TenantId, displayName, contactEmail); err != nil {
+		slog.ErrorContext(ctx, "Failed to update tenant", "tenant_id", req.Msg.TenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	tenant, err := a.getTenantProto(ctx, req.Msg.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Tenant updated", "tenant_id", req.Msg.TenantId, "admin", admin.Email)
+	return connect.NewResponse(&jennahv1.UpdateTenantResponse{
+		Tenant: tenant,
+	}), nil
+}
+
+// DeleteTenant suspends the tenant so no new jobs can be submitted, cancels its
+// live jobs through the tenant's worker, waits for the job events and
+// notifications queued for them to be sent, and only then deletes its data. If
+// any job cannot be cancelled, or the queue does not drain in time, nothing is
+// deleted and the tenant stays suspended so the call can be retried.
+func (a *AdminService) DeleteTenant(
+	ctx context.Context,
+	req *connect.Request[jennahv1.DeleteTenantRequest],
+) (*connect.Response[jennahv1.DeleteTenantResponse], error) {
+	admin, err := a.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenantId := req.Msg.TenantId
+	if _, err := a.getTenant(ctx, tenantId); err != nil {
+		return nil, err
+	}
+
+	if err := a.gateway.dbClient.SuspendTenant(ctx, tenantId, deletionSuspendReason); err != nil {
+		slog.ErrorContext(ctx, "Failed to suspend tenant before deletion", "tenant_id", tenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	a.gateway.suspended.Set(tenantId, true)
+
+	jobs, err := a.gateway.dbClient.ListActiveJobs(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list active jobs", "tenant_id", tenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	cancelled := make([]string, 0, len(jobs))
+	var failed []string
+	for _, job := range jobs {
+		_, err := a.gateway.cancelWorkerJob(ctx, tenantId, job.JobId)
+		switch connect.CodeOf(err) {
+		case connect.CodeFailedPrecondition, connect.CodeNotFound:
+			// Finished or removed since it was listed; nothing left to stop
+		default:
+			if err != nil {
+				failed = append(failed, job.JobId)
+				continue
+			}
+			cancelled = append(cancelled, job.JobId)
+		}
+	}
+	if len(failed) > 0 {
+		slog.WarnContext(ctx, "Tenant deletion aborted", "tenant_id", tenantId, "failed_job_ids", failed)
+		return nil, connect.NewError(connect.CodeUnavailable,
+			fmt.Errorf("failed to cancel %d live jobs (%s); tenant is suspended and was not deleted, retry to continue",
+				len(failed), strings.Join(failed, ", ")))
+	}
+
+	if err := a.waitForTenantOutbox(ctx, tenantId); err != nil {
+		return nil, err
+	}
+
+	if err := a.gateway.dbClient.DeleteTenant(ctx, tenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete tenant", "tenant_id", tenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	a.gateway.invalidateTenant(tenantId)
+	a.gateway.quotas.forget(tenantId)
+
+	slog.InfoContext(ctx, "Tenant deleted", "tenant_id", tenantId, "admin", admin.Email, "cancelled_jobs", len(cancelled))
+	return connect.NewResponse(&jennahv1.DeleteTenantResponse{
+		CancelledJobIds: cancelled,
+	}), nil
+}
+
+// waitForTenantOutbox waits until the tenant has no job events or notification
+// deliveries left to send, which deleting it would drop. Dead-lettered
+// deliveries do not count.
+func (a *AdminService) waitForTenantOutbox(ctx context.Context, tenantId string) error {
+	deadline := time.Now().Add(outboxDrainTimeout)
+	for {
+		events, deliveries, err := a.gateway.dbClient.CountTenantOutbox(ctx, tenantId)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to check tenant outbox", "tenant_id", tenantId, "error", err)
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if events == 0 && deliveries == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			slog.WarnContext(ctx, "Tenant deletion postponed", "tenant_id", tenantId, "job_events", events, "notification_deliveries", deliveries)
+			return connect.NewError(connect.CodeUnavailable,
+				fmt.Errorf("%d job events and %d notifications are still being sent; tenant is suspended and was not deleted, retry to continue",
+					events, deliveries))
+		}
+		select {
+		case <-ctx.Done():
+			return connect.NewError(connect.CodeCanceled, ctx.Err())
+		case <-time.After(outboxDrainInterval):
+		}
+	}
+}
+
+// getTenant reads a tenant, mapping a missing one to CodeNotFound.
+func (a *AdminService) getTenant(ctx context.Context, tenantId string) (*database.Tenant, error) {
+	if tenantId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tenantId is required"))
+	}
+	tenant, err := a.gateway.dbClient.GetTenant(ctx, tenantId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tenant %s not found", tenantId))
+		}
+		slog.ErrorContext(ctx, "Failed to get tenant", "tenant_id", tenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return tenant, nil
+}
+
+// getTenantProto reads a tenant and its linked identities.
+func (a *AdminService) getTenantProto(ctx context.Context, tenantId string) (*jennahv1.Tenant, error) {
+	tenant, err := a.getTenant(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	identities, err := a.gateway.dbClient.ListTenantIdentities(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list tenant identities", "tenant_id", tenantId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return tenantToProto(tenant, identities), nil
+}
+
+func tenantToProto(tenant *database.Tenant, identities []*database.TenantIdentity) *jennahv1.Tenant {
+	protoTenant := &jennahv1.Tenant{
+		TenantId:   tenant.TenantId,
+		UserEmail:  tenant.UserEmail,
+		Suspended:  tenant.SuspendedAt != nil,
+		CreatedAt:  tenant.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  tenant.UpdatedAt.Format(time.RFC3339),
+		Identities: identitiesToProto(identities),
+	}
+	if tenant.DisplayName != nil {
+		protoTenant.DisplayName = *tenant.DisplayName
+	}
+	if tenant.ContactEmail != nil {
+		protoTenant.ContactEmail = *tenant.ContactEmail
+	}
+	if tenant.SuspendedAt != nil {
+		protoTenant.SuspendedAt = tenant.SuspendedAt.Format(time.RFC3339)
+	}
+	if tenant.SuspendReason != nil {
+		protoTenant.SuspendReason = *tenant.SuspendReason
+	}
+	return protoTenant
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/auth"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// API keys can only be managed by interactive users, so a leaked key cannot be
+// used to mint further keys or revoke the tenant's other keys.
+func (s *GatewayService) resolveKeyManager(ctx context.Context) (*OAuthUser, string, error) {
+	user, err := oauthUserFromContext(ctx)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodePermissionDenied, err)
+	}
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return user, tenantId, nil
+}
+
+func (s *GatewayService) CreateApiKey(
+	ctx context.Context,
+	req *connect.Request[jennahv1.CreateApiKeyRequest],
+) (*connect.Response[jennahv1.CreateApiKeyResponse], error) {
+	user, tenantId, err := s.resolveKeyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	if req.Msg.TtlSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("ttlSeconds must not be negative"))
+	}
+
+	plaintext, hash, prefix, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	key := &database.ApiKey{
+		TenantId:  tenantId,
+		KeyId:     uuid.New().String(),
+		Name:      req.Msg.Name,
+		KeyHash:   hash,
+		Prefix:    prefix,
+		CreatedBy: &user.Email,
+		CreatedAt: time.Now(),
+	}
+	if req.Msg.TtlSeconds > 0 {
+		expiresAt := time.Now().Add(time.Duration(req.Msg.TtlSeconds) * time.Second)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := s.dbClient.InsertApiKey(ctx, key); err != nil {
+		slog.ErrorContext(ctx, "Failed to create API key", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "API key created", "key_id", key.KeyId, "name", key.Name, "user", user.Email)
+	return connect.NewResponse(&jennahv1.CreateApiKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Key:    plaintext,
+	}), nil
+}
+
+func (s *GatewayService) ListApiKeys(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListApiKeysRequest],
+) (*connect.Response[jennahv1.ListApiKeysResponse], error) {
+	_, tenantId, err := s.resolveKeyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.dbClient.ListApiKeys(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list API keys", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoKeys := make([]*jennahv1.ApiKey, 0, len(keys))
+	for _, key := range keys {
+		protoKeys = append(protoKeys, apiKeyToProto(key))
+	}
+
+	return connect.NewResponse(&jennahv1.ListApiKeysResponse{
+		ApiKeys: protoKeys,
+	}), nil
+}
+
+func (s *GatewayService) RevokeApiKey(
+	ctx context.Context,
+	req *connect.Request[jennahv1.RevokeApiKeyRequest],
+) (*connect.Response[jennahv1.RevokeApiKeyResponse], error) {
+	user, tenantId, err := s.resolveKeyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.KeyId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("keyId is required"))
+	}
+
+	// Keys are keyed by tenant, so another tenant's key ID is simply not found
+	if _, err := s.dbClient.GetApiKey(ctx, tenantId, req.Msg.KeyId); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("API key %s not found", req.Msg.KeyId))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.dbClient.RevokeApiKey(ctx, tenantId, req.Msg.KeyId); err != nil {
+		slog.ErrorContext(ctx, "Failed to revoke API key", "key_id", req.Msg.KeyId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	key, err := s.dbClient.GetApiKey(ctx, tenantId, req.Msg.KeyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "API key revoked", "key_id", req.Msg.KeyId, "user", user.Email)
+	return connect.NewResponse(&jennahv1.RevokeApiKeyResponse{
+		ApiKey: apiKeyToProto(key),
+	}), nil
+}
+
+func apiKeyToProto(key *database.ApiKey) *jennahv1.ApiKey {
+	protoKey := &jennahv1.ApiKey{
+		KeyId:     key.KeyId,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		CreatedAt: key.CreatedAt.Format(time.RFC3339),
+	}
+	if key.CreatedBy != nil {
+		protoKey.CreatedBy = *key.CreatedBy
+	}
+	if key.ExpiresAt != nil {
+		protoKey.ExpiresAt = key.ExpiresAt.Format(time.RFC3339)
+	}
+	if key.LastUsedAt != nil {
+		protoKey.LastUsedAt = key.LastUsedAt.Format(time.RFC3339)
+	}
+	if key.RevokedAt != nil {
+		protoKey.RevokedAt = key.RevokedAt.Format(time.RFC3339)
+	}
+	return protoKey
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alphauslabs/jennah/internal/auth"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/logging"
+)
+
+// API key last-use timestamps are only rewritten this often, to avoid a Spanner
+// write on every request from busy machine clients.
+const apiKeyTouchInterval = time.Minute
+
+type principalKey struct{}
+
+func withPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// principalFromContext returns the caller authenticated by the auth interceptor.
+func principalFromContext(ctx context.Context) (*Principal, error) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	if !ok || principal == nil {
+		return nil, errors.New("request is not authenticated")
+	}
+	return principal, nil
+}
+
+// oauthUserFromContext returns the interactive user behind the request.
+// Requests authenticated with an API key have no user.
+func oauthUserFromContext(ctx context.Context) (*OAuthUser, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.User == nil {
+		return nil, errors.New("this operation requires an interactive user, not an API key")
+	}
+	return principal.User, nil
+}
+
+// resolveTenant returns the caller and the tenant the request acts on, as resolved
+// by the tenant access interceptor. Errors are connect errors ready to return from a handler.
+func (s *GatewayService) resolveTenant(ctx context.Context) (*Principal, string, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	access, ok := tenantAccessFromContext(ctx)
+	if !ok {
+		return nil, "", connect.NewError(connect.CodeInternal, errors.New("tenant was not resolved for this request"))
+	}
+	return principal, access.TenantId, nil
+}
+
+// personalTenant returns the interactive caller and their personal tenant,
+// creating it on first use.
+func (s *GatewayService) personalTenant(ctx context.Context) (*OAuthUser, string, error) {
+	user, err := oauthUserFromContext(ctx)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodePermissionDenied, err)
+	}
+	tenantId, err := s.personalTenantOf(ctx, user)
+	if err != nil {
+		return nil, "", err
+	}
+	logging.SetTenantID(ctx, tenantId)
+	return user, tenantId, nil
+}
+
+// personalTenantOf returns the user's personal tenant, creating it on first use.
+func (s *GatewayService) personalTenantOf(ctx context.Context, user *OAuthUser) (string, error) {
+	tenantId, err := s.getOrCreateTenant(ctx, user)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get or create tenant", "error", err)
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	err = s.checkTenantActive(ctx, tenantId)
+	if errors.Is(err, errTenantNotFound) {
+		// Deleted by an admin, possibly through another gateway instance;
+		// the user starts over with a fresh tenant as on first login
+		s.invalidateTenant(tenantId)
+		if tenantId, err = s.getOrCreateTenant(ctx, user); err != nil {
+			slog.ErrorContext(ctx, "Failed to get or create tenant", "error", err)
+			return "", connect.NewError(connect.CodeInternal, err)
+		}
+		err = s.checkTenantActive(ctx, tenantId)
+	}
+	if err != nil {
+		return "", err
+	}
+	return tenantId, nil
+}
+
+// NewAuthInterceptor authenticates every request before it reaches a handler.
+// A bearer token is either a Jennah API key, looked up by hash in Spanner, or a
+// JWT verified against the configured OIDC issuers. When trustProxyHeaders is
+// set, requests without a bearer token fall back to the X-OAuth-* headers
+// injected by oauth2-proxy; only enable this when the gateway is reachable
+// solely through the proxy.
+func NewAuthInterceptor(verifier *auth.Verifier, dbClient *database.Client, trustProxyHeaders bool) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			principal, err := authenticate(ctx, req, verifier, dbClient, trustProxyHeaders)
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				// Failed to check the credentials, rather than found them invalid
+				return nil, connectErr
+			}
+			if err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+			return next(withPrincipal(ctx, principal), req)
+		}
+	})
+}
+
+func authenticate(ctx context.Context, req connect.AnyRequest, verifier *auth.Verifier, dbClient *database.Client, trustProxyHeaders bool) (*Principal, error) {
+	if token, ok := bearerToken(req.Header().Get("Authorization")); ok {
+		if auth.IsAPIKey(token) {
+			return authenticateAPIKey(ctx, dbClient, token)
+		}
+		if verifier == nil {
+			return nil, errors.New("bearer tokens are not accepted: no OIDC issuers configured")
+		}
+		identity, err := verifier.Verify(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		return &Principal{User: &OAuthUser{
+			Email:         identity.Email,
+			UserId:        identity.Subject,
+			Provider:      identity.Provider,
+			EmailVerified: identity.EmailVerified,
+		}}, nil
+	}
+
+	if trustProxyHeaders {
+		user, err := extractOAuthUser(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return &Principal{User: user}, nil
+	}
+	return nil, errors.New("missing bearer token")
+}
+
+// authenticateAPIKey looks up an API key. A key that is unknown, revoked or
+// expired is a plain error; a failed lookup is a connect error with
+// CodeUnavailable or CodeInternal, so that clients retry rather than give up.
+func authenticateAPIKey(ctx context.Context, dbClient *database.Client, token string) (*Principal, error) {
+	key, err := dbClient.GetApiKeyByHash(ctx, auth.HashAPIKey(token))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to look up API key", "error", err)
+		code := connect.CodeInternal
+		if c := spanner.ErrCode(err); c == codes.Unavailable || c == codes.DeadlineExceeded {
+			code = connect.CodeUnavailable
+		}
+		return nil, connect.NewError(code, fmt.Errorf("failed to look up API key: %w", err))
+	}
+	if key == nil {
+		return nil, errors.New("invalid API key")
+	}
+	now := time.Now()
+	if key.RevokedAt != nil {
+		return nil, fmt.Errorf("API key %s has been revoked", key.KeyId)
+	}
+	if key.ExpiresAt != nil && now.After(*key.ExpiresAt) {
+		return nil, fmt.Errorf("API key %s has expired", key.KeyId)
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		go func() {
+			touchCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := dbClient.TouchApiKey(touchCtx, key.TenantId, key.KeyId, now); err != nil {
+				slog.WarnContext(touchCtx, "Failed to record use of API key", "key_id", key.KeyId, "error", err)
+			}
+		}()
+	}
+
+	return &Principal{TenantId: key.TenantId, ApiKeyId: key.KeyId}, nil
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// authorizeWorkerRequest attaches a short-lived signed token asserting the tenant
+// on whose behalf the gateway is calling a worker.
+func (s *GatewayService) authorizeWorkerRequest(req connect.AnyRequest, tenantId string) error {
+	token, err := s.workerSigner.Sign(tenantId)
+	if err != nil {
+		return fmt.Errorf("failed to sign worker request: %w", err)
+	}
+	req.Header().Set("Authorization", "Bearer "+token)
+	return nil
+}
@@ -4,42 +4,51 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"connectrpc.com/connect"
 
 	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/labels"
+	"github.com/alphauslabs/jennah/internal/logging"
+	"github.com/alphauslabs/jennah/internal/policy"
 )
 
 func (s *GatewayService) GetCurrentTenant(
 	ctx context.Context,
 	req *connect.Request[jennahv1.GetCurrentTenantRequest],
 ) (*connect.Response[jennahv1.GetCurrentTenantResponse], error) {
-	oauthUser, err := extractOAuthUser(req.Header())
+	principal, tenantId, err := s.resolveTenant(ctx)
 	if err != nil {
-		log.Printf("OAuth extraction failed: %v", err)
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing or invalid OAuth headers"))
-	}
-	tenantId, err := s.getOrCreateTenant(oauthUser)
-	if err != nil {
-		log.Printf("Failed to get or create tenant: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	tenant, err := s.dbClient.GetTenant(ctx, tenantId)
 	if err != nil {
-		log.Printf("Failed to fetch tenant from database: %v", err)
+		slog.ErrorContext(ctx, "Failed to fetch tenant", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch tenant: %w", err))
 	}
 
+	identities, err := s.identitiesOf(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
 	response := connect.NewResponse(&jennahv1.GetCurrentTenantResponse{
-		TenantId:      tenant.TenantId,
-		UserEmail:     tenant.UserEmail,
-		OauthProvider: tenant.OAuthProvider,
-		CreatedAt:     tenant.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		TenantId:   tenant.TenantId,
+		UserEmail:  tenant.UserEmail,
+		CreatedAt:  tenant.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Identities: identities,
 	})
+	if principal.User != nil {
+		response.Msg.OauthProvider = principal.User.Provider
+	}
+	if access, ok := tenantAccessFromContext(ctx); ok {
+		response.Msg.Role = access.Role
+	}
 
-	log.Printf("Retrieved tenant info for user %s: tenantId=%s", oauthUser.Email, tenantId)
 	return response, nil
 }
 
@@ -47,101 +56,350 @@ func (s *GatewayService) SubmitJob(
 	ctx context.Context,
 	req *connect.Request[jennahv1.SubmitJobRequest],
 ) (*connect.Response[jennahv1.SubmitJobResponse], error) {
-	log.Printf("Received job submission")
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	oauthUser, err := extractOAuthUser(req.Header())
+	response, err := s.submitJob(ctx, principal, tenantId, &jennahv1.SubmitJobRequest{
+		ImageUri:          req.Msg.ImageUri,
+		EnvVars:           req.Msg.EnvVars,
+		Resources:         req.Msg.Resources,
+		TaskCount:         req.Msg.TaskCount,
+		AllowedRegions:    req.Msg.AllowedRegions,
+		PreferredRegions:  req.Msg.PreferredRegions,
+		Labels:            req.Msg.Labels,
+		Annotations:       req.Msg.Annotations,
+		ProvisioningModel: req.Msg.ProvisioningModel,
+		MaxRunDuration:    req.Msg.MaxRunDuration,
+		MaxQueueDuration:  req.Msg.MaxQueueDuration,
+		Outputs:           req.Msg.Outputs,
+		DryRun:            req.Msg.DryRun,
+	})
 	if err != nil {
-		log.Printf("OAuth authentication failed: %v", err)
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		return nil, err
 	}
+	return connect.NewResponse(response), nil
+}
 
-	tenantId, err := s.getOrCreateTenant(oauthUser)
+func (s *GatewayService) ValidateJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ValidateJobRequest],
+) (*connect.Response[jennahv1.ValidateJobResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
 	if err != nil {
-		log.Printf("Failed to get or create tenant: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
+	}
+	job := req.Msg.Job
+	if job == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job is required"))
+	}
+
+	response, err := s.submitJob(ctx, principal, tenantId, &jennahv1.SubmitJobRequest{
+		ImageUri:          job.ImageUri,
+		EnvVars:           job.EnvVars,
+		Resources:         job.Resources,
+		TaskCount:         job.TaskCount,
+		AllowedRegions:    job.AllowedRegions,
+		PreferredRegions:  job.PreferredRegions,
+		Labels:            job.Labels,
+		Annotations:       job.Annotations,
+		ProvisioningModel: job.ProvisioningModel,
+		MaxRunDuration:    job.MaxRunDuration,
+		MaxQueueDuration:  job.MaxQueueDuration,
+		Outputs:           job.Outputs,
+		DryRun:            true,
+	})
+	if err != nil {
+		return nil, err
 	}
-	log.Printf("Job submission from user %s (tenantId=%s)", oauthUser.Email, tenantId)
+	return connect.NewResponse(&jennahv1.ValidateJobResponse{Region: response.Region}), nil
+}
 
-	if req.Msg.ImageUri == "" {
-		log.Printf("Error: imageUri is empty")
+// submitJob validates a job, checks it against the job policies and the
+// tenant's quota and forwards it to the tenant's worker, with the quota for the
+// worker to hold. job is otherwise sent to the worker as is, so callers must
+// build it from trusted fields only. Dry runs go through every check, including
+// the worker's, but the worker creates nothing.
+func (s *GatewayService) submitJob(ctx context.Context, principal *Principal, tenantId string, job *jennahv1.SubmitJobRequest) (*jennahv1.SubmitJobResponse, error) {
+	if job.ImageUri == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("imageUri is required"))
 	}
+	if err := labels.Validate(job.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := labels.ValidateAnnotations(job.Annotations); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
-	workerIP := s.router.GetWorkerIP(tenantId)
-	if workerIP == "" {
-		log.Printf("No worker found for tenantId: %s", tenantId)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("no worker found for tenantId"))
+	cpuMilli, memoryMib := int64(database.DefaultCpuMilli), int64(database.DefaultMemoryMib)
+	if res := job.Resources; res != nil {
+		if res.CpuMilli < 0 || res.MemoryMib < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("resources must not be negative"))
+		}
+		if res.CpuMilli > 0 {
+			cpuMilli = res.CpuMilli
+		}
+		if res.MemoryMib > 0 {
+			memoryMib = res.MemoryMib
+		}
+	}
+	taskCount := job.TaskCount
+	if taskCount < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("taskCount must not be negative"))
+	}
+	if taskCount == 0 {
+		taskCount = 1
 	}
-	log.Printf("Selected worker: %s for tenant: %s", workerIP, tenantId)
 
-	workerClient, exists := s.workerClients[workerIP]
-	if !exists {
-		log.Printf("No worker client found for IP: %s", workerIP)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("no worker client found for tenantId"))
+	policyJob := &policy.Job{
+		ImageUri:  job.ImageUri,
+		CpuMilli:  cpuMilli,
+		MemoryMib: memoryMib,
+		TaskCount: taskCount,
+		EnvVars:   job.EnvVars,
+		Outputs:   job.Outputs,
+		Labels:    job.Labels,
+	}
+	if job.MaxRunDuration != nil {
+		policyJob.MaxRunDuration = job.MaxRunDuration.AsDuration()
+	}
+	if err := s.checkPolicy(ctx, tenantId, policyJob); err != nil {
+		return nil, err
 	}
 
-	workerReq := connect.NewRequest(&jennahv1.SubmitJobRequest{
-		ImageUri: req.Msg.ImageUri,
-		EnvVars:  req.Msg.EnvVars,
-	})
-	workerReq.Header().Set("X-Tenant-Id", tenantId)
+	quota, err := s.quotas.checkSubmit(ctx, tenantId, cpuMilli, memoryMib, taskCount, job.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	job.Quota = quotaToProto(quota)
+
+	workerIP, workerClient, err := s.workerFor(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	workerReq := connect.NewRequest(job)
+	if err := s.authorizeWorkerRequest(workerReq, tenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to authorize worker request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
 	response, err := workerClient.SubmitJob(ctx, workerReq)
 	if err != nil {
-		log.Printf("ERROR: Worker %s failed: %v", workerIP, err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("worker failed: %w", err))
+		slog.ErrorContext(ctx, "Worker failed to submit job", "worker", workerIP, "error", err)
+		// Keep the worker's code so callers can tell a bad region or full regions from a failure
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
 	}
 
 	response.Msg.WorkerAssigned = workerIP
-	log.Printf("Job submitted successfully: jobId=%s, worker=%s, status=%s",
-		response.Msg.JobId, workerIP, response.Msg.Status)
+	if job.DryRun {
+		slog.DebugContext(ctx, "Job validated", "worker", workerIP, "region", response.Msg.Region, "user", principal.Name())
+		return response.Msg, nil
+	}
+	logging.SetJobID(ctx, response.Msg.JobId)
+	attrs := []any{"worker", workerIP, "region", response.Msg.Region, "status", response.Msg.Status,
+		"user", principal.Name(), "image_uri", job.ImageUri, logging.EnvVars(job.EnvVars)}
+	if job.TemplateId != "" {
+		attrs = append(attrs, "template_id", job.TemplateId, "template_revision", job.TemplateRevision)
+	}
+	slog.InfoContext(ctx, "Job submitted", attrs...)
 
-	return response, nil
+	return response.Msg, nil
 }
 
 func (s *GatewayService) ListJobs(
 	ctx context.Context,
 	req *connect.Request[jennahv1.ListJobsRequest],
 ) (*connect.Response[jennahv1.ListJobsResponse], error) {
-	log.Printf("Received list jobs request")
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workerIP, workerClient, err := s.workerFor(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := labels.ParseSelector(req.Msg.LabelSelector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	workerReq := connect.NewRequest(&jennahv1.ListJobsRequest{LabelSelector: req.Msg.LabelSelector})
+	if err := s.authorizeWorkerRequest(workerReq, tenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to authorize worker request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response, err := workerClient.ListJobs(ctx, workerReq)
+	if err != nil {
+		slog.ErrorContext(ctx, "Worker failed to list jobs", "worker", workerIP, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
+	}
+
+	return response, nil
+}
+
+func (s *GatewayService) CancelJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.CancelJobRequest],
+) (*connect.Response[jennahv1.CancelJobResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	oauthUser, err := extractOAuthUser(req.Header())
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("jobId is required"))
+	}
+	logging.SetJobID(ctx, req.Msg.JobId)
+
+	response, err := s.cancelWorkerJob(ctx, tenantId, req.Msg.JobId)
 	if err != nil {
-		log.Printf("OAuth authentication failed: %v", err)
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		return nil, err
 	}
 
-	tenantId, err := s.getOrCreateTenant(oauthUser)
+	slog.InfoContext(ctx, "Job cancelled", "user", principal.Name())
+	return response, nil
+}
+
+// cancelWorkerJob asks the worker that owns tenantId to cancel one of its jobs.
+// Worker errors keep their code, so callers can tell a finished job from a failure.
+func (s *GatewayService) cancelWorkerJob(ctx context.Context, tenantId, jobId string) (*connect.Response[jennahv1.CancelJobResponse], error) {
+	workerIP, workerClient, err := s.workerFor(ctx, tenantId)
 	if err != nil {
-		log.Printf("Failed to get or create tenant: %v", err)
+		return nil, err
+	}
+
+	workerReq := connect.NewRequest(&jennahv1.CancelJobRequest{JobId: jobId})
+	if err := s.authorizeWorkerRequest(workerReq, tenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to authorize worker request", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	log.Printf("List jobs request from user %s (tenantId=%s)", oauthUser.Email, tenantId)
 
-	workerIP := s.router.GetWorkerIP(tenantId)
-	if workerIP == "" {
-		log.Printf("No worker found for tenantId: %s", tenantId)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("no worker found"))
+	response, err := workerClient.CancelJob(ctx, workerReq)
+	if err != nil {
+		slog.WarnContext(ctx, "Worker failed to cancel job", "worker", workerIP, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
 	}
-	log.Printf("Selected worker: %s for tenant: %s", workerIP, tenantId)
+	return response, nil
+}
 
-	workerClient, exists := s.workerClients[workerIP]
-	if !exists {
-		log.Printf("No worker client found for IP: %s", workerIP)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("no worker client found"))
+func (s *GatewayService) GetJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobRequest],
+) (*connect.Response[jennahv1.GetJobResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
 	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("jobId is required"))
+	}
+	logging.SetJobID(ctx, req.Msg.JobId)
 
-	workerReq := connect.NewRequest(&jennahv1.ListJobsRequest{})
-	workerReq.Header().Set("X-Tenant-Id", tenantId)
+	workerIP, workerClient, err := s.workerFor(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := workerClient.ListJobs(ctx, workerReq)
+	workerReq := connect.NewRequest(&jennahv1.GetJobRequest{JobId: req.Msg.JobId})
+	if err := s.authorizeWorkerRequest(workerReq, tenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to authorize worker request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response, err := workerClient.GetJob(ctx, workerReq)
+	if err != nil {
+		slog.WarnContext(ctx, "Worker failed to get job", "worker", workerIP, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
+	}
+	return response, nil
+}
+
+func (s *GatewayService) ListJobArtifacts(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListJobArtifactsRequest],
+) (*connect.Response[jennahv1.ListJobArtifactsResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
 	if err != nil {
-		log.Printf("ERROR: Worker %s failed: %v", workerIP, err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("worker failed: %w", err))
+		return nil, err
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("jobId is required"))
 	}
+	logging.SetJobID(ctx, req.Msg.JobId)
 
-	log.Printf("Successfully listed %d jobs for tenant %s from worker %s",
-		len(response.Msg.Jobs), tenantId, workerIP)
+	workerIP, workerClient, err := s.workerFor(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
 
+	workerReq := connect.NewRequest(&jennahv1.ListJobArtifactsRequest{JobId: req.Msg.JobId, Output: req.Msg.Output})
+	if err := s.authorizeWorkerRequest(workerReq, tenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to authorize worker request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response, err := workerClient.ListJobArtifacts(ctx, workerReq)
+	if err != nil {
+		slog.WarnContext(ctx, "Worker failed to list job artifacts", "worker", workerIP, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
+	}
 	return response, nil
 }
+
+func (s *GatewayService) GetJobLogs(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobLogsRequest],
+) (*connect.Response[jennahv1.GetJobLogsResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("jobId is required"))
+	}
+	logging.SetJobID(ctx, req.Msg.JobId)
+
+	workerIP, workerClient, err := s.workerFor(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	workerReq := connect.NewRequest(&jennahv1.GetJobLogsRequest{
+		JobId:     req.Msg.JobId,
+		Since:     req.Msg.Since,
+		PageSize:  req.Msg.PageSize,
+		PageToken: req.Msg.PageToken,
+	})
+	if err := s.authorizeWorkerRequest(workerReq, tenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to authorize worker request", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response, err := workerClient.GetJobLogs(ctx, workerReq)
+	if err != nil {
+		slog.WarnContext(ctx, "Worker failed to read job logs", "worker", workerIP, "error", err)
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("worker failed: %w", err))
+	}
+	return response, nil
+}
+
+// workerFor returns the worker that owns tenantId's jobs and its address.
+func (s *GatewayService) workerFor(ctx context.Context, tenantId string) (string, jennahv1connect.DeploymentServiceClient, error) {
+	workerIP := s.router.GetWorkerIP(tenantId)
+	if workerIP == "" {
+		slog.ErrorContext(ctx, "No worker found for tenant")
+		return "", nil, connect.NewError(connect.CodeInternal, errors.New("no worker found for tenantId"))
+	}
+	slog.DebugContext(ctx, "Selected worker", "worker", workerIP)
+
+	workerClient, exists := s.workerClients[workerIP]
+	if !exists {
+		slog.ErrorContext(ctx, "No worker client found", "worker", workerIP)
+		return "", nil, connect.NewError(connect.CodeInternal, errors.New("no worker client found for tenantId"))
+	}
+	return workerIP, workerClient, nil
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/auth"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// LinkIdentity links a second OAuth identity to the caller's personal tenant.
+// The caller's credentials prove the identity they are signed in with, and the
+// ID token in the request proves the one being linked.
+func (s *GatewayService) LinkIdentity(
+	ctx context.Context,
+	req *connect.Request[jennahv1.LinkIdentityRequest],
+) (*connect.Response[jennahv1.LinkIdentityResponse], error) {
+	user, tenantId, err := s.personalTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := s.proveIdentity(ctx, req.Msg.IdToken)
+	if err != nil {
+		return nil, err
+	}
+
+	previousTenantId, err := s.dbClient.LinkIdentity(ctx, tenantId, &database.TenantIdentity{
+		OAuthProvider: identity.Provider,
+		OAuthUserId:   identity.Subject,
+		Email:         identity.Email,
+	})
+	if errors.Is(err, database.ErrIdentityInUse) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("%s identity %s: %w; delete or move its data first", identity.Provider, identity.Email, err))
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to link identity", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.tenantCache.Delete(identityKey{Provider: identity.Provider, UserId: identity.Subject})
+	if previousTenantId != "" {
+		s.invalidateTenant(previousTenantId)
+		slog.InfoContext(ctx, "Removed empty tenant of linked identity", "removed_tenant_id", previousTenantId, "provider", identity.Provider, "email", identity.Email)
+	}
+
+	identities, err := s.identitiesOf(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Identity linked", "provider", identity.Provider, "email", identity.Email, "user", user.Email)
+	return connect.NewResponse(&jennahv1.LinkIdentityResponse{
+		Identities: identities,
+	}), nil
+}
+
+// UnlinkIdentity removes an identity from the caller's personal tenant. The
+// identity is proven by its ID token and must differ from the caller's own, so
+// the caller keeps access to the tenant.
+func (s *GatewayService) UnlinkIdentity(
+	ctx context.Context,
+	req *connect.Request[jennahv1.UnlinkIdentityRequest],
+) (*connect.Response[jennahv1.UnlinkIdentityResponse], error) {
+	user, tenantId, err := s.personalTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := s.proveIdentity(ctx, req.Msg.IdToken)
+	if err != nil {
+		return nil, err
+	}
+	if identity.Provider == user.Provider && identity.Subject == user.UserId {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("cannot unlink the identity you are signed in with; sign in with another linked identity"))
+	}
+
+	err = s.dbClient.UnlinkIdentity(ctx, tenantId, identity.Provider, identity.Subject)
+	if errors.Is(err, database.ErrIdentityNotLinked) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if errors.Is(err, database.ErrLastIdentity) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to unlink identity", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.tenantCache.Delete(identityKey{Provider: identity.Provider, UserId: identity.Subject})
+
+	identities, err := s.identitiesOf(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Identity unlinked", "provider", identity.Provider, "email", identity.Email, "user", user.Email)
+	return connect.NewResponse(&jennahv1.UnlinkIdentityResponse{
+		Identities: identities,
+	}), nil
+}
+
+// proveIdentity verifies an ID token presented as proof of a second identity.
+func (s *GatewayService) proveIdentity(ctx context.Context, idToken string) (*auth.Identity, error) {
+	if s.verifier == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("identity linking requires OIDC issuers to be configured"))
+	}
+	idToken = strings.TrimSpace(idToken)
+	if idToken == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("idToken is required"))
+	}
+	identity, err := s.verifier.Verify(ctx, idToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("invalid idToken: %w", err))
+	}
+	return identity, nil
+}
+
+func (s *GatewayService) identitiesOf(ctx context.Context, tenantId string) ([]*jennahv1.TenantIdentity, error) {
+	identities, err := s.dbClient.ListTenantIdentities(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list identities", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return identitiesToProto(identities), nil
+}
+
+func identitiesToProto(identities []*database.TenantIdentity) []*jennahv1.TenantIdentity {
+	protoIdentities := make([]*jennahv1.TenantIdentity, 0, len(identities))
+	for _, identity := range identities {
+		protoIdentities = append(protoIdentities, &jennahv1.TenantIdentity{
+			Provider: identity.OAuthProvider,
+			UserId:   identity.OAuthUserId,
+			Email:    identity.Email,
+			LinkedAt: identity.LinkedAt.Format(time.RFC3339),
+		})
+	}
+	return protoIdentities
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// How long an invitation can be accepted for
+const invitationTTL = 7 * 24 * time.Hour
+
+func (s *GatewayService) ListMyTenants(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListMyTenantsRequest],
+) (*connect.Response[jennahv1.ListMyTenantsResponse], error) {
+	user, personal, err := s.personalTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := []*jennahv1.TenantMembership{{
+		TenantId:   personal,
+		OwnerEmail: user.Email,
+		Role:       database.RoleOwner,
+		Personal:   true,
+	}}
+
+	memberships, err := s.dbClient.ListMembershipsByMember(ctx, personal)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list memberships", "user", user.Email, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, m := range memberships {
+		tenant, err := s.dbClient.GetTenant(ctx, m.TenantId)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to fetch tenant", "member_of_tenant_id", m.TenantId, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		tenants = append(tenants, &jennahv1.TenantMembership{
+			TenantId:   m.TenantId,
+			OwnerEmail: tenant.UserEmail,
+			Role:       m.Role,
+		})
+	}
+
+	return connect.NewResponse(&jennahv1.ListMyTenantsResponse{Tenants: tenants}), nil
+}
+
+func (s *GatewayService) ListTenantMembers(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListTenantMembersRequest],
+) (*connect.Response[jennahv1.ListTenantMembersResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.dbClient.GetTenant(ctx, tenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch tenant: %w", err))
+	}
+	members, err := s.dbClient.ListTenantMembers(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list members", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// The user whose personal tenant this is is its implicit owner
+	protoMembers := []*jennahv1.TenantMember{{
+		MemberTenantId: tenant.TenantId,
+		Email:          tenant.UserEmail,
+		Role:           database.RoleOwner,
+		CreatedAt:      tenant.CreatedAt.Format(time.RFC3339),
+	}}
+	for _, m := range members {
+		protoMembers = append(protoMembers, memberToProto(m))
+	}
+
+	return connect.NewResponse(&jennahv1.ListTenantMembersResponse{Members: protoMembers}), nil
+}
+
+func (s *GatewayService) InviteTenantMember(
+	ctx context.Context,
+	req *connect.Request[jennahv1.InviteTenantMemberRequest],
+) (*connect.Response[jennahv1.InviteTenantMemberResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access, _ := tenantAccessFromContext(ctx)
+
+	email := strings.ToLower(strings.TrimSpace(req.Msg.Email))
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid email %q", req.Msg.Email))
+	}
+	if !validRole(req.Msg.Role) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid role %q", req.Msg.Role))
+	}
+	if req.Msg.Role == database.RoleOwner && access.Role != database.RoleOwner {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only owners can invite owners"))
+	}
+
+	inv := &database.TenantInvitation{
+		TenantId:     tenantId,
+		InvitationId: uuid.New().String(),
+		Email:        email,
+		Role:         req.Msg.Role,
+		InvitedBy:    principal.Name(),
+		CreatedAt:    time.Now(),
+		ExpiresAt:    time.Now().Add(invitationTTL),
+	}
+	if err := s.dbClient.InsertInvitation(ctx, inv); err != nil {
+		slog.ErrorContext(ctx, "Failed to create invitation", "email", email, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Member invited", "email", email, "role", inv.Role, "user", principal.Name())
+	return connect.NewResponse(&jennahv1.InviteTenantMemberResponse{
+		Invitation: invitationToProto(inv),
+	}), nil
+}
+
+func (s *GatewayService) ListMyInvitations(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListMyInvitationsRequest],
+) (*connect.Response[jennahv1.ListMyInvitationsResponse], error) {
+	user, err := oauthUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	invitations, err := s.dbClient.ListPendingInvitationsByEmail(ctx, strings.ToLower(user.Email))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list invitations", "user", user.Email, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoInvitations := make([]*jennahv1.TenantInvitation, 0, len(invitations))
+	for _, inv := range invitations {
+		protoInvitations = append(protoInvitations, invitationToProto(inv))
+	}
+
+	return connect.NewResponse(&jennahv1.ListMyInvitationsResponse{Invitations: protoInvitations}), nil
+}
+
+func (s *GatewayService) AcceptInvitation(
+	ctx context.Context,
+	req *connect.Request[jennahv1.AcceptInvitationRequest],
+) (*connect.Response[jennahv1.AcceptInvitationResponse], error) {
+	user, personal, err := s.personalTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.TenantId == "" || req.Msg.InvitationId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tenantId and invitationId are required"))
+	}
+
+	notFound := connect.NewError(connect.CodeNotFound, fmt.Errorf("invitation %s not found", req.Msg.InvitationId))
+	inv, err := s.dbClient.GetInvitation(ctx, req.Msg.TenantId, req.Msg.InvitationId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, notFound
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// Invitations are bound to the invitee's email; don't reveal others' invitations
+	if !strings.EqualFold(inv.Email, user.Email) {
+		return nil, notFound
+	}
+	if inv.TenantId == personal {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("already the owner of this tenant"))
+	}
+
+	member := &database.TenantMember{
+		TenantId:       inv.TenantId,
+		MemberTenantId: personal,
+		Role:           inv.Role,
+		MemberEmail:    user.Email,
+		AddedBy:        &inv.InvitedBy,
+	}
+	if err := s.dbClient.AcceptInvitation(ctx, inv.TenantId, inv.InvitationId, member); err != nil {
+		if errors.Is(err, database.ErrInvitationUnavailable) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		slog.ErrorContext(ctx, "Failed to accept invitation", "invitation_id", inv.InvitationId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	tenant, err := s.dbClient.GetTenant(ctx, inv.TenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch tenant: %w", err))
+	}
+
+	slog.InfoContext(ctx, "Invitation accepted", "user", user.Email, "joined_tenant_id", inv.TenantId, "role", inv.Role)
+	return connect.NewResponse(&jennahv1.AcceptInvitationResponse{
+		Membership: &jennahv1.TenantMembership{
+			TenantId:   inv.TenantId,
+			OwnerEmail: tenant.UserEmail,
+			Role:       inv.Role,
+		},
+	}), nil
+}
+
+func (s *GatewayService) UpdateTenantMemberRole(
+	ctx context.Context,
+	req *connect.Request[jennahv1.UpdateTenantMemberRoleRequest],
+) (*connect.Response[jennahv1.UpdateTenantMemberRoleResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access, _ := tenantAccessFromContext(ctx)
+
+	if !validRole(req.Msg.Role) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid role %q", req.Msg.Role))
+	}
+	if req.Msg.MemberTenantId == tenantId {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the tenant's own user is always an owner"))
+	}
+
+	member, err := s.lookupMember(ctx, tenantId, req.Msg.MemberTenantId)
+	if err != nil {
+		return nil, err
+	}
+	if (member.Role == database.RoleOwner || req.Msg.Role == database.RoleOwner) && access.Role != database.RoleOwner {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only owners can grant or revoke the owner role"))
+	}
+
+	if err := s.dbClient.UpdateTenantMemberRole(ctx, tenantId, member.MemberTenantId, req.Msg.Role); err != nil {
+		slog.ErrorContext(ctx, "Failed to update member role", "member", member.MemberEmail, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	member.Role = req.Msg.Role
+
+	slog.InfoContext(ctx, "Member role changed", "member", member.MemberEmail, "role", member.Role, "user", principal.Name())
+	return connect.NewResponse(&jennahv1.UpdateTenantMemberRoleResponse{
+		Member: memberToProto(member),
+	}), nil
+}
+
+func (s *GatewayService) RemoveTenantMember(
+	ctx context.Context,
+	req *connect.Request[jennahv1.RemoveTenantMemberRequest],
+) (*connect.Response[jennahv1.RemoveTenantMemberResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access, _ := tenantAccessFromContext(ctx)
+
+	if req.Msg.MemberTenantId == tenantId {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the tenant's own user cannot be removed"))
+	}
+
+	member, err := s.lookupMember(ctx, tenantId, req.Msg.MemberTenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anyone may leave; removing someone else needs admin, and owners can only be removed by owners
+	leaving := false
+	if principal.User != nil {
+		personal, err := s.getOrCreateTenant(ctx, principal.User)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		leaving = personal == member.MemberTenantId
+	}
+	if !leaving {
+		if !roleAtLeast(access.Role, database.RoleAdmin) {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("requires role admin to remove other members"))
+		}
+		if member.Role == database.RoleOwner && access.Role != database.RoleOwner {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only owners can remove owners"))
+		}
+	}
+
+	if err := s.dbClient.DeleteTenantMember(ctx, tenantId, member.MemberTenantId); err != nil {
+		slog.ErrorContext(ctx, "Failed to remove member", "member", member.MemberEmail, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Member removed", "member", member.MemberEmail, "user", principal.Name())
+	return connect.NewResponse(&jennahv1.RemoveTenantMemberResponse{}), nil
+}
+
+func (s *GatewayService) lookupMember(ctx context.Context, tenantId, memberTenantId string) (*database.TenantMember, error) {
+	if memberTenantId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("memberTenantId is required"))
+	}
+	member, err := s.dbClient.GetTenantMember(ctx, tenantId, memberTenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if member == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member %s not found", memberTenantId))
+	}
+	return member, nil
+}
+
+func memberToProto(m *database.TenantMember) *jennahv1.TenantMember {
+	protoMember := &jennahv1.TenantMember{
+		MemberTenantId: m.MemberTenantId,
+		Email:          m.MemberEmail,
+		Role:           m.Role,
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+	}
+	if m.AddedBy != nil {
+		protoMember.AddedBy = *m.AddedBy
+	}
+	return protoMember
+}
+
+func invitationToProto(inv *database.TenantInvitation) *jennahv1.TenantInvitation {
+	return &jennahv1.TenantInvitation{
+		InvitationId: inv.InvitationId,
+		TenantId:     inv.TenantId,
+		Email:        inv.Email,
+		Role:         inv.Role,
+		InvitedBy:    inv.InvitedBy,
+		CreatedAt:    inv.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:    inv.ExpiresAt.Format(time.RFC3339),
+	}
+}
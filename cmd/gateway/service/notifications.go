@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/egress"
+)
+
+// notifiableStatuses are the job statuses a subscription may ask for. PENDING
+// is left out on purpose: the only transition to it is the resubmission of a
+// preempted job, which is silent, and the job's next SCHEDULED and RUNNING
+// transitions are notified again.
+var notifiableStatuses = []string{
+	database.JobStatusScheduled,
+	database.JobStatusRunning,
+	database.JobStatusCompleted,
+	database.JobStatusFailed,
+	database.JobStatusCancelled,
+	database.JobStatusTimedOut,
+}
+
+var deliveryStatuses = []string{
+	database.DeliveryStatusPending,
+	database.DeliveryStatusDelivered,
+	database.DeliveryStatusDeadLetter,
+}
+
+const (
+	maxNotificationSubscriptions = 20
+	minNotificationSecretLength  = 16
+	defaultDeliveriesLimit       = 100
+	maxDeliveriesLimit           = 1000
+)
+
+func (s *GatewayService) CreateNotificationSubscription(
+	ctx context.Context,
+	req *connect.Request[jennahv1.CreateNotificationSubscriptionRequest],
+) (*connect.Response[jennahv1.CreateNotificationSubscriptionResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := subscriptionFromRequest(req.Msg, s.webhookAllow)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	existing, err := s.dbClient.ListNotificationSubscriptions(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list notification subscriptions", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(existing) >= maxNotificationSubscriptions {
+		return nil, connect.NewError(connect.CodeResourceExhausted,
+			fmt.Errorf("a tenant can have at most %d notification subscriptions", maxNotificationSubscriptions))
+	}
+
+	if sub.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate secret: %w", err))
+		}
+		sub.Secret = base64.RawURLEncoding.EncodeToString(secret)
+	}
+	createdBy := principal.Name()
+	sub.TenantId = tenantId
+	sub.SubscriptionId = uuid.New().String()
+	sub.CreatedBy = &createdBy
+
+	if err := s.dbClient.InsertNotificationSubscription(ctx, sub); err != nil {
+		slog.ErrorContext(ctx, "Failed to create notification subscription", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Notification subscription created", "subscription_id", sub.SubscriptionId,
+		"url", sub.Url, "statuses", sub.Statuses, "user", principal.Name())
+	return connect.NewResponse(&jennahv1.CreateNotificationSubscriptionResponse{
+		Subscription: subscriptionToProto(sub),
+		Secret:       sub.Secret,
+	}), nil
+}
+
+// subscriptionFromRequest validates a CreateNotificationSubscriptionRequest;
+// the URL may only be internal if allow lists it.
+func subscriptionFromRequest(msg *jennahv1.CreateNotificationSubscriptionRequest, allow *egress.Allowlist) (*database.NotificationSubscription, error) {
+	// The worker also refuses to connect to internal addresses, whatever the name resolves to
+	if err := egress.CheckURL(msg.Url, allow); err != nil {
+		return nil, err
+	}
+	if len(msg.Statuses) == 0 {
+		return nil, fmt.Errorf("statuses is required; one or more of %v", notifiableStatuses)
+	}
+	var statuses []string
+	for _, status := range msg.Statuses {
+		if !slices.Contains(notifiableStatuses, status) {
+			return nil, fmt.Errorf("unknown status %q; must be one of %v", status, notifiableStatuses)
+		}
+		if !slices.Contains(statuses, status) {
+			statuses = append(statuses, status)
+		}
+	}
+
+	format := msg.Format
+	switch format {
+	case "":
+		format = database.NotificationFormatJSON
+	case database.NotificationFormatJSON, database.NotificationFormatSlack:
+	default:
+		return nil, fmt.Errorf("unknown format %q; must be %q or %q", format, database.NotificationFormatJSON, database.NotificationFormatSlack)
+	}
+
+	if msg.Secret != "" && len(msg.Secret) < minNotificationSecretLength {
+		return nil, fmt.Errorf("secret must be at least %d characters", minNotificationSecretLength)
+	}
+
+	return &database.NotificationSubscription{
+		Url:      msg.Url,
+		Secret:   msg.Secret,
+		Statuses: statuses,
+		Format:   format,
+	}, nil
+}
+
+func (s *GatewayService) ListNotificationSubscriptions(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListNotificationSubscriptionsRequest],
+) (*connect.Response[jennahv1.ListNotificationSubscriptionsResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := s.dbClient.ListNotificationSubscriptions(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list notification subscriptions", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoSubs := make([]*jennahv1.NotificationSubscription, 0, len(subs))
+	for _, sub := range subs {
+		protoSubs = append(protoSubs, subscriptionToProto(sub))
+	}
+	return connect.NewResponse(&jennahv1.ListNotificationSubscriptionsResponse{
+		Subscriptions: protoSubs,
+	}), nil
+}
+
+func (s *GatewayService) DeleteNotificationSubscription(
+	ctx context.Context,
+	req *connect.Request[jennahv1.DeleteNotificationSubscriptionRequest],
+) (*connect.Response[jennahv1.DeleteNotificationSubscriptionResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.getSubscription(ctx, tenantId, req.Msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dbClient.DeleteNotificationSubscription(ctx, tenantId, sub.SubscriptionId); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete notification subscription", "subscription_id", sub.SubscriptionId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Notification subscription deleted", "subscription_id", sub.SubscriptionId, "user", principal.Name())
+	return connect.NewResponse(&jennahv1.DeleteNotificationSubscriptionResponse{
+		Subscription: subscriptionToProto(sub),
+	}), nil
+}
+
+func (s *GatewayService) ListNotificationDeliveries(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListNotificationDeliveriesRequest],
+) (*connect.Response[jennahv1.ListNotificationDeliveriesResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Status != "" && !slices.Contains(deliveryStatuses, req.Msg.Status) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("unknown status %q; must be one of %v", req.Msg.Status, deliveryStatuses))
+	}
+	limit := int64(req.Msg.Limit)
+	switch {
+	case limit < 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit must not be negative"))
+	case limit == 0:
+		limit = defaultDeliveriesLimit
+	case limit > maxDeliveriesLimit:
+		limit = maxDeliveriesLimit
+	}
+
+	deliveries, err := s.dbClient.ListNotificationDeliveries(ctx, tenantId, req.Msg.SubscriptionId, req.Msg.JobId, req.Msg.Status, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list notification deliveries", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoDeliveries := make([]*jennahv1.NotificationDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		protoDeliveries = append(protoDeliveries, deliveryToProto(delivery))
+	}
+	return connect.NewResponse(&jennahv1.ListNotificationDeliveriesResponse{
+		Deliveries: protoDeliveries,
+	}), nil
+}
+
+// getSubscription reads one of the tenant's subscriptions, mapping a missing
+// one to CodeNotFound.
+func (s *GatewayService) getSubscription(ctx context.Context, tenantId, subscriptionId string) (*database.NotificationSubscription, error) {
+	if subscriptionId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("subscriptionId is required"))
+	}
+	sub, err := s.dbClient.GetNotificationSubscription(ctx, tenantId, subscriptionId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get notification subscription", "subscription_id", subscriptionId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if sub == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("notification subscription %s not found", subscriptionId))
+	}
+	return sub, nil
+}
+
+func subscriptionToProto(sub *database.NotificationSubscription) *jennahv1.NotificationSubscription {
+	protoSub := &jennahv1.NotificationSubscription{
+		SubscriptionId: sub.SubscriptionId,
+		Url:            sub.Url,
+		Statuses:       sub.Statuses,
+		Format:         sub.Format,
+		CreatedAt:      sub.CreatedAt.Format(time.RFC3339),
+	}
+	if sub.CreatedBy != nil {
+		protoSub.CreatedBy = *sub.CreatedBy
+	}
+	return protoSub
+}
+
+func deliveryToProto(d *database.NotificationDelivery) *jennahv1.NotificationDelivery {
+	protoDelivery := &jennahv1.NotificationDelivery{
+		DeliveryId:     d.DeliveryId,
+		SubscriptionId: d.SubscriptionId,
+		JobId:          d.JobId,
+		FromStatus:     d.FromStatus,
+		ToStatus:       d.ToStatus,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.Reason != nil {
+		protoDelivery.Reason = *d.Reason
+	}
+	if d.LastResponseCode != nil {
+		protoDelivery.LastResponseCode = int32(*d.LastResponseCode)
+	}
+	if d.LastError != nil {
+		protoDelivery.LastError = *d.LastError
+	}
+	if d.LastAttemptAt != nil {
+		protoDelivery.LastAttemptAt = d.LastAttemptAt.Format(time.RFC3339)
+	}
+	if d.DeliveredAt != nil {
+		protoDelivery.DeliveredAt = d.DeliveredAt.Format(time.RFC3339)
+	}
+	if d.NextAttemptAt != nil {
+		protoDelivery.NextAttemptAt = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	return protoDelivery
+}
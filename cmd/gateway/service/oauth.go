@@ -3,12 +3,10 @@ package service
 import (
 	"context"
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 
-	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
 )
 
 func extractOAuthUser(headers http.Header) (*OAuthUser, error) {
@@ -20,77 +18,52 @@ func extractOAuthUser(headers http.Header) (*OAuthUser, error) {
 		return nil, errors.New("missing required OAuth headers")
 	}
 
+	// oauth2-proxy refuses unverified emails unless started with
+	// --insecure-oidc-allow-unverified-email
 	return &OAuthUser{
-		Email:    email,
-		UserId:   oauthUserId,
-		Provider: provider,
+		Email:         email,
+		UserId:        oauthUserId,
+		Provider:      provider,
+		EmailVerified: true,
 	}, nil
 }
 
-func (s *GatewayService) getOrCreateTenant(oauthUser *OAuthUser) (string, error) {
-	ctx := context.Background()
+// identityKey identifies an OAuth user. User IDs are only unique within a
+// provider, so the provider is part of the key.
+type identityKey struct {
+	Provider string
+	UserId   string
+}
 
-	// Check in-memory cache first (fast path)
-	s.mu.RLock()
-	tenantId, exists := s.oauthToTenant[oauthUser.UserId]
-	s.mu.RUnlock()
+func (s *GatewayService) getOrCreateTenant(ctx context.Context, oauthUser *OAuthUser) (string, error) {
+	key := identityKey{Provider: oauthUser.Provider, UserId: oauthUser.UserId}
 
-	if exists {
-		log.Printf("Found existing tenant in cache for user %s: tenantId=%s",
-			oauthUser.Email, tenantId)
+	// Check in-memory cache first (fast path)
+	if tenantId, ok := s.tenantCache.Get(key); ok {
 		return tenantId, nil
 	}
 
-	// Not in cache, check database using OAuth credentials
-	tenant, err := s.dbClient.GetTenantByOAuth(ctx, oauthUser.Provider, oauthUser.UserId)
+	// Look up or atomically create the tenant. Concurrent first logins, on this
+	// or another gateway instance, all resolve to the same persisted tenant.
+	tenant, created, err := s.dbClient.GetOrCreateTenantByOAuth(ctx,
+		oauthUser.Provider, oauthUser.UserId, oauthUser.Email, uuid.New().String())
 	if err != nil {
-		log.Printf("Error querying tenant by OAuth: %v", err)
 		return "", err
 	}
 
-	if tenant != nil {
-		// Found existing tenant in database
-		s.mu.Lock()
-		s.oauthToTenant[oauthUser.UserId] = tenant.TenantId
-		s.mu.Unlock()
-
-		log.Printf("Found existing tenant in database for user %s: tenantId=%s",
-			oauthUser.Email, tenant.TenantId)
-		return tenant.TenantId, nil
-	}
-
-	// Tenant doesn't exist, create new one
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.tenantCache.Set(key, tenant.TenantId)
 
-	// Double-check cache after acquiring write lock
-	if tenantId, exists = s.oauthToTenant[oauthUser.UserId]; exists {
-		log.Printf("Found tenant created by another request: tenantId=%s", tenantId)
-		return tenantId, nil
+	if created {
+		slog.InfoContext(ctx, "Created tenant", "tenant_id", tenant.TenantId, "user", oauthUser.Email, "provider", oauthUser.Provider)
 	}
+	return tenant.TenantId, nil
+}
 
-	// Create new tenant
-	tenantId = uuid.New().String()
-
-	// Insert into database with OAuth info
-	err = s.dbClient.InsertTenant(ctx, tenantId, oauthUser.Email, oauthUser.Provider, oauthUser.UserId)
-	if err != nil {
-		// Check if tenant already exists (race condition)
-		if spanner.ErrCode(err) == codes.AlreadyExists {
-			log.Printf("Tenant %s already exists in database (created by another instance)", tenantId)
-			// Cache it and return
-			s.oauthToTenant[oauthUser.UserId] = tenantId
-			return tenantId, nil
-		}
-		log.Printf("Failed to insert tenant into database: %v", err)
-		return "", err
-	}
-
-	// Cache the OAuth -> Tenant mapping
-	s.oauthToTenant[oauthUser.UserId] = tenantId
-
-	log.Printf("Created new tenant for user %s (provider: %s): tenantId=%s (persisted to database)",
-		oauthUser.Email, oauthUser.Provider, tenantId)
-
-	return tenantId, nil
+// invalidateTenant drops every cached identity mapping to tenantId, so that the
+// next request re-reads it from Spanner.
+func (s *GatewayService) invalidateTenant(tenantId string) {
+	s.tenantCache.DeleteFunc(func(_ identityKey, cached string) bool {
+		return cached == tenantId
+	})
+	s.suspended.Delete(tenantId)
 }
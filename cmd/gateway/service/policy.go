@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/policy"
+)
+
+// Policy scopes, as reported in violations.
+const (
+	policyScopeGlobal = "global"
+	policyScopeTenant = "tenant"
+)
+
+// checkPolicy checks a job against the gateway's global policy, then the
+// tenant's own. It returns a connect error with CodePermissionDenied naming
+// the first rule the job breaks.
+func (s *GatewayService) checkPolicy(ctx context.Context, tenantId string, job *policy.Job) error {
+	tenantPolicy, err := s.tenantPolicy(ctx, tenantId)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load policy: %w", err))
+	}
+
+	for _, scoped := range []struct {
+		scope  string
+		policy *policy.Policy
+	}{{policyScopeGlobal, s.globalPolicy}, {policyScopeTenant, tenantPolicy}} {
+		violation, err := scoped.policy.Check(job)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if violation != nil {
+			slog.WarnContext(ctx, "Job rejected by policy", "scope", scoped.scope, "rule", violation.Rule,
+				"image_uri", job.ImageUri, "reason", violation.Description)
+			return policyViolationError(scoped.scope, violation)
+		}
+	}
+	return nil
+}
+
+// tenantPolicy returns the tenant's own policy, or nil if it has none.
+func (s *GatewayService) tenantPolicy(ctx context.Context, tenantId string) (*policy.Policy, error) {
+	row, err := s.dbClient.GetTenantPolicy(ctx, tenantId)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	return &policy.Policy{
+		AllowedRegistries: row.AllowedRegistries,
+		RequireDigest:     row.RequireDigest,
+		MaxCpuMilli:       row.MaxCpuMilli,
+		MaxMemoryMib:      row.MaxMemoryMib,
+		MaxTaskCount:      row.MaxTaskCount,
+		MaxRunDuration:    time.Duration(row.MaxRunDurationSeconds) * time.Second,
+		ForbiddenOptions:  row.ForbiddenOptions,
+		RequiredLabels:    row.RequiredLabels,
+	}, nil
+}
+
+// policyViolationError builds a CodePermissionDenied error carrying an ErrorInfo
+// detail with the scope and name of the broken rule.
+func policyViolationError(scope string, violation *policy.Violation) *connect.Error {
+	connectErr := connect.NewError(connect.CodePermissionDenied,
+		fmt.Errorf("job violates %s policy rule %s", scope, violation))
+
+	if detail, err := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: "POLICY_VIOLATION",
+		Domain: "jennah",
+		Metadata: map[string]string{
+			"scope": scope,
+			"rule":  violation.Rule,
+		},
+	}); err == nil {
+		connectErr.AddDetail(detail)
+	}
+
+	return connectErr
+}
+
+func policyToProto(p *policy.Policy) *jennahv1.JobPolicy {
+	if p == nil {
+		return &jennahv1.JobPolicy{}
+	}
+	proto := &jennahv1.JobPolicy{
+		AllowedRegistries: p.AllowedRegistries,
+		RequireDigest:     p.RequireDigest,
+		MaxCpuMilli:       p.MaxCpuMilli,
+		MaxMemoryMib:      p.MaxMemoryMib,
+		MaxTaskCount:      p.MaxTaskCount,
+		ForbiddenOptions:  p.ForbiddenOptions,
+		RequiredLabels:    p.RequiredLabels,
+	}
+	if p.MaxRunDuration > 0 {
+		proto.MaxRunDuration = durationpb.New(p.MaxRunDuration)
+	}
+	return proto
+}
+
+// policyFromProto converts and validates a policy sent by an admin.
+func policyFromProto(proto *jennahv1.JobPolicy) (*policy.Policy, error) {
+	p := &policy.Policy{
+		AllowedRegistries: proto.AllowedRegistries,
+		RequireDigest:     proto.RequireDigest,
+		MaxCpuMilli:       proto.MaxCpuMilli,
+		MaxMemoryMib:      proto.MaxMemoryMib,
+		MaxTaskCount:      proto.MaxTaskCount,
+		ForbiddenOptions:  proto.ForbiddenOptions,
+		RequiredLabels:    proto.RequiredLabels,
+	}
+	if d := proto.MaxRunDuration; d != nil {
+		if err := d.CheckValid(); err != nil {
+			return nil, fmt.Errorf("maxRunDuration: %w", err)
+		}
+		if p.MaxRunDuration = d.AsDuration(); p.MaxRunDuration%time.Second != 0 {
+			return nil, fmt.Errorf("maxRunDuration must be whole seconds")
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func policyToDB(tenantId string, p *policy.Policy) *database.TenantPolicy {
+	return &database.TenantPolicy{
+		TenantId:              tenantId,
+		AllowedRegistries:     p.AllowedRegistries,
+		RequireDigest:         p.RequireDigest,
+		MaxCpuMilli:           p.MaxCpuMilli,
+		MaxMemoryMib:          p.MaxMemoryMib,
+		MaxTaskCount:          p.MaxTaskCount,
+		MaxRunDurationSeconds: int64(p.MaxRunDuration / time.Second),
+		ForbiddenOptions:      p.ForbiddenOptions,
+		RequiredLabels:        p.RequiredLabels,
+	}
+}
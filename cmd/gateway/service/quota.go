@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// Suggested wait before retrying a submission rejected by a capacity quota.
+// Capacity frees up as jobs finish, so there is no exact time to report.
+const capacityRetryAfter = 30 * time.Second
+
+// quotaEnforcer checks job submissions against per-tenant quotas.
+// Submission rate is limited with an in-memory token bucket per tenant and
+// gateway instance, so with N gateways behind a load balancer a tenant can
+// submit up to N times its max_submissions_per_minute. Capacity limits are
+// checked against the tenant's active jobs in Spanner, and held by the worker,
+// which checks them again in the transaction that records the job.
+type quotaEnforcer struct {
+	dbClient *database.Client
+	defaults database.TenantQuota
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newQuotaEnforcer(dbClient *database.Client, defaults database.TenantQuota) *quotaEnforcer {
+	return &quotaEnforcer{
+		dbClient: dbClient,
+		defaults: defaults,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// quotaFor returns the tenant's explicit quota, or the gateway defaults if it has none.
+// The boolean result reports whether the defaults were used.
+func (q *quotaEnforcer) quotaFor(ctx context.Context, tenantId string) (*database.TenantQuota, bool, error) {
+	quota, err := q.dbClient.GetTenantQuota(ctx, tenantId)
+	if err != nil {
+		return nil, false, err
+	}
+	if quota == nil {
+		defaults := q.defaults
+		defaults.TenantId = tenantId
+		return &defaults, true, nil
+	}
+	return quota, false, nil
+}
+
+// limiterFor returns the tenant's token bucket, resized if the quota changed.
+func (q *quotaEnforcer) limiterFor(tenantId string, perMinute int64) *rate.Limiter {
+	limit := rate.Limit(float64(perMinute) / 60)
+	burst := int(perMinute)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	limiter, exists := q.limiters[tenantId]
+	if !exists {
+		limiter = rate.NewLimiter(limit, burst)
+		q.limiters[tenantId] = limiter
+		return limiter
+	}
+	if limiter.Limit() != limit || limiter.Burst() != burst {
+		limiter.SetLimit(limit)
+		limiter.SetBurst(burst)
+	}
+	return limiter
+}
+
+// forget drops the rate limiter of a deleted tenant.
+func (q *quotaEnforcer) forget(tenantId string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.limiters, tenantId)
+}
+
+// checkSubmit verifies that a job with the given per-task resources fits within the
+// tenant's quota and consumes one submission token; dry runs only check that a token
+// is available. It returns the quota, which the worker checks capacity against again
+// when it records the job, or a connect error with CodeResourceExhausted when a limit
+// is hit.
+func (q *quotaEnforcer) checkSubmit(ctx context.Context, tenantId string, cpuMilli, memoryMib, taskCount int64, dryRun bool) (*database.TenantQuota, error) {
+	quota, _, err := q.quotaFor(ctx, tenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load quota: %w", err))
+	}
+
+	if quota.MaxTaskCount > 0 && taskCount > quota.MaxTaskCount {
+		return nil, quotaExceededError(tenantId, "max_task_count",
+			fmt.Sprintf("job requests %d tasks, limit is %d", taskCount, quota.MaxTaskCount), 0)
+	}
+
+	// Concurrent submissions can all pass this check; it rejects jobs early,
+	// and the worker's insert is what holds the limits
+	if quota.HasCapacityLimits() {
+		usage, err := q.dbClient.GetTenantUsage(ctx, tenantId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load usage: %w", err))
+		}
+		if exceeded := quota.CheckCapacity(usage, cpuMilli*taskCount, memoryMib*taskCount); exceeded != nil {
+			return nil, quotaExceededError(tenantId, exceeded.Quota, exceeded.Description, capacityRetryAfter)
+		}
+	}
+
+	// Take a submission token last so rejected requests don't use up the rate limit
+	if quota.MaxSubmissionsPerMinute > 0 {
+		reservation := q.limiterFor(tenantId, quota.MaxSubmissionsPerMinute).Reserve()
+		delay := reservation.Delay()
+		if delay > 0 || dryRun {
+			reservation.Cancel()
+		}
+		if delay > 0 {
+			return nil, quotaExceededError(tenantId, "max_submissions_per_minute",
+				fmt.Sprintf("more than %d submissions per minute", quota.MaxSubmissionsPerMinute), delay)
+		}
+	}
+
+	return quota, nil
+}
+
+// quotaExceededError builds a CodeResourceExhausted error carrying QuotaFailure and,
+// when retryAfter is set, RetryInfo details plus a Retry-After header.
+func quotaExceededError(tenantId, quotaName, description string, retryAfter time.Duration) *connect.Error {
+	connectErr := connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("quota %s exceeded: %s", quotaName, description))
+
+	if detail, err := connect.NewErrorDetail(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     "tenant:" + tenantId,
+			Description: quotaName + ": " + description,
+		}},
+	}); err == nil {
+		connectErr.AddDetail(detail)
+	}
+
+	if retryAfter > 0 {
+		if detail, err := connect.NewErrorDetail(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryAfter),
+		}); err == nil {
+			connectErr.AddDetail(detail)
+		}
+		seconds := int64(retryAfter.Round(time.Second) / time.Second)
+		if seconds < 1 {
+			seconds = 1
+		}
+		connectErr.Meta().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	}
+
+	return connectErr
+}
+
+func quotaToProto(quota *database.TenantQuota) *jennahv1.TenantQuota {
+	return &jennahv1.TenantQuota{
+		MaxConcurrentJobs:       quota.MaxConcurrentJobs,
+		MaxSubmissionsPerMinute: quota.MaxSubmissionsPerMinute,
+		MaxCpuMilli:             quota.MaxCpuMilli,
+		MaxMemoryMib:            quota.MaxMemoryMib,
+		MaxTaskCount:            quota.MaxTaskCount,
+	}
+}
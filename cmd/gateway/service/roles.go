@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	jennahv1connect "github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/logging"
+)
+
+// ActiveTenantHeader selects which of the caller's tenants a request acts on.
+// Without it, requests act on the caller's personal tenant.
+const ActiveTenantHeader = "X-Jennah-Tenant"
+
+// roleRank orders roles so that a higher rank includes all lower permissions.
+var roleRank = map[string]int{
+	database.RoleViewer:    1,
+	database.RoleSubmitter: 2,
+	database.RoleAdmin:     3,
+	database.RoleOwner:     4,
+}
+
+// selfService marks procedures that act on the caller rather than on a tenant.
+const selfService = ""
+
+// procedureRoles is the minimum role required for each DeploymentService procedure.
+// Procedures missing from this map are rejected, so new RPCs must be added here.
+var procedureRoles = map[string]string{
+	jennahv1connect.DeploymentServiceGetCurrentTenantProcedure:               database.RoleViewer,
+	jennahv1connect.DeploymentServiceListJobsProcedure:                       database.RoleViewer,
+	jennahv1connect.DeploymentServiceSubmitJobProcedure:                      database.RoleSubmitter,
+	jennahv1connect.DeploymentServiceCreateApiKeyProcedure:                   database.RoleAdmin,
+	jennahv1connect.DeploymentServiceListApiKeysProcedure:                    database.RoleAdmin,
+	jennahv1connect.DeploymentServiceRevokeApiKeyProcedure:                   database.RoleAdmin,
+	jennahv1connect.DeploymentServiceListTenantMembersProcedure:              database.RoleViewer,
+	jennahv1connect.DeploymentServiceInviteTenantMemberProcedure:             database.RoleAdmin,
+	jennahv1connect.DeploymentServiceUpdateTenantMemberRoleProcedure:         database.RoleAdmin,
+	jennahv1connect.DeploymentServiceRemoveTenantMemberProcedure:             database.RoleViewer, // Members may remove themselves; removing others needs admin
+	jennahv1connect.DeploymentServiceListMyTenantsProcedure:                  selfService,
+	jennahv1connect.DeploymentServiceListMyInvitationsProcedure:              selfService,
+	jennahv1connect.DeploymentServiceAcceptInvitationProcedure:               selfService,
+	jennahv1connect.DeploymentServiceCancelJobProcedure:                      database.RoleSubmitter,
+	jennahv1connect.DeploymentServiceLinkIdentityProcedure:                   selfService,
+	jennahv1connect.DeploymentServiceUnlinkIdentityProcedure:                 selfService,
+	jennahv1connect.DeploymentServiceCreateJobTemplateProcedure:              database.RoleSubmitter,
+	jennahv1connect.DeploymentServiceGetJobTemplateProcedure:                 database.RoleViewer,
+	jennahv1connect.DeploymentServiceListJobTemplatesProcedure:               database.RoleViewer,
+	jennahv1connect.DeploymentServiceDeleteJobTemplateProcedure:              database.RoleAdmin,
+	jennahv1connect.DeploymentServiceSubmitJobFromTemplateProcedure:          database.RoleSubmitter,
+	jennahv1connect.DeploymentServiceGetJobProcedure:                         database.RoleViewer,
+	jennahv1connect.DeploymentServiceGetJobLogsProcedure:                     database.RoleViewer,
+	jennahv1connect.DeploymentServiceListJobArtifactsProcedure:               database.RoleViewer,
+	jennahv1connect.DeploymentServiceValidateJobProcedure:                    database.RoleSubmitter,
+	jennahv1connect.DeploymentServiceCreateNotificationSubscriptionProcedure: database.RoleAdmin,
+	jennahv1connect.DeploymentServiceListNotificationSubscriptionsProcedure:  database.RoleAdmin,
+	jennahv1connect.DeploymentServiceDeleteNotificationSubscriptionProcedure: database.RoleAdmin,
+	jennahv1connect.DeploymentServiceListNotificationDeliveriesProcedure:     database.RoleViewer,
+	jennahv1connect.DeploymentServiceGetUsageProcedure:                       database.RoleViewer,
+}
+
+func validRole(role string) bool {
+	_, ok := roleRank[role]
+	return ok
+}
+
+func roleAtLeast(role, required string) bool {
+	return roleRank[role] >= roleRank[required]
+}
+
+// tenantAccess is the tenant a request acts on and the caller's role in it.
+type tenantAccess struct {
+	TenantId string
+	Role     string
+}
+
+type tenantAccessKey struct{}
+
+func tenantAccessFromContext(ctx context.Context) (*tenantAccess, bool) {
+	access, ok := ctx.Value(tenantAccessKey{}).(*tenantAccess)
+	return access, ok && access != nil
+}
+
+// NewTenantAccessInterceptor resolves the active tenant for every DeploymentService
+// request and enforces the caller's role in it. It must run after the auth interceptor.
+func (s *GatewayService) NewTenantAccessInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			required, known := procedureRoles[procedure]
+			if !known {
+				slog.ErrorContext(ctx, "No role mapping for procedure, rejecting", "procedure", procedure)
+				return nil, connect.NewError(connect.CodePermissionDenied, errors.New("procedure is not authorized"))
+			}
+			if required == selfService {
+				return next(ctx, req)
+			}
+
+			principal, err := principalFromContext(ctx)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+			access, err := s.tenantAccessFor(ctx, principal, req.Header().Get(ActiveTenantHeader))
+			if err != nil {
+				return nil, err
+			}
+			logging.SetTenantID(ctx, access.TenantId)
+			if err := s.checkTenantActive(ctx, access.TenantId); err != nil {
+				return nil, err
+			}
+			if !roleAtLeast(access.Role, required) {
+				slog.WarnContext(ctx, "Insufficient role", "user", principal.Name(), "role", access.Role, "required", required)
+				return nil, connect.NewError(connect.CodePermissionDenied,
+					fmt.Errorf("requires role %s in tenant %s", required, access.TenantId))
+			}
+			return next(context.WithValue(ctx, tenantAccessKey{}, access), req)
+		}
+	})
+}
+
+// tenantAccessFor determines the tenant a principal acts on and its role there.
+// API keys act as submitters of the tenant they belong to. Users act as owners of
+// their personal tenant, or with their member role in the tenant they selected.
+func (s *GatewayService) tenantAccessFor(ctx context.Context, principal *Principal, selected string) (*tenantAccess, error) {
+	if principal.TenantId != "" {
+		if selected != "" && selected != principal.TenantId {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("API key is not valid for the selected tenant"))
+		}
+		return &tenantAccess{TenantId: principal.TenantId, Role: database.RoleSubmitter}, nil
+	}
+
+	personal, err := s.personalTenantOf(ctx, principal.User)
+	if err != nil {
+		return nil, err
+	}
+	if selected == "" || selected == personal {
+		return &tenantAccess{TenantId: personal, Role: database.RoleOwner}, nil
+	}
+
+	member, err := s.dbClient.GetTenantMember(ctx, selected, personal)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to look up membership", "user", principal.Name(), "selected_tenant_id", selected, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if member == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a member of tenant %s", selected))
+	}
+	return &tenantAccess{TenantId: selected, Role: member.Role}, nil
+}
+
+var errTenantNotFound = errors.New("tenant not found")
+
+// checkTenantActive rejects requests acting on a suspended or deleted tenant. Suspension
+// state is cached briefly, so a suspension made through another gateway
+// instance takes effect within suspensionCacheTTL.
+func (s *GatewayService) checkTenantActive(ctx context.Context, tenantId string) error {
+	suspended, ok := s.suspended.Get(tenantId)
+	if !ok {
+		tenant, err := s.dbClient.GetTenant(ctx, tenantId)
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("tenant %s: %w", tenantId, errTenantNotFound))
+			}
+			slog.ErrorContext(ctx, "Failed to read tenant", "tenant_id", tenantId, "error", err)
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		suspended = tenant.SuspendedAt != nil
+		s.suspended.Set(tenantId, suspended)
+	}
+	if suspended {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("tenant %s is suspended", tenantId))
+	}
+	return nil
+}
@@ -1,31 +1,69 @@
 package service
 
 import (
-	"sync"
+	"strings"
+	"time"
 
 	jennahv1connect "github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/auth"
+	"github.com/alphauslabs/jennah/internal/cache"
 	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/egress"
 	"github.com/alphauslabs/jennah/internal/hashing"
+	"github.com/alphauslabs/jennah/internal/metrics"
+	"github.com/alphauslabs/jennah/internal/policy"
+	"github.com/alphauslabs/jennah/internal/usage"
 )
 
+// Suspension state is re-read from Spanner this often, bounding how long a
+// suspension made through another gateway instance takes to apply here.
+const suspensionCacheTTL = 30 * time.Second
+
 type GatewayService struct {
 	jennahv1connect.UnimplementedDeploymentServiceHandler
 	router        *hashing.Router
 	workerClients map[string]jennahv1connect.DeploymentServiceClient
 	dbClient      *database.Client
-	mu            sync.RWMutex
-	oauthToTenant map[string]string
+	workerSigner  *auth.TokenSigner
+	verifier      *auth.Verifier
+	tenantCache   *cache.TTL[identityKey, string]
+	suspended     *cache.TTL[string, bool]
+	quotas        *quotaEnforcer
+	admins        map[string]bool
+	priceTable    *usage.PriceTable
+	globalPolicy  *policy.Policy
+	webhookAllow  *egress.Allowlist
 }
 
 func NewGatewayService(
 	router *hashing.Router,
 	workerClients map[string]jennahv1connect.DeploymentServiceClient,
 	dbClient *database.Client,
+	workerSigner *auth.TokenSigner,
+	verifier *auth.Verifier,
+	cfg Config,
 ) *GatewayService {
+	admins := make(map[string]bool, len(cfg.AdminEmails))
+	for _, email := range cfg.AdminEmails {
+		admins[strings.ToLower(email)] = true
+	}
+	tenantCache := cache.NewTTL[identityKey, string](cfg.TenantCacheSize, cfg.TenantCacheTTL)
+	suspended := cache.NewTTL[string, bool](cfg.TenantCacheSize, suspensionCacheTTL)
+	metrics.RegisterCache("tenant_identity", tenantCache.Stats)
+	metrics.RegisterCache("tenant_suspension", suspended.Stats)
+
 	return &GatewayService{
 		router:        router,
 		workerClients: workerClients,
 		dbClient:      dbClient,
-		oauthToTenant: make(map[string]string),
+		workerSigner:  workerSigner,
+		verifier:      verifier,
+		tenantCache:   tenantCache,
+		suspended:     suspended,
+		quotas:        newQuotaEnforcer(dbClient, cfg.DefaultQuota),
+		admins:        admins,
+		priceTable:    cfg.PriceTable,
+		globalPolicy:  cfg.Policy,
+		webhookAllow:  cfg.WebhookAllowlist,
 	}
 }
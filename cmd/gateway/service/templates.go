@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+var (
+	// Template names follow the GCP resource name rules, so they can be used in labels
+	templateNamePattern = regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	paramNamePattern    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// paramRefPattern matches ${name} references in a template's image URI and env var values
+	paramRefPattern = regexp.MustCompile(`\$\{([^}]*)\}`)
+)
+
+func (s *GatewayService) CreateJobTemplate(
+	ctx context.Context,
+	req *connect.Request[jennahv1.CreateJobTemplateRequest],
+) (*connect.Response[jennahv1.CreateJobTemplateResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !templateNamePattern.MatchString(req.Msg.Name) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("name must be 1-63 lowercase letters, digits or hyphens, starting with a letter"))
+	}
+	rev, err := templateRevisionFromRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	createdBy := principal.Name()
+	rev.CreatedBy = &createdBy
+
+	template, err := s.dbClient.CreateJobTemplateRevision(ctx, tenantId, req.Msg.Name, req.Msg.Description, rev)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			// Two first revisions of the same name raced on JobTemplatesByName
+			return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("job template %q was created concurrently, retry", req.Msg.Name))
+		}
+		slog.ErrorContext(ctx, "Failed to create job template", "name", req.Msg.Name, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Job template revision created", "template_id", template.TemplateId,
+		"name", template.Name, "revision", rev.Revision, "user", principal.Name())
+	return connect.NewResponse(&jennahv1.CreateJobTemplateResponse{
+		Template: templateToProto(template),
+		Revision: templateRevisionToProto(rev),
+	}), nil
+}
+
+func (s *GatewayService) GetJobTemplate(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobTemplateRequest],
+) (*connect.Response[jennahv1.GetJobTemplateResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, rev, err := s.getTemplateRevision(ctx, tenantId, req.Msg.Name, req.Msg.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&jennahv1.GetJobTemplateResponse{
+		Template: templateToProto(template),
+		Revision: templateRevisionToProto(rev),
+	}), nil
+}
+
+func (s *GatewayService) ListJobTemplates(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListJobTemplatesRequest],
+) (*connect.Response[jennahv1.ListJobTemplatesResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.dbClient.ListJobTemplates(ctx, tenantId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job templates", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTemplates := make([]*jennahv1.JobTemplate, 0, len(templates))
+	for _, template := range templates {
+		protoTemplates = append(protoTemplates, templateToProto(template))
+	}
+
+	return connect.NewResponse(&jennahv1.ListJobTemplatesResponse{
+		Templates: protoTemplates,
+	}), nil
+}
+
+func (s *GatewayService) DeleteJobTemplate(
+	ctx context.Context,
+	req *connect.Request[jennahv1.DeleteJobTemplateRequest],
+) (*connect.Response[jennahv1.DeleteJobTemplateResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.getTemplate(ctx, tenantId, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dbClient.DeleteJobTemplate(ctx, tenantId, template.TemplateId); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job template", "template_id", template.TemplateId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "Job template deleted", "template_id", template.TemplateId, "name", template.Name, "user", principal.Name())
+	return connect.NewResponse(&jennahv1.DeleteJobTemplateResponse{
+		Template: templateToProto(template),
+	}), nil
+}
+
+// SubmitJobFromTemplate renders a template revision with the given parameters
+// and submits the result like SubmitJob, recording the revision on the job.
+func (s *GatewayService) SubmitJobFromTemplate(
+	ctx context.Context,
+	req *connect.Request[jennahv1.SubmitJobFromTemplateRequest],
+) (*connect.Response[jennahv1.SubmitJobFromTemplateResponse], error) {
+	principal, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, rev, err := s.getTemplateRevision(ctx, tenantId, req.Msg.Name, req.Msg.Revision)
+	if err != nil {
+		return nil, err
+	}
+	job, err := renderTemplate(rev, req.Msg.Parameters)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	job.TemplateId = template.TemplateId
+	job.TemplateRevision = rev.Revision
+	job.AllowedRegions = req.Msg.AllowedRegions
+	job.PreferredRegions = req.Msg.PreferredRegions
+	job.Labels = req.Msg.Labels
+	job.Annotations = req.Msg.Annotations
+	job.ProvisioningModel = req.Msg.ProvisioningModel
+	job.MaxRunDuration = req.Msg.MaxRunDuration
+	job.MaxQueueDuration = req.Msg.MaxQueueDuration
+	job.Outputs = req.Msg.Outputs
+	job.DryRun = req.Msg.DryRun
+
+	response, err := s.submitJob(ctx, principal, tenantId, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&jennahv1.SubmitJobFromTemplateResponse{
+		JobId:            response.JobId,
+		Status:           response.Status,
+		WorkerAssigned:   response.WorkerAssigned,
+		Region:           response.Region,
+		TemplateId:       template.TemplateId,
+		TemplateRevision: rev.Revision,
+		BatchJob:         response.BatchJob,
+		ImageDigest:      response.ImageDigest,
+	}), nil
+}
+
+// getTemplate reads a template by name, mapping a missing one to CodeNotFound.
+func (s *GatewayService) getTemplate(ctx context.Context, tenantId, name string) (*database.JobTemplate, error) {
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	template, err := s.dbClient.GetJobTemplateByName(ctx, tenantId, name)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get job template", "name", name, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if template == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job template %q not found", name))
+	}
+	return template, nil
+}
+
+// getTemplateRevision reads a template and one of its revisions; revision 0 is the latest.
+func (s *GatewayService) getTemplateRevision(ctx context.Context, tenantId, name string, revision int64) (*database.JobTemplate, *database.JobTemplateRevision, error) {
+	if revision < 0 {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("revision must not be negative"))
+	}
+	template, err := s.getTemplate(ctx, tenantId, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if revision == 0 {
+		revision = template.LatestRevision
+	}
+	if revision > template.LatestRevision {
+		return nil, nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("job template %q has no revision %d; the latest is %d", name, revision, template.LatestRevision))
+	}
+
+	rev, err := s.dbClient.GetJobTemplateRevision(ctx, tenantId, template.TemplateId, revision)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			// The template was deleted after it was read
+			return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job template %q not found", name))
+		}
+		slog.ErrorContext(ctx, "Failed to get job template revision", "template_id", template.TemplateId, "revision", revision, "error", err)
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return template, rev, nil
+}
+
+// templateRevisionFromRequest validates a template definition. Every ${name}
+// reference must be a declared parameter and every default must match its type.
+func templateRevisionFromRequest(req *jennahv1.CreateJobTemplateRequest) (*database.JobTemplateRevision, error) {
+	if req.ImageUri == "" {
+		return nil, errors.New("imageUri is required")
+	}
+	rev := &database.JobTemplateRevision{
+		ImageUri:  req.ImageUri,
+		EnvVars:   req.EnvVars,
+		TaskCount: req.TaskCount,
+	}
+	if rev.EnvVars == nil {
+		rev.EnvVars = map[string]string{}
+	}
+	if res := req.Resources; res != nil {
+		if res.CpuMilli < 0 || res.MemoryMib < 0 {
+			return nil, errors.New("resources must not be negative")
+		}
+		if res.CpuMilli > 0 {
+			rev.CpuMilli = &res.CpuMilli
+		}
+		if res.MemoryMib > 0 {
+			rev.MemoryMib = &res.MemoryMib
+		}
+	}
+	if rev.TaskCount < 0 {
+		return nil, errors.New("taskCount must not be negative")
+	}
+	if rev.TaskCount == 0 {
+		rev.TaskCount = 1
+	}
+
+	declared := make(map[string]bool)
+	for _, p := range req.Parameters {
+		param := database.TemplateParameter{
+			Name:        p.Name,
+			Type:        p.Type,
+			Default:     p.DefaultValue,
+			Required:    p.Required,
+			Description: p.Description,
+		}
+		if param.Type == "" {
+			param.Type = database.TemplateParamString
+		}
+		if !paramNamePattern.MatchString(param.Name) {
+			return nil, fmt.Errorf("parameter name %q must be letters, digits and underscores, not starting with a digit", param.Name)
+		}
+		if declared[param.Name] {
+			return nil, fmt.Errorf("parameter %q is declared twice", param.Name)
+		}
+		declared[param.Name] = true
+		if param.Required && param.Default != "" {
+			return nil, fmt.Errorf("parameter %q is required, so it cannot have a default", param.Name)
+		}
+		if !param.Required {
+			if err := checkParamValue(param, param.Default); err != nil {
+				return nil, fmt.Errorf("default of %w", err)
+			}
+		}
+		rev.Parameters = append(rev.Parameters, param)
+	}
+
+	for _, value := range append([]string{rev.ImageUri}, slices.Collect(maps.Values(rev.EnvVars))...) {
+		for _, ref := range paramRefPattern.FindAllStringSubmatch(value, -1) {
+			if !declared[ref[1]] {
+				return nil, fmt.Errorf("${%s} does not name a declared parameter", ref[1])
+			}
+		}
+	}
+	return rev, nil
+}
+
+// checkParamValue reports whether value is valid for the parameter's type.
+func checkParamValue(param database.TemplateParameter, value string) error {
+	var err error
+	switch param.Type {
+	case database.TemplateParamString:
+	case database.TemplateParamInt:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case database.TemplateParamBool:
+		_, err = strconv.ParseBool(value)
+	default:
+		return fmt.Errorf("parameter %q: type %q must be %s, %s or %s", param.Name, param.Type,
+			database.TemplateParamString, database.TemplateParamInt, database.TemplateParamBool)
+	}
+	if err != nil {
+		return fmt.Errorf("parameter %q: %q is not a valid %s", param.Name, value, param.Type)
+	}
+	return nil
+}
+
+// renderTemplate resolves a revision's parameters from values and their
+// defaults, and substitutes them into the image URI and env var values.
+func renderTemplate(rev *database.JobTemplateRevision, values map[string]string) (*jennahv1.SubmitJobRequest, error) {
+	resolved := make(map[string]string, len(rev.Parameters))
+	for _, param := range rev.Parameters {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Required {
+				return nil, fmt.Errorf("parameter %q is required", param.Name)
+			}
+			value = param.Default
+		}
+		if err := checkParamValue(param, value); err != nil {
+			return nil, err
+		}
+		resolved[param.Name] = value
+	}
+	for name := range values {
+		if _, ok := resolved[name]; !ok {
+			return nil, fmt.Errorf("template revision %d has no parameter %q", rev.Revision, name)
+		}
+	}
+
+	expand := func(s string) string {
+		return paramRefPattern.ReplaceAllStringFunc(s, func(ref string) string {
+			return resolved[ref[2:len(ref)-1]]
+		})
+	}
+	job := &jennahv1.SubmitJobRequest{
+		ImageUri:  expand(rev.ImageUri),
+		EnvVars:   make(map[string]string, len(rev.EnvVars)),
+		Resources: &jennahv1.ResourceRequirements{},
+		TaskCount: rev.TaskCount,
+	}
+	for name, value := range rev.EnvVars {
+		job.EnvVars[name] = expand(value)
+	}
+	if rev.CpuMilli != nil {
+		job.Resources.CpuMilli = *rev.CpuMilli
+	}
+	if rev.MemoryMib != nil {
+		job.Resources.MemoryMib = *rev.MemoryMib
+	}
+	return job, nil
+}
+
+func templateToProto(template *database.JobTemplate) *jennahv1.JobTemplate {
+	protoTemplate := &jennahv1.JobTemplate{
+		TemplateId:     template.TemplateId,
+		Name:           template.Name,
+		LatestRevision: template.LatestRevision,
+		CreatedAt:      template.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      template.UpdatedAt.Format(time.RFC3339),
+	}
+	if template.Description != nil {
+		protoTemplate.Description = *template.Description
+	}
+	return protoTemplate
+}
+
+func templateRevisionToProto(rev *database.JobTemplateRevision) *jennahv1.JobTemplateRevision {
+	protoRev := &jennahv1.JobTemplateRevision{
+		Revision:  rev.Revision,
+		ImageUri:  rev.ImageUri,
+		EnvVars:   rev.EnvVars,
+		Resources: &jennahv1.ResourceRequirements{},
+		TaskCount: rev.TaskCount,
+		CreatedAt: rev.CreatedAt.Format(time.RFC3339),
+	}
+	if rev.CpuMilli != nil {
+		protoRev.Resources.CpuMilli = *rev.CpuMilli
+	}
+	if rev.MemoryMib != nil {
+		protoRev.Resources.MemoryMib = *rev.MemoryMib
+	}
+	if rev.CreatedBy != nil {
+		protoRev.CreatedBy = *rev.CreatedBy
+	}
+	for _, param := range rev.Parameters {
+		protoRev.Parameters = append(protoRev.Parameters, &jennahv1.TemplateParameter{
+			Name:         param.Name,
+			Type:         param.Type,
+			DefaultValue: param.Default,
+			Required:     param.Required,
+			Description:  param.Description,
+		})
+	}
+	return protoRev
+}
@@ -1,6 +1,13 @@
 package service
 
-import "time"
+import (
+	"time"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/egress"
+	"github.com/alphauslabs/jennah/internal/policy"
+	"github.com/alphauslabs/jennah/internal/usage"
+)
 
 type Tenant struct {
 	TenantId      string
@@ -11,7 +18,34 @@ type Tenant struct {
 }
 
 type OAuthUser struct {
-	Email    string
-	UserId   string
-	Provider string
+	Email         string
+	UserId        string
+	Provider      string
+	EmailVerified bool // The identity provider vouches that Email belongs to the user
+}
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	User     *OAuthUser // Interactive user; nil for API key callers
+	TenantId string     // Tenant the credential is bound to (API keys)
+	ApiKeyId string
+}
+
+// Name identifies the principal in logs and audit fields
+func (p *Principal) Name() string {
+	if p.User != nil {
+		return p.User.Email
+	}
+	return "api-key:" + p.ApiKeyId
+}
+
+// Config holds gateway settings that are not tied to a single dependency
+type Config struct {
+	AdminEmails      []string             // Users allowed to call AdminService
+	DefaultQuota     database.TenantQuota // Applied to tenants without an explicit quota
+	TenantCacheSize  int                  // Max cached identity-to-tenant mappings
+	TenantCacheTTL   time.Duration        // How long a cached mapping is trusted
+	PriceTable       *usage.PriceTable    // Prices for GetUsage cost estimates; nil estimates none
+	Policy           *policy.Policy       // Global job policy applied to every tenant; nil allows everything
+	WebhookAllowlist *egress.Allowlist    // Internal hosts notification webhooks may reach anyway; nil allows none
 }
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/labels"
+)
+
+// maxUsageRange bounds the time range of a GetUsage request, and so the number
+// of jobs it reads.
+const maxUsageRange = 366 * 24 * time.Hour
+
+const usageFormatCSV = "csv"
+
+func (s *GatewayService) GetUsage(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetUsageRequest],
+) (*connect.Response[jennahv1.GetUsageResponse], error) {
+	_, tenantId, err := s.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.TenantId != "" && req.Msg.TenantId != tenantId {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tenantId can only be set through the AdminService"))
+	}
+
+	resp, err := s.usageReport(ctx, tenantId, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *AdminService) GetUsage(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetUsageRequest],
+) (*connect.Response[jennahv1.GetUsageResponse], error) {
+	if _, err := a.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := a.gateway.usageReport(ctx, req.Msg.TenantId, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// usageKey identifies a row of a usage report.
+type usageKey struct {
+	tenantId   string
+	labelValue string
+}
+
+// usageReport sums the usage of the jobs of tenantId, or of every tenant if it
+// is empty, that req asks for. Each job is priced on its own, by its region and
+// provisioning model, before it is added to its row.
+func (s *GatewayService) usageReport(ctx context.Context, tenantId string, req *jennahv1.GetUsageRequest) (*jennahv1.GetUsageResponse, error) {
+	start, end, err := usageRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	selector, err := labels.ParseSelector(req.LabelSelector)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.GroupByLabel != "" {
+		if err := labels.Validate(map[string]string{req.GroupByLabel: ""}); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("groupByLabel: %w", err))
+		}
+	}
+	if req.Format != "" && req.Format != usageFormatCSV {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown format %q; must be empty or %q", req.Format, usageFormatCSV))
+	}
+
+	jobs, err := s.dbClient.ListJobUsage(ctx, tenantId, start, end, selector)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job usage", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	rows := make(map[usageKey]*jennahv1.UsageRow)
+	total := &jennahv1.UsageRow{TenantId: tenantId}
+	for _, job := range jobs {
+		key := usageKey{tenantId: job.TenantId}
+		if req.GroupByLabel != "" {
+			key.labelValue = labels.Split(job.Labels)[req.GroupByLabel]
+		}
+		row, ok := rows[key]
+		if !ok {
+			row = &jennahv1.UsageRow{TenantId: key.tenantId, LabelValue: key.labelValue}
+			rows[key] = row
+		}
+		s.addJobUsage(row, job)
+		s.addJobUsage(total, job)
+	}
+
+	resp := &jennahv1.GetUsageResponse{Total: total}
+	for _, key := range slices.SortedFunc(maps.Keys(rows), compareUsageKeys) {
+		resp.Rows = append(resp.Rows, rows[key])
+	}
+	if s.priceTable != nil {
+		resp.Currency = s.priceTable.Currency
+	}
+	if req.Format == usageFormatCSV {
+		if resp.Csv, err = usageCSV(resp, req.GroupByLabel); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return resp, nil
+}
+
+// addJobUsage adds a job's usage to row. Jobs whose VMs are unknown count
+// their requested resources as used.
+func (s *GatewayService) addJobUsage(row *jennahv1.UsageRow, job *database.JobUsage) {
+	vcpuSeconds, memoryGibSeconds := job.RequestedVcpuSeconds, job.RequestedMemoryGibSeconds
+	if job.VcpuSeconds != nil && job.MemoryGibSeconds != nil {
+		vcpuSeconds, memoryGibSeconds = *job.VcpuSeconds, *job.MemoryGibSeconds
+	}
+
+	row.Jobs++
+	row.RunSeconds += job.RunSeconds
+	row.RequestedVcpuSeconds += job.RequestedVcpuSeconds
+	row.RequestedMemoryGibSeconds += job.RequestedMemoryGibSeconds
+	row.VcpuSeconds += vcpuSeconds
+	row.MemoryGibSeconds += memoryGibSeconds
+
+	if s.priceTable == nil {
+		return
+	}
+	// Batch provisions STANDARD VMs unless a job asks otherwise
+	region, provisioningModel := "", "STANDARD"
+	if job.Region != nil {
+		region = *job.Region
+	}
+	if job.ProvisioningModel != nil {
+		provisioningModel = *job.ProvisioningModel
+	}
+	if cost, ok := s.priceTable.Cost(region, provisioningModel, vcpuSeconds, memoryGibSeconds); ok {
+		row.EstimatedCost += cost
+	} else {
+		row.UnpricedJobs++
+	}
+}
+
+// usageRange parses a GetUsage time range. end defaults to now.
+func usageRange(startTime, endTime string) (start, end time.Time, err error) {
+	if startTime == "" {
+		return start, end, errors.New("startTime is required")
+	}
+	if start, err = time.Parse(time.RFC3339, startTime); err != nil {
+		return start, end, fmt.Errorf("startTime must be an RFC 3339 time: %w", err)
+	}
+	end = time.Now()
+	if endTime != "" {
+		if end, err = time.Parse(time.RFC3339, endTime); err != nil {
+			return start, end, fmt.Errorf("endTime must be an RFC 3339 time: %w", err)
+		}
+	}
+	switch {
+	case !end.After(start):
+		return start, end, errors.New("endTime must be after startTime")
+	case end.Sub(start) > maxUsageRange:
+		return start, end, fmt.Errorf("the time range must be at most %d days", int(maxUsageRange.Hours()/24))
+	}
+	return start, end, nil
+}
+
+// usageCSV renders a usage report's rows, then its total in a row whose
+// tenant_id is "total", as CSV. The label column is named after the grouped
+// label.
+func usageCSV(resp *jennahv1.GetUsageResponse, groupByLabel string) (string, error) {
+	labelColumn := "label_value"
+	if groupByLabel != "" {
+		labelColumn = "label:" + groupByLabel
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"tenant_id", labelColumn, "jobs", "run_seconds", "requested_vcpu_seconds", "requested_memory_gib_seconds",
+		"vcpu_seconds", "memory_gib_seconds", "estimated_cost", "currency", "unpriced_jobs"})
+	for _, row := range append(resp.Rows, resp.Total) {
+		tenantId := row.TenantId
+		if row == resp.Total {
+			tenantId = "total"
+		}
+		w.Write([]string{
+			tenantId,
+			row.LabelValue,
+			strconv.FormatInt(row.Jobs, 10),
+			formatUsage(row.RunSeconds),
+			formatUsage(row.RequestedVcpuSeconds),
+			formatUsage(row.RequestedMemoryGibSeconds),
+			formatUsage(row.VcpuSeconds),
+			formatUsage(row.MemoryGibSeconds),
+			strconv.FormatFloat(row.EstimatedCost, 'f', 4, 64),
+			resp.Currency,
+			strconv.FormatInt(row.UnpricedJobs, 10),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func formatUsage(v float64) string {
+	return strconv.FormatFloat(v, 'f', 3, 64)
+}
+
+func compareUsageKeys(a, b usageKey) int {
+	if c := strings.Compare(a.tenantId, b.tenantId); c != 0 {
+		return c
+	}
+	return strings.Compare(a.labelValue, b.labelValue)
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+)
+
+var artifactsOpts struct {
+	output string
+	quiet  bool
+}
+
+var artifactsCmd = &cobra.Command{
+	Use:   "artifacts JOB_ID",
+	Short: "List the files a completed job wrote to its outputs",
+	Long: `List the Cloud Storage objects that matched a job's declared outputs when it
+completed, with their size and CRC32C checksum. Artifacts are recorded shortly
+after the job completes; jobs that fail record none.
+
+-q prints only the URIs, one per line, e.g. to pass to a downstream job.`,
+	Example: `  jennahctl artifacts 0b6a8f1e-3c1d-4e2f-9a7b-5d4c3b2a1f0e
+  jennahctl artifacts --output report -q 0b6a8f1e-3c1d-4e2f-9a7b-5d4c3b2a1f0e`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeJobIDs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListJobArtifacts(cmd.Context(), connect.NewRequest(&jennahv1.ListJobArtifactsRequest{
+			JobId:  args[0],
+			Output: artifactsOpts.output,
+		}))
+		if err != nil {
+			return err
+		}
+		if artifactsOpts.quiet {
+			for _, artifact := range resp.Msg.Artifacts {
+				fmt.Fprintln(cmd.OutOrStdout(), artifact.Uri)
+			}
+			return nil
+		}
+		return printMessage(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+			fmt.Fprintln(w, "OUTPUT\tURI\tSIZE\tCRC32C\tUPDATED")
+			for _, artifact := range resp.Msg.Artifacts {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", artifact.Output, artifact.Uri, artifact.SizeBytes, artifact.Crc32C, artifact.UpdatedAt)
+			}
+		})
+	},
+}
+
+func init() {
+	flags := artifactsCmd.Flags()
+	flags.StringVar(&artifactsOpts.output, "output", "", "Only list the artifacts of this output")
+	flags.BoolVarP(&artifactsOpts.quiet, "quiet", "q", false, "Only print the URIs")
+}
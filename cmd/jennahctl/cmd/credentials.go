@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// A gcloud token is refreshed this long before it expires, so that it does not
+// expire during a request
+const tokenRefreshMargin = time.Minute
+
+// credentials is the cached login, stored in credentials.yaml under the user's
+// config directory, readable only by the user.
+type credentials struct {
+	Gateway string    `yaml:"gateway"`
+	Tenant  string    `yaml:"tenant,omitempty"`
+	Token   string    `yaml:"token"`
+	Expiry  time.Time `yaml:"expiry,omitempty"` // Zero for API keys, which do not expire
+	Gcloud  bool      `yaml:"gcloud,omitempty"` // Token is a gcloud identity token, refreshed when it expires
+}
+
+func credentialsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find config directory: %w", err)
+	}
+	return filepath.Join(dir, "jennah", "credentials.yaml"), nil
+}
+
+// loadCredentials reads the cached login. It returns empty credentials if the
+// user has not logged in.
+func loadCredentials() (*credentials, error) {
+	path, err := credentialsPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &credentials{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials: %w", err)
+	}
+	var creds credentials
+	if err := yaml.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials %s: %w", path, err)
+	}
+	return &creds, nil
+}
+
+func (c *credentials) save() error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode credentials: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+	return nil
+}
+
+// currentToken returns the cached token, first refreshing a gcloud token that
+// is about to expire.
+func (c *credentials) currentToken(ctx context.Context) (string, error) {
+	if c.Gcloud && time.Until(c.Expiry) < tokenRefreshMargin {
+		token, err := gcloudIdentityToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.Token = token
+		c.Expiry = tokenExpiry(token)
+		if err := c.save(); err != nil {
+			return "", err
+		}
+	}
+	if !c.Expiry.IsZero() && time.Now().After(c.Expiry) {
+		return "", errors.New("login has expired; run jennahctl login again")
+	}
+	return c.Token, nil
+}
+
+// gcloudIdentityToken asks gcloud for an OIDC identity token of the active account.
+func gcloudIdentityToken(ctx context.Context) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "gcloud", "auth", "print-identity-token")
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get an identity token from gcloud: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// tokenExpiry reads the exp claim of a JWT without verifying it; the gateway
+// does that. It returns the zero time for API keys and other opaque tokens.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
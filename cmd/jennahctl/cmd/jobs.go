@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+)
+
+// Job statuses that are never left
+var terminalStatuses = []string{"COMPLETED", "FAILED", "CANCELLED", "TIMED_OUT"}
+
+var (
+	listSelector string
+	pollInterval time.Duration
+	waitTimeout  time.Duration
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List jobs",
+	Example: `  jennahctl list
+  jennahctl list -l 'team=billing,env in (prod,staging)' -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListJobs(cmd.Context(), connect.NewRequest(&jennahv1.ListJobsRequest{LabelSelector: listSelector}))
+		if err != nil {
+			return err
+		}
+		return printMessage(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+			fmt.Fprintln(w, "JOB ID\tSTATUS\tREGION\tIMAGE\tCREATED")
+			for _, job := range resp.Msg.Jobs {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", job.JobId, job.Status, orNone(job.Region), job.ImageUri, job.CreatedAt)
+			}
+		})
+	},
+}
+
+var getCmd = &cobra.Command{
+	Use:               "get JOB_ID",
+	Short:             "Show a job",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeJobIDs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+		job, err := getJob(cmd.Context(), client, args[0])
+		if err != nil {
+			return err
+		}
+		return printMessage(cmd.OutOrStdout(), job, func(w io.Writer) {
+			printJobDetails(w, job)
+		})
+	},
+}
+
+var cancelCmd = &cobra.Command{
+	Use:               "cancel JOB_ID...",
+	Short:             "Cancel jobs",
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeJobIDs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+		var errs []error
+		for _, jobID := range args {
+			resp, err := client.CancelJob(cmd.Context(), connect.NewRequest(&jennahv1.CancelJobRequest{JobId: jobID}))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("job %s: %w", jobID, err))
+				continue
+			}
+			err = printMessage(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintf(w, "%s\t%s\n", resp.Msg.JobId, resp.Msg.Status)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return errors.Join(errs...)
+	},
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch JOB_ID",
+	Short: "Print a job's status changes until it finishes",
+	Long: `Print a job's status changes until it finishes. Exits with a non-zero status
+unless the job completes successfully.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeJobIDs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+		return waitForJob(cmd, client, args[0], true)
+	},
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&listSelector, "selector", "l", "", `Label selector, e.g. "team=billing,env!=dev"`)
+	for _, cmd := range []*cobra.Command{watchCmd, submitCmd, logsCmd} {
+		cmd.Flags().DurationVar(&pollInterval, "poll-interval", 5*time.Second, "How often to check the job")
+	}
+	for _, cmd := range []*cobra.Command{watchCmd, submitCmd} {
+		cmd.Flags().DurationVar(&waitTimeout, "timeout", 0, "Give up waiting after this long, e.g. 30m (default no limit)")
+	}
+}
+
+func getJob(ctx context.Context, client jennahv1connect.DeploymentServiceClient, jobID string) (*jennahv1.Job, error) {
+	resp, err := client.GetJob(ctx, connect.NewRequest(&jennahv1.GetJobRequest{JobId: jobID}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Job, nil
+}
+
+// waitForJob polls a job until it reaches a terminal status, reporting each
+// status change on stderr, and prints the finished job if printResult is set.
+// It returns an error unless the job completed, so that scripts can rely on
+// the exit status.
+func waitForJob(cmd *cobra.Command, client jennahv1connect.DeploymentServiceClient, jobID string, printResult bool) error {
+	ctx := cmd.Context()
+	if waitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, waitTimeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var status string
+	for {
+		job, err := getJob(ctx, client, jobID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out waiting for job %s after %s; last status %s", jobID, waitTimeout, orNone(status))
+			}
+			return err
+		}
+		if job.Status != status {
+			status = job.Status
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s  %s  %s\n", time.Now().Format(time.TimeOnly), jobID, status)
+		}
+
+		if slices.Contains(terminalStatuses, status) {
+			if printResult {
+				if err := printMessage(cmd.OutOrStdout(), job, func(w io.Writer) { printJobDetails(w, job) }); err != nil {
+					return err
+				}
+			}
+			if status != "COMPLETED" {
+				return fmt.Errorf("job %s finished with status %s", jobID, status)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for job %s after %s; last status %s", jobID, waitTimeout, status)
+		case <-ticker.C:
+		}
+	}
+}
+
+func printJobDetails(w io.Writer, job *jennahv1.Job) {
+	fmt.Fprintf(w, "Job ID:\t%s\n", job.JobId)
+	fmt.Fprintf(w, "Status:\t%s\n", job.Status)
+	if job.ErrorMessage != "" {
+		fmt.Fprintf(w, "Error:\t%s\n", job.ErrorMessage)
+	}
+	fmt.Fprintf(w, "Image:\t%s\n", job.ImageUri)
+	if job.ImageDigest != "" {
+		fmt.Fprintf(w, "Image digest:\t%s\n", job.ImageDigest)
+	}
+	fmt.Fprintf(w, "Region:\t%s\n", orNone(job.Region))
+	if job.PreemptionCount > 0 {
+		fmt.Fprintf(w, "Provisioning:\t%s (preempted %d times)\n", job.ProvisioningModel, job.PreemptionCount)
+	} else {
+		fmt.Fprintf(w, "Provisioning:\t%s\n", orNone(job.ProvisioningModel))
+	}
+	if job.TemplateId != "" {
+		fmt.Fprintf(w, "Template:\t%s (revision %d)\n", job.TemplateId, job.TemplateRevision)
+	}
+	if job.MaxRunDuration != nil {
+		fmt.Fprintf(w, "Max run:\t%s per task\n", job.MaxRunDuration.AsDuration())
+	}
+	if job.MaxQueueDuration != nil {
+		fmt.Fprintf(w, "Max queue:\t%s\n", job.MaxQueueDuration.AsDuration())
+	}
+	fmt.Fprintf(w, "Labels:\t%s\n", orNone(joinMap(job.Labels)))
+	fmt.Fprintf(w, "Created:\t%s\n", job.CreatedAt)
+	fmt.Fprintf(w, "Started:\t%s\n", orNone(job.StartedAt))
+	fmt.Fprintf(w, "Completed:\t%s\n", orNone(job.CompletedAt))
+	for _, key := range slices.Sorted(maps.Keys(job.Outputs)) {
+		fmt.Fprintf(w, "Output %s:\t%s\n", key, job.Outputs[key])
+	}
+	for _, key := range slices.Sorted(maps.Keys(job.Annotations)) {
+		fmt.Fprintf(w, "Annotation %s:\t%s\n", key, job.Annotations[key])
+	}
+}
+
+func joinMap(m map[string]string) string {
+	pairs := make([]string, 0, len(m))
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		pairs = append(pairs, key+"="+m[key])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// completeJobIDs completes job IDs from ListJobs, showing each job's status.
+func completeJobIDs(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	client, err := newClient(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	resp, err := client.ListJobs(cmd.Context(), connect.NewRequest(&jennahv1.ListJobsRequest{}))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var completions []cobra.Completion
+	for _, job := range resp.Msg.Jobs {
+		if strings.HasPrefix(job.JobId, toComplete) && !slices.Contains(args, job.JobId) {
+			completions = append(completions, cobra.CompletionWithDesc(job.JobId, job.Status+" "+job.ImageUri))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
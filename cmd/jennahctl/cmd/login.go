@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+)
+
+var (
+	loginToken      string
+	loginTokenStdin bool
+	loginGcloud     bool
+)
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Log in to a gateway and cache the credentials",
+	Long: `Log in to a gateway with an API key or an OIDC identity token. The token is
+checked against the gateway and cached, with the gateway URL and tenant, for
+later commands.
+
+With --gcloud, identity tokens come from "gcloud auth print-identity-token" and
+are refreshed automatically when they expire.`,
+	Example: `  jennahctl login --gateway https://jennah.example.com --gcloud
+  echo "$JENNAH_API_KEY" | jennahctl login --gateway https://jennah.example.com --token-stdin`,
+	Args: cobra.NoArgs,
+	RunE: runLogin,
+}
+
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove the cached credentials",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := credentialsPath()
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove credentials: %w", err)
+		}
+		fmt.Fprintln(cmd.ErrOrStderr(), "Logged out")
+		return nil
+	},
+}
+
+func init() {
+	flags := loginCmd.Flags()
+	flags.StringVar(&loginToken, "token", "", "API key or identity token (prefer --token-stdin, which keeps it out of shell history)")
+	flags.BoolVar(&loginTokenStdin, "token-stdin", false, "Read the API key or identity token from stdin")
+	flags.BoolVar(&loginGcloud, "gcloud", false, "Use identity tokens from the gcloud CLI")
+	loginCmd.MarkFlagsMutuallyExclusive("token", "token-stdin", "gcloud")
+	loginCmd.MarkFlagsOneRequired("token", "token-stdin", "gcloud")
+}
+
+func runLogin(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	creds, err := loadCredentials()
+	if err != nil {
+		return err
+	}
+
+	creds.Gateway = firstNonEmpty(gatewayURL, os.Getenv(gatewayEnv), creds.Gateway)
+	if creds.Gateway == "" {
+		return errors.New("--gateway is required")
+	}
+	if cmd.Flags().Changed("tenant") {
+		creds.Tenant = tenantID
+	}
+
+	token := loginToken
+	switch {
+	case loginTokenStdin:
+		line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("failed to read token from stdin: %w", err)
+		}
+		token = strings.TrimSpace(line)
+	case loginGcloud:
+		token, err = gcloudIdentityToken(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if token == "" {
+		return errors.New("token is empty")
+	}
+
+	client := newGatewayClient(creds.Gateway, token, creds.Tenant)
+	resp, err := client.GetCurrentTenant(ctx, connect.NewRequest(&jennahv1.GetCurrentTenantRequest{}))
+	if err != nil {
+		return fmt.Errorf("gateway rejected the login: %w", err)
+	}
+
+	creds.Token = token
+	creds.Expiry = tokenExpiry(token)
+	creds.Gcloud = loginGcloud
+	if err := creds.save(); err != nil {
+		return err
+	}
+
+	who := resp.Msg.UserEmail
+	if who == "" {
+		who = "API key"
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Logged in to %s as %s (tenant %s, role %s)\n",
+		creds.Gateway, who, resp.Msg.TenantId, resp.Msg.Role)
+	return nil
+}
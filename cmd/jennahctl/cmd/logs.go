@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+)
+
+var logsOpts struct {
+	follow     bool
+	since      time.Duration
+	timestamps bool
+}
+
+var logsCmd = &cobra.Command{
+	Use:   "logs JOB_ID",
+	Short: "Print a job's logs",
+	Long: `Print what a job's tasks wrote to stdout and stderr, oldest first.
+
+Logs reach Cloud Logging a few seconds after they are written. With --follow,
+new lines are printed as they arrive until the job finishes.
+
+In json and yaml output, each line is printed as a separate entry with its
+timestamp, severity and task.`,
+	Example: `  jennahctl logs 0b6a8f1e-3c1d-4e2f-9a7b-5d4c3b2a1f0e
+  jennahctl logs -f --since 10m 0b6a8f1e-3c1d-4e2f-9a7b-5d4c3b2a1f0e`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeJobIDs,
+	RunE:              runLogs,
+}
+
+func init() {
+	flags := logsCmd.Flags()
+	flags.BoolVarP(&logsOpts.follow, "follow", "f", false, "Keep printing new lines until the job finishes")
+	flags.DurationVar(&logsOpts.since, "since", 0, "Only print lines from the last duration, e.g. 10m")
+	flags.BoolVarP(&logsOpts.timestamps, "timestamps", "t", false, "Prefix lines with their timestamp and task")
+}
+
+func runLogs(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	client, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+	jobID := args[0]
+
+	var since string
+	if logsOpts.since > 0 {
+		since = time.Now().Add(-logsOpts.since).UTC().Format(time.RFC3339Nano)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Read the job's status before its logs, so that no lines written
+		// before it finished can be missed
+		var finished bool
+		if logsOpts.follow {
+			job, err := getJob(ctx, client, jobID)
+			if err != nil {
+				return err
+			}
+			finished = slices.Contains(terminalStatuses, job.Status)
+		}
+
+		last, err := printLogPages(ctx, cmd.OutOrStdout(), client, jobID, since)
+		if err != nil {
+			return err
+		}
+		if last != "" {
+			since = last
+		}
+		if !logsOpts.follow || finished {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// printLogPages prints every log entry after since and returns the timestamp
+// of the last one, or "" if there were none.
+func printLogPages(ctx context.Context, w io.Writer, client jennahv1connect.DeploymentServiceClient, jobID, since string) (string, error) {
+	var last, pageToken string
+	for {
+		resp, err := client.GetJobLogs(ctx, connect.NewRequest(&jennahv1.GetJobLogsRequest{
+			JobId:     jobID,
+			Since:     since,
+			PageToken: pageToken,
+		}))
+		if err != nil {
+			return "", err
+		}
+		for _, entry := range resp.Msg.Entries {
+			if err := printLogEntry(w, entry); err != nil {
+				return "", err
+			}
+			last = entry.Timestamp
+		}
+		if resp.Msg.NextPageToken == "" {
+			return last, nil
+		}
+		pageToken = resp.Msg.NextPageToken
+	}
+}
+
+func printLogEntry(w io.Writer, entry *jennahv1.LogEntry) error {
+	switch outputFormat {
+	case outputJSON:
+		// One entry per line, so that the output can be streamed
+		data, err := protojson.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode output: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case outputYAML:
+		data, err := toYAML(entry)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "---\n%s", data)
+		return err
+	}
+
+	message := strings.TrimSuffix(entry.Message, "\n")
+	if logsOpts.timestamps {
+		_, err := fmt.Fprintf(w, "%s %s %s\n", entry.Timestamp, entry.TaskId, message)
+		return err
+	}
+	_, err := fmt.Fprintln(w, message)
+	return err
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+)
+
+// Environment variables that override the cached login, for CI
+const (
+	gatewayEnv = "JENNAH_GATEWAY"
+	tokenEnv   = "JENNAH_TOKEN"
+	tenantEnv  = "JENNAH_TENANT"
+)
+
+// tenantHeader selects which of the caller's tenants a request acts on
+const tenantHeader = "X-Jennah-Tenant"
+
+var (
+	gatewayURL   string
+	tenantID     string
+	outputFormat string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "jennahctl",
+	Short: "Jennah command-line client",
+	Long: `Command-line client for the Jennah gateway. Submit, inspect and cancel jobs,
+read their logs and report what they used.
+
+Log in once with "jennahctl login", or set ` + gatewayEnv + ` and ` + tokenEnv + ` in CI.`,
+	SilenceUsage:  true,
+	SilenceErrors: true, // main prints them
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return validateOutputFormat()
+	},
+}
+
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	flags := rootCmd.PersistentFlags()
+	flags.StringVar(&gatewayURL, "gateway", "", "Gateway URL, e.g. https://jennah.example.com (env "+gatewayEnv+", default from login)")
+	flags.StringVar(&tenantID, "tenant", "", "Tenant to act on, if you belong to several (env "+tenantEnv+", default from login)")
+	flags.StringVarP(&outputFormat, "output", "o", outputTable, "Output format: table, json or yaml")
+	rootCmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(
+		[]string{outputTable, outputJSON, outputYAML}, cobra.ShellCompDirectiveNoFileComp))
+
+	rootCmd.AddCommand(loginCmd, logoutCmd, submitCmd, listCmd, getCmd, cancelCmd, watchCmd, logsCmd, artifactsCmd, validateCmd, usageCmd)
+}
+
+// newClient returns a DeploymentService client for the gateway, authenticated
+// with JENNAH_TOKEN or the cached login.
+func newClient(ctx context.Context) (jennahv1connect.DeploymentServiceClient, error) {
+	creds, err := loadCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	gateway := firstNonEmpty(gatewayURL, os.Getenv(gatewayEnv), creds.Gateway)
+	if gateway == "" {
+		return nil, errors.New("no gateway configured; run jennahctl login --gateway URL or set " + gatewayEnv)
+	}
+	token := os.Getenv(tokenEnv)
+	if token == "" {
+		token, err = creds.currentToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if token == "" {
+		return nil, errors.New("not logged in; run jennahctl login or set " + tokenEnv)
+	}
+	tenant := firstNonEmpty(tenantID, os.Getenv(tenantEnv), creds.Tenant)
+
+	return newGatewayClient(gateway, token, tenant), nil
+}
+
+func newGatewayClient(gateway, token, tenant string) jennahv1connect.DeploymentServiceClient {
+	return jennahv1connect.NewDeploymentServiceClient(
+		http.DefaultClient,
+		strings.TrimSuffix(gateway, "/"),
+		connect.WithInterceptors(newAuthInterceptor(token, tenant)),
+	)
+}
+
+// newAuthInterceptor sends the bearer token, and the tenant if one is selected,
+// with every request.
+func newAuthInterceptor(token, tenant string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			if tenant != "" {
+				req.Header().Set(tenantHeader, tenant)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
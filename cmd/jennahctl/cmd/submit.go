@@ -0,0 +1,323 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/manifest"
+)
+
+var submitOpts struct {
+	file             string
+	image            string
+	env              map[string]string
+	cpuMilli         int64
+	memoryMib        int64
+	tasks            int64
+	regions          []string
+	preferredRegions []string
+	provisioning     string
+	maxRun           time.Duration
+	maxQueue         time.Duration
+	outputs          []string
+	labels           map[string]string
+	annotations      map[string]string
+	template         string
+	templateRevision int64
+	params           map[string]string
+	wait             bool
+	quiet            bool
+	dryRun           bool
+}
+
+var submitCmd = &cobra.Command{
+	Use:   "submit",
+	Short: "Submit a job",
+	Long: `Submit a job described by flags, a YAML or JSON job manifest, or a job template.
+Flags override the values in the manifest, e.g.
+
+  apiVersion: jennah/v1
+  kind: Job
+  metadata:
+    labels:
+      team: billing
+  spec:
+    image: asia-docker.pkg.dev/my-project/jobs/report:1.4
+    env:
+      REPORT_MONTH: "2026-09"
+    resources:
+      cpuMilli: 2000
+      memoryMib: 4096
+
+With --wait, the command waits for the job to finish and exits with a non-zero
+status unless it completes successfully. With --dry-run, the job is validated and
+the GCP Batch job it would create is printed instead.`,
+	Example: `  jennahctl submit --image alpine:3 --env GREETING=hello --wait
+  jennahctl submit -f job.yaml --label env=ci --wait --timeout 1h
+  jennahctl submit --template nightly-report --param month=2026-09
+  JOB_ID=$(jennahctl submit -f job.yaml -q)
+  jennahctl submit -f job.yaml --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runSubmit,
+}
+
+func init() {
+	flags := submitCmd.Flags()
+	flags.StringVarP(&submitOpts.file, "file", "f", "", `YAML or JSON job manifest, or "-" for stdin`)
+	flags.StringVar(&submitOpts.image, "image", "", "Container image to run")
+	flags.StringToStringVar(&submitOpts.env, "env", nil, "Environment variables, e.g. --env KEY=VALUE")
+	flags.Int64Var(&submitOpts.cpuMilli, "cpu-milli", 0, "vCPU per task in milli-cores, 1000 = 1 vCPU")
+	flags.Int64Var(&submitOpts.memoryMib, "memory-mib", 0, "Memory per task in MiB")
+	flags.Int64Var(&submitOpts.tasks, "tasks", 0, "Number of tasks (default 1)")
+	flags.StringSliceVar(&submitOpts.regions, "region", nil, "Regions the job may run in (default all)")
+	flags.StringSliceVar(&submitOpts.preferredRegions, "prefer-region", nil, "Regions to try first, in order")
+	flags.StringVar(&submitOpts.provisioning, "provisioning-model", "", "VM provisioning model: STANDARD, SPOT or PREEMPTIBLE (default STANDARD)")
+	flags.DurationVar(&submitOpts.maxRun, "max-run-duration", 0, "Stop each task that runs longer than this, e.g. 1h (default no limit)")
+	flags.StringArrayVar(&submitOpts.outputs, "output", nil, "Declared outputs, e.g. --output report=gs://my-bucket/reports/{job_id}/*.csv")
+	flags.DurationVar(&submitOpts.maxQueue, "max-queue-duration", 0, "Cancel the job if it has not started this long after submission (default no limit)")
+	flags.StringToStringVar(&submitOpts.labels, "label", nil, "Labels, e.g. --label team=billing")
+	flags.StringToStringVar(&submitOpts.annotations, "annotation", nil, "Annotations, e.g. --annotation commit=0a1b2c3")
+	flags.StringVar(&submitOpts.template, "template", "", "Submit from the job template with this name")
+	flags.Int64Var(&submitOpts.templateRevision, "template-revision", 0, "Template revision (default latest)")
+	flags.StringToStringVar(&submitOpts.params, "param", nil, "Template parameters, e.g. --param month=2026-09")
+	flags.BoolVarP(&submitOpts.wait, "wait", "w", false, "Wait for the job to finish")
+	flags.BoolVarP(&submitOpts.quiet, "quiet", "q", false, "Only print the job ID")
+	flags.BoolVar(&submitOpts.dryRun, "dry-run", false, "Validate the job and print the GCP Batch job it would create, without submitting it")
+
+	submitCmd.MarkFlagsMutuallyExclusive("template", "file")
+	submitCmd.MarkFlagsMutuallyExclusive("dry-run", "wait")
+	submitCmd.MarkFlagsMutuallyExclusive("dry-run", "quiet")
+	for _, name := range []string{"image", "env", "cpu-milli", "memory-mib", "tasks"} {
+		submitCmd.MarkFlagsMutuallyExclusive("template", name)
+	}
+	submitCmd.MarkFlagFilename("file", "yaml", "yml", "json")
+}
+
+// submitResponse is what SubmitJob and SubmitJobFromTemplate have in common
+type submitResponse interface {
+	proto.Message
+	GetJobId() string
+	GetStatus() string
+	GetRegion() string
+}
+
+func runSubmit(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	client, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var resp submitResponse
+	if submitOpts.template != "" {
+		req, err := templateRequest(cmd)
+		if err != nil {
+			return err
+		}
+		res, err := client.SubmitJobFromTemplate(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+		if req.DryRun {
+			return printDryRun(cmd.OutOrStdout(), res.Msg)
+		}
+		resp = res.Msg
+	} else {
+		req, err := submitRequest(cmd)
+		if err != nil {
+			return err
+		}
+		req.DryRun = submitOpts.dryRun
+		res, err := client.SubmitJob(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+		if req.DryRun {
+			return printDryRun(cmd.OutOrStdout(), res.Msg)
+		}
+		resp = res.Msg
+	}
+
+	switch {
+	case submitOpts.quiet:
+		fmt.Fprintln(cmd.OutOrStdout(), resp.GetJobId())
+	case submitOpts.wait:
+		fmt.Fprintf(cmd.ErrOrStderr(), "Submitted job %s to %s\n", resp.GetJobId(), orNone(resp.GetRegion()))
+	default:
+		err := printMessage(cmd.OutOrStdout(), resp, func(w io.Writer) {
+			fmt.Fprintln(w, "JOB ID\tSTATUS\tREGION")
+			fmt.Fprintf(w, "%s\t%s\t%s\n", resp.GetJobId(), resp.GetStatus(), orNone(resp.GetRegion()))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !submitOpts.wait {
+		return nil
+	}
+	return waitForJob(cmd, client, resp.GetJobId(), !submitOpts.quiet)
+}
+
+// dryRunResponse is what the SubmitJob and SubmitJobFromTemplate responses to a
+// dry run have in common
+type dryRunResponse interface {
+	submitResponse
+	GetBatchJob() *structpb.Struct
+}
+
+// printDryRun prints the Batch job a dry run would create. Tables have no room
+// for it, so table output prints it as YAML.
+func printDryRun(w io.Writer, resp dryRunResponse) error {
+	if outputFormat != outputTable {
+		return printMessage(w, resp, nil)
+	}
+	data, err := toYAML(resp.GetBatchJob())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "# Region: %s\n%s", resp.GetRegion(), data)
+	return err
+}
+
+// templateRequest builds a SubmitJobFromTemplateRequest from the flags.
+func templateRequest(cmd *cobra.Command) (*jennahv1.SubmitJobFromTemplateRequest, error) {
+	outputs, err := parseOutputs(submitOpts.outputs)
+	if err != nil {
+		return nil, err
+	}
+	req := &jennahv1.SubmitJobFromTemplateRequest{
+		Name:              submitOpts.template,
+		Revision:          submitOpts.templateRevision,
+		Parameters:        submitOpts.params,
+		AllowedRegions:    submitOpts.regions,
+		PreferredRegions:  submitOpts.preferredRegions,
+		Labels:            submitOpts.labels,
+		Annotations:       submitOpts.annotations,
+		DryRun:            submitOpts.dryRun,
+		ProvisioningModel: submitOpts.provisioning,
+		Outputs:           outputs,
+	}
+	if cmd.Flags().Changed("max-run-duration") {
+		req.MaxRunDuration = durationpb.New(submitOpts.maxRun)
+	}
+	if cmd.Flags().Changed("max-queue-duration") {
+		req.MaxQueueDuration = durationpb.New(submitOpts.maxQueue)
+	}
+	return req, nil
+}
+
+// submitRequest builds a SubmitJobRequest from the manifest and flags.
+func submitRequest(cmd *cobra.Command) (*jennahv1.SubmitJobRequest, error) {
+	req := &jennahv1.SubmitJobRequest{}
+	if submitOpts.file != "" {
+		job, err := readManifest(cmd.InOrStdin(), submitOpts.file)
+		if err != nil {
+			return nil, err
+		}
+		req = job.SubmitJobRequest()
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("image") {
+		req.ImageUri = submitOpts.image
+	}
+	if flags.Changed("cpu-milli") || flags.Changed("memory-mib") {
+		if req.Resources == nil {
+			req.Resources = &jennahv1.ResourceRequirements{}
+		}
+		if flags.Changed("cpu-milli") {
+			req.Resources.CpuMilli = submitOpts.cpuMilli
+		}
+		if flags.Changed("memory-mib") {
+			req.Resources.MemoryMib = submitOpts.memoryMib
+		}
+	}
+	if flags.Changed("tasks") {
+		req.TaskCount = submitOpts.tasks
+	}
+	if flags.Changed("region") {
+		req.AllowedRegions = submitOpts.regions
+	}
+	if flags.Changed("prefer-region") {
+		req.PreferredRegions = submitOpts.preferredRegions
+	}
+	if flags.Changed("provisioning-model") {
+		req.ProvisioningModel = submitOpts.provisioning
+	}
+	if flags.Changed("max-run-duration") {
+		req.MaxRunDuration = durationpb.New(submitOpts.maxRun)
+	}
+	if flags.Changed("max-queue-duration") {
+		req.MaxQueueDuration = durationpb.New(submitOpts.maxQueue)
+	}
+	outputs, err := parseOutputs(submitOpts.outputs)
+	if err != nil {
+		return nil, err
+	}
+	req.Outputs = mergeMaps(req.Outputs, outputs)
+	req.EnvVars = mergeMaps(req.EnvVars, submitOpts.env)
+	req.Labels = mergeMaps(req.Labels, submitOpts.labels)
+	req.Annotations = mergeMaps(req.Annotations, submitOpts.annotations)
+
+	if req.ImageUri == "" {
+		return nil, errors.New("an image is required; use --image, a job file or --template")
+	}
+	return req, nil
+}
+
+// parseOutputs parses --output flags, each NAME=gs://bucket/pattern.
+func parseOutputs(flags []string) (map[string]string, error) {
+	outputs := make(map[string]string, len(flags))
+	for _, output := range flags {
+		name, uri, ok := strings.Cut(output, "=")
+		if !ok {
+			return nil, fmt.Errorf("--output %q must be NAME=gs://bucket/pattern", output)
+		}
+		outputs[name] = uri
+	}
+	return outputs, nil
+}
+
+// readManifest reads a job manifest from a file, or from stdin if path is "-".
+func readManifest(stdin io.Reader, path string) (*manifest.Job, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	job, err := manifest.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return job, nil
+}
+
+// mergeMaps returns base with the entries of overrides added or replaced.
+func mergeMaps(base, overrides map[string]string) map[string]string {
+	if len(overrides) == 0 {
+		return base
+	}
+	if base == nil {
+		base = make(map[string]string, len(overrides))
+	}
+	maps.Copy(base, overrides)
+	return base
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+)
+
+var usageOpts struct {
+	since    time.Duration
+	start    string
+	end      string
+	selector string
+	by       string
+	csv      bool
+}
+
+var usageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show the resources and estimated cost of finished jobs",
+	Long: `Show the vCPU and memory time used by the tenant's jobs that finished in a
+time range, and their cost estimated from the gateway's price table.
+
+With --by, a row is printed per value of a label; jobs without the label are
+in a row of their own. --csv prints the rows as CSV, e.g. for a spreadsheet.`,
+	Example: `  jennahctl usage --since 168h
+  jennahctl usage --start 2026-09-01T00:00:00Z --end 2026-10-01T00:00:00Z --by team --csv > september.csv`,
+	Args: cobra.NoArgs,
+	RunE: runUsage,
+}
+
+func init() {
+	flags := usageCmd.Flags()
+	flags.DurationVar(&usageOpts.since, "since", 30*24*time.Hour, "Jobs that finished in the last duration; ignored with --start")
+	flags.StringVar(&usageOpts.start, "start", "", "Jobs that finished at or after this RFC 3339 time")
+	flags.StringVar(&usageOpts.end, "end", "", "Jobs that finished before this RFC 3339 time (default now)")
+	flags.StringVarP(&usageOpts.selector, "selector", "l", "", `Label selector, e.g. "team=billing,env!=dev"`)
+	flags.StringVar(&usageOpts.by, "by", "", "Label key to group jobs by")
+	flags.BoolVar(&usageOpts.csv, "csv", false, "Print CSV instead of --output")
+}
+
+func runUsage(cmd *cobra.Command, args []string) error {
+	if usageOpts.since <= 0 && usageOpts.start == "" {
+		return errors.New("--since must be positive")
+	}
+	client, err := newClient(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &jennahv1.GetUsageRequest{
+		StartTime:     usageOpts.start,
+		EndTime:       usageOpts.end,
+		LabelSelector: usageOpts.selector,
+		GroupByLabel:  usageOpts.by,
+	}
+	if req.StartTime == "" {
+		req.StartTime = time.Now().Add(-usageOpts.since).UTC().Format(time.RFC3339)
+	}
+	if usageOpts.csv {
+		req.Format = "csv"
+	}
+	resp, err := client.GetUsage(cmd.Context(), connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if usageOpts.csv {
+		_, err := io.WriteString(cmd.OutOrStdout(), resp.Msg.Csv)
+		return err
+	}
+
+	return printMessage(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+		fmt.Fprintln(w, "LABEL\tJOBS\tVCPU HOURS\tMEMORY GIB HOURS\tCOST")
+		for _, row := range append(resp.Msg.Rows, resp.Msg.Total) {
+			label := orNone(row.LabelValue)
+			if row == resp.Msg.Total {
+				label = "TOTAL"
+			}
+			fmt.Fprintf(w, "%s\t%d\t%.2f\t%.2f\t%s\n", label, row.Jobs, row.VcpuSeconds/3600, row.MemoryGibSeconds/3600, usageCost(resp.Msg.Currency, row))
+		}
+	})
+}
+
+// usageCost shows a row's estimated cost, or "-" if the gateway prices nothing.
+func usageCost(currency string, row *jennahv1.UsageRow) string {
+	if currency == "" {
+		return "-"
+	}
+	cost := strconv.FormatFloat(row.EstimatedCost, 'f', 2, 64) + " " + currency
+	if row.UnpricedJobs > 0 {
+		cost += fmt.Sprintf(" (%d jobs unpriced)", row.UnpricedJobs)
+	}
+	return cost
+}
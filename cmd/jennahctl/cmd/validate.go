@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+)
+
+var validateOffline bool
+
+var validateCmd = &cobra.Command{
+	Use:   "validate MANIFEST...",
+	Short: "Check job manifests without submitting them",
+	Long: `Check job manifests without submitting them. Each manifest is parsed and sent
+to ValidateJob, which runs every check SubmitJob runs, including the tenant's
+quotas and region placement.
+
+With --offline, manifests are only parsed, which needs no login; use it in
+pre-commit hooks. Exits with a non-zero status if any manifest is invalid.`,
+	Example: `  jennahctl validate jobs/*.yaml
+  jennahctl validate --offline jobs/*.yaml`,
+	Args: cobra.MinimumNArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		return []cobra.Completion{"yaml", "yml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+	},
+	RunE: runValidate,
+}
+
+func init() {
+	validateCmd.Flags().BoolVar(&validateOffline, "offline", false, "Only check the manifest format, without calling the gateway")
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	var client jennahv1connect.DeploymentServiceClient
+	if !validateOffline {
+		var err error
+		client, err = newClient(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	invalid := 0
+	for _, path := range args {
+		job, err := readManifest(cmd.InOrStdin(), path)
+		if err != nil {
+			fmt.Fprintln(cmd.ErrOrStderr(), err)
+			invalid++
+			continue
+		}
+		if validateOffline {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: valid\n", path)
+			continue
+		}
+
+		resp, err := client.ValidateJob(ctx, connect.NewRequest(&jennahv1.ValidateJobRequest{Job: job.SubmitJobRequest()}))
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s: %v\n", path, err)
+			invalid++
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: valid, would run in %s\n", path, resp.Msg.Region)
+	}
+
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d manifests are invalid", invalid, len(args))
+	}
+	return nil
+}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+
+	"github.com/alphauslabs/jennah/cmd/worker/service"
+	"github.com/alphauslabs/jennah/internal/egress"
+	"github.com/alphauslabs/jennah/internal/logging"
+	"github.com/alphauslabs/jennah/internal/tracing"
+)
+
+// Config is the worker's configuration. Each setting is resolved, lowest
+// precedence first, from the defaults, the YAML file given by --config or
+// WORKER_CONFIG, environment variables and command-line flags.
+type Config struct {
+	Port                 string           `yaml:"port"`
+	Project              string           `yaml:"project"`
+	Regions              []string         `yaml:"regions"`
+	RegionCapacity       map[string]int64 `yaml:"regionCapacity"`
+	SpannerInstance      string           `yaml:"spannerInstance"`
+	SpannerDatabase      string           `yaml:"spannerDatabase"`
+	GatewayPublicKeyFile string           `yaml:"gatewayPublicKeyFile"`
+	LogFormat            string           `yaml:"logFormat"`
+	LogLevel             string           `yaml:"logLevel"`
+	TraceExporter        string           `yaml:"traceExporter"`
+	TraceSampleRatio     float64          `yaml:"traceSampleRatio"`
+	EventsTopic          string           `yaml:"eventsTopic"`
+	MaxPreemptions       int64            `yaml:"maxPreemptions"`
+	ArtifactRoot         string           `yaml:"artifactRoot"`
+	InsecureRegistries   []string         `yaml:"insecureRegistries"`
+	AllowedWebhookHosts  []string         `yaml:"allowedWebhookHosts"`
+	OutputBuckets        []string         `yaml:"outputBuckets"`
+	WorkerIP             string           `yaml:"workerIp"`
+	WorkerIPs            []string         `yaml:"workerIps"`
+}
+
+// configSetting ties a setting to its flag and environment variable.
+type configSetting struct {
+	flag  string
+	env   string
+	usage string
+	value func(*Config) *string
+}
+
+// Regions, their capacity, the trace sample ratio, max preemptions, insecure
+// registries, allowed webhook hosts, output buckets and the workers are not
+// strings, so they are handled separately
+const (
+	regionsFlag             = "regions"
+	regionsEnv              = "GCP_REGIONS"
+	regionCapacityFlag      = "region-capacity"
+	regionCapacityEnv       = "GCP_REGION_CAPACITY"
+	traceSampleRatioFlag    = "trace-sample-ratio"
+	traceSampleRatioEnv     = "TRACE_SAMPLE_RATIO"
+	maxPreemptionsFlag      = "max-preemptions"
+	maxPreemptionsEnv       = "MAX_PREEMPTIONS"
+	insecureRegistriesFlag  = "insecure-registries"
+	insecureRegistriesEnv   = "INSECURE_REGISTRIES"
+	allowedWebhookHostsFlag = "allowed-webhook-hosts"
+	allowedWebhookHostsEnv  = "ALLOWED_WEBHOOK_HOSTS"
+	outputBucketsFlag       = "output-buckets"
+	outputBucketsEnv        = "OUTPUT_BUCKETS"
+	workerIPsFlag           = "worker-ips"
+	workerIPsEnv            = "WORKER_IPS"
+	configEnv               = "WORKER_CONFIG"
+)
+
+var configSettings = []configSetting{
+	{"port", "WORKER_PORT", "Port to listen on", func(c *Config) *string { return &c.Port }},
+	{"gcp-project", "GCP_PROJECT", "GCP project that runs Batch jobs (required)", func(c *Config) *string { return &c.Project }},
+	{"spanner-instance", "SPANNER_INSTANCE", "Cloud Spanner instance", func(c *Config) *string { return &c.SpannerInstance }},
+	{"spanner-database", "SPANNER_DATABASE", "Cloud Spanner database", func(c *Config) *string { return &c.SpannerDatabase }},
+	{"gateway-public-key", "GATEWAY_PUBLIC_KEY_FILE", "Path to the gateway's PEM Ed25519 public key (required)", func(c *Config) *string { return &c.GatewayPublicKeyFile }},
+	{"log-format", "LOG_FORMAT", "Log output format: text or json", func(c *Config) *string { return &c.LogFormat }},
+	{"log-level", "LOG_LEVEL", "Minimum log level: debug, info, warn or error", func(c *Config) *string { return &c.LogLevel }},
+	{"trace-exporter", "TRACE_EXPORTER", "Trace exporter: none, otlp (endpoint from OTEL_EXPORTER_OTLP_ENDPOINT) or stdout", func(c *Config) *string { return &c.TraceExporter }},
+	{"events-topic", "EVENTS_TOPIC", "Pub/Sub topic to publish job events to, an ID in gcp-project or a full name; empty disables job events", func(c *Config) *string { return &c.EventsTopic }},
+	{"worker-ip", "WORKER_IP", "This worker's entry in worker-ips", func(c *Config) *string { return &c.WorkerIP }},
+	{"artifact-root", "ARTIFACT_ROOT", "Directory to read job outputs from instead of Cloud Storage, as <dir>/<bucket>/<object>; for tests and local runs", func(c *Config) *string { return &c.ArtifactRoot }},
+}
+
+func defaultConfig() Config {
+	return Config{
+		Port:             "8081",
+		Regions:          []string{"asia-northeast1"},
+		SpannerInstance:  "alphaus-dev",
+		SpannerDatabase:  "main",
+		LogFormat:        logging.FormatText,
+		LogLevel:         "info",
+		TraceExporter:    tracing.ExporterNone,
+		TraceSampleRatio: 1,
+		MaxPreemptions:   2,
+	}
+}
+
+// addConfigFlags registers a flag for every setting. Flag defaults are left
+// empty so that an unset flag never overrides the file or environment.
+func addConfigFlags(flags *pflag.FlagSet) {
+	defaults := defaultConfig()
+	for _, setting := range configSettings {
+		usage := fmt.Sprintf("%s (env %s", setting.usage, setting.env)
+		if d := *setting.value(&defaults); d != "" {
+			usage += ", default " + d
+		}
+		flags.String(setting.flag, "", usage+")")
+	}
+	flags.StringSlice(regionsFlag, nil, fmt.Sprintf("Batch regions jobs may run in, most preferred first (env %s, default %s)",
+		regionsEnv, strings.Join(defaults.Regions, ",")))
+	flags.StringToInt64(regionCapacityFlag, nil, fmt.Sprintf("Max vCPU in milli-cores that active jobs may hold per region, e.g. asia-northeast1=64000; unlisted regions are unlimited (env %s)",
+		regionCapacityEnv))
+	flags.Float64(traceSampleRatioFlag, 0, fmt.Sprintf("Fraction of new traces to sample, between 0 and 1; traces the gateway started keep its decision (env %s, default %g)",
+		traceSampleRatioEnv, defaults.TraceSampleRatio))
+	flags.Int64(maxPreemptionsFlag, 0, fmt.Sprintf("Preemptions after which a Spot or preemptible job is resubmitted on standard VMs (env %s, default %d)",
+		maxPreemptionsEnv, defaults.MaxPreemptions))
+	flags.StringSlice(insecureRegistriesFlag, nil, fmt.Sprintf("Registries, as host:port, to resolve image tags from over plain HTTP, e.g. a local test registry; loopback registries such as localhost:5000 must be listed (env %s)",
+		insecureRegistriesEnv))
+	flags.StringSlice(allowedWebhookHostsFlag, nil, fmt.Sprintf("Internal hosts, IP addresses or CIDRs notification webhooks may reach anyway, e.g. a local test server; only for testing (env %s)",
+		allowedWebhookHostsEnv))
+	flags.StringSlice(outputBucketsFlag, nil, fmt.Sprintf("Buckets, or bucket/prefix, job outputs may be in, where %s is the job's tenant, e.g. jennah-outputs/%s/; none disables outputs (env %s)",
+		service.TenantIDPlaceholder, service.TenantIDPlaceholder, outputBucketsEnv))
+	flags.StringSlice(workerIPsFlag, nil, fmt.Sprintf("Every worker, as in the gateway's --worker-ips, so that this one only reconciles the jobs of the tenants routed to it; none reconciles every tenant's jobs (env %s)",
+		workerIPsEnv))
+}
+
+// loadConfig resolves the configuration for a command from its flags, the
+// environment and the optional config file, and validates it.
+func loadConfig(flags *pflag.FlagSet, path string) (*Config, error) {
+	cfg := defaultConfig()
+
+	if path == "" {
+		path = os.Getenv(configEnv)
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+	}
+
+	for _, setting := range configSettings {
+		if v := os.Getenv(setting.env); v != "" {
+			*setting.value(&cfg) = v
+		}
+	}
+	if v := os.Getenv(regionsEnv); v != "" {
+		cfg.Regions = splitList(v)
+	}
+	if v := os.Getenv(regionCapacityEnv); v != "" {
+		capacity, err := parseCapacity(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", regionCapacityEnv, err)
+		}
+		cfg.RegionCapacity = capacity
+	}
+	if v := os.Getenv(traceSampleRatioEnv); v != "" {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %q is not a number", traceSampleRatioEnv, v)
+		}
+		cfg.TraceSampleRatio = ratio
+	}
+	if v := os.Getenv(maxPreemptionsEnv); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %q is not a number", maxPreemptionsEnv, v)
+		}
+		cfg.MaxPreemptions = n
+	}
+	if v := os.Getenv(insecureRegistriesEnv); v != "" {
+		cfg.InsecureRegistries = splitList(v)
+	}
+	if v := os.Getenv(allowedWebhookHostsEnv); v != "" {
+		cfg.AllowedWebhookHosts = splitList(v)
+	}
+	if v := os.Getenv(outputBucketsEnv); v != "" {
+		cfg.OutputBuckets = splitList(v)
+	}
+	if v := os.Getenv(workerIPsEnv); v != "" {
+		cfg.WorkerIPs = splitList(v)
+	}
+
+	for _, setting := range configSettings {
+		if flags.Changed(setting.flag) {
+			v, _ := flags.GetString(setting.flag)
+			*setting.value(&cfg) = v
+		}
+	}
+	if flags.Changed(regionsFlag) {
+		regions, _ := flags.GetStringSlice(regionsFlag)
+		cfg.Regions = regions
+	}
+	if flags.Changed(regionCapacityFlag) {
+		capacity, _ := flags.GetStringToInt64(regionCapacityFlag)
+		cfg.RegionCapacity = capacity
+	}
+	if flags.Changed(traceSampleRatioFlag) {
+		cfg.TraceSampleRatio, _ = flags.GetFloat64(traceSampleRatioFlag)
+	}
+	if flags.Changed(maxPreemptionsFlag) {
+		cfg.MaxPreemptions, _ = flags.GetInt64(maxPreemptionsFlag)
+	}
+	if flags.Changed(insecureRegistriesFlag) {
+		registries, _ := flags.GetStringSlice(insecureRegistriesFlag)
+		cfg.InsecureRegistries = registries
+	}
+	if flags.Changed(allowedWebhookHostsFlag) {
+		hosts, _ := flags.GetStringSlice(allowedWebhookHostsFlag)
+		cfg.AllowedWebhookHosts = hosts
+	}
+	if flags.Changed(outputBucketsFlag) {
+		buckets, _ := flags.GetStringSlice(outputBucketsFlag)
+		cfg.OutputBuckets = buckets
+	}
+	if flags.Changed(workerIPsFlag) {
+		workers, _ := flags.GetStringSlice(workerIPsFlag)
+		cfg.WorkerIPs = workers
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseCapacity parses region=milliCpu pairs separated by commas.
+func parseCapacity(s string) (map[string]int64, error) {
+	capacity := make(map[string]int64)
+	for _, pair := range splitList(s) {
+		region, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not region=milli-cores", pair)
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not region=milli-cores", pair)
+		}
+		capacity[strings.TrimSpace(region)] = n
+	}
+	return capacity, nil
+}
+
+// regionPattern matches GCP region names such as asia-northeast1 or us-central1
+var regionPattern = regexp.MustCompile(`^[a-z]+(-[a-z]+)+[0-9]+$`)
+
+// validate reports every invalid setting at once.
+func (c *Config) validate() error {
+	var errs []error
+	if port, err := strconv.Atoi(c.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("port %q must be a number between 1 and 65535", c.Port))
+	}
+	if c.Project == "" {
+		errs = append(errs, errors.New("gcp-project is required"))
+	}
+	if len(c.Regions) == 0 {
+		errs = append(errs, errors.New("at least one region is required"))
+	}
+	seen := make(map[string]bool)
+	for _, region := range c.Regions {
+		if !regionPattern.MatchString(region) {
+			errs = append(errs, fmt.Errorf("region %q is not a GCP region name", region))
+		}
+		if seen[region] {
+			errs = append(errs, fmt.Errorf("region %q is listed twice", region))
+		}
+		seen[region] = true
+	}
+	for region, limit := range c.RegionCapacity {
+		if !seen[region] {
+			errs = append(errs, fmt.Errorf("region-capacity names %q, which is not in regions", region))
+		}
+		if limit < 0 {
+			errs = append(errs, fmt.Errorf("region-capacity for %q must not be negative", region))
+		}
+	}
+	if c.MaxPreemptions < 1 {
+		errs = append(errs, fmt.Errorf("max-preemptions %d must be positive", c.MaxPreemptions))
+	}
+	for _, registry := range c.InsecureRegistries {
+		if registry == "" || strings.ContainsAny(registry, "/@ ") {
+			errs = append(errs, fmt.Errorf("insecure registry %q must be a host, optionally with a port", registry))
+		}
+	}
+	if _, err := egress.ParseAllowlist(c.AllowedWebhookHosts); err != nil {
+		errs = append(errs, err)
+	}
+	for _, entry := range c.OutputBuckets {
+		bucket, _, _ := strings.Cut(entry, "/")
+		if bucket == "" || strings.Contains(entry, "://") || strings.ContainsAny(entry, "*?[\\ ") {
+			errs = append(errs, fmt.Errorf("output bucket %q must be a bucket name, optionally followed by /prefix", entry))
+		}
+	}
+	if len(c.WorkerIPs) > 0 && !slices.Contains(c.WorkerIPs, c.WorkerIP) {
+		errs = append(errs, fmt.Errorf("worker-ip %q must be one of worker-ips", c.WorkerIP))
+	}
+	if len(c.WorkerIPs) == 0 && c.WorkerIP != "" {
+		errs = append(errs, errors.New("worker-ip requires worker-ips"))
+	}
+	if c.SpannerInstance == "" || c.SpannerDatabase == "" {
+		errs = append(errs, errors.New("spanner-instance and spanner-database are required"))
+	}
+	if c.GatewayPublicKeyFile == "" {
+		errs = append(errs, errors.New("gateway-public-key is required"))
+	}
+	if _, err := logging.New(io.Discard, c.LogFormat, c.LogLevel); err != nil {
+		errs = append(errs, err)
+	}
+	switch c.TraceExporter {
+	case tracing.ExporterNone, tracing.ExporterOTLP, tracing.ExporterStdout:
+	default:
+		errs = append(errs, fmt.Errorf("trace-exporter %q must be %s, %s or %s",
+			c.TraceExporter, tracing.ExporterNone, tracing.ExporterOTLP, tracing.ExporterStdout))
+	}
+	if c.TraceSampleRatio < 0 || c.TraceSampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("trace-sample-ratio %g must be between 0 and 1", c.TraceSampleRatio))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid worker configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
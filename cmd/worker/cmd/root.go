@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var configFile string
+
+var rootCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Jennah Worker",
+	Long:  `Worker that runs tenant jobs on GCP Batch and records their lifecycle in Cloud Spanner.`,
+}
+
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Path to a YAML config file (env "+configEnv+")")
+	rootCmd.AddCommand(serveCmd)
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	batch "cloud.google.com/go/batch/apiv1"
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2/google"
+	cloudlogging "google.golang.org/api/logging/v2"
+
+	"github.com/alphauslabs/jennah/cmd/worker/service"
+	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/auth"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/egress"
+	"github.com/alphauslabs/jennah/internal/events"
+	"github.com/alphauslabs/jennah/internal/hashing"
+	"github.com/alphauslabs/jennah/internal/logging"
+	"github.com/alphauslabs/jennah/internal/metrics"
+	"github.com/alphauslabs/jennah/internal/registry"
+	"github.com/alphauslabs/jennah/internal/storage"
+	"github.com/alphauslabs/jennah/internal/tracing"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Start the worker server",
+	Long:  `Start the worker server to accept jobs from the gateway and run them on GCP Batch.`,
+	RunE:  runServe,
+}
+
+func init() {
+	addConfigFlags(serveCmd.Flags())
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig(cmd.Flags(), configFile)
+	if err != nil {
+		return err
+	}
+	if err := logging.Setup(cfg.LogFormat, cfg.LogLevel); err != nil {
+		return err
+	}
+	slog.Info("Starting worker")
+
+	ctx := context.Background()
+
+	shutdownTracing, err := tracing.Setup(ctx, "jennah-worker", cfg.TraceExporter, cfg.TraceSampleRatio)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Warn("Failed to flush traces", "error", err)
+		}
+	}()
+
+	dbClient, err := database.NewClient(ctx, cfg.Project, cfg.SpannerInstance, cfg.SpannerDatabase)
+	if err != nil {
+		return fmt.Errorf("failed to create database client: %w", err)
+	}
+	defer dbClient.Close()
+	slog.Info("Connected to Spanner", "project", cfg.Project, "instance", cfg.SpannerInstance, "database", cfg.SpannerDatabase)
+
+	batchClient, err := batch.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create GCP Batch client: %w", err)
+	}
+	defer batchClient.Close()
+	slog.Info("Connected to GCP Batch API", "regions", cfg.Regions)
+
+	logClient, err := cloudlogging.NewService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create Cloud Logging client: %w", err)
+	}
+
+	var store storage.Store
+	if cfg.ArtifactRoot != "" {
+		store = storage.NewLocalStore(cfg.ArtifactRoot)
+		slog.Info("Reading job outputs from a local directory", "path", cfg.ArtifactRoot)
+	} else if store, err = storage.NewGCSStore(ctx); err != nil {
+		return err
+	}
+	defer store.Close()
+
+	// Image tags are resolved with the worker's Google credentials, which Batch
+	// also pulls images with
+	registryTokens, err := google.DefaultTokenSource(ctx, "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		return fmt.Errorf("failed to get Google credentials for image registries: %w", err)
+	}
+	// Validated with the rest of the configuration
+	webhookAllowlist, _ := egress.ParseAllowlist(cfg.AllowedWebhookHosts)
+	if webhookAllowlist != nil {
+		slog.Warn("Notification webhooks may reach internal hosts", "hosts", cfg.AllowedWebhookHosts)
+	}
+	resolver := registry.NewResolver(registryTokens, cfg.InsecureRegistries)
+	if len(cfg.InsecureRegistries) > 0 {
+		slog.Warn("Resolving image tags over plain HTTP", "registries", cfg.InsecureRegistries)
+	}
+
+	// Events are queued in the transactions that cause them, so the client must
+	// queue them before the worker accepts jobs
+	var eventPublisher events.Publisher
+	if cfg.EventsTopic != "" {
+		eventPublisher, err = events.NewPubSubPublisher(ctx, cfg.Project, cfg.EventsTopic)
+		if err != nil {
+			return err
+		}
+		dbClient.EnableJobEvents()
+		slog.Info("Publishing job events", "topic", cfg.EventsTopic)
+	}
+
+	tokenVerifier, err := auth.NewTokenVerifierFromFiles(cfg.GatewayPublicKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load gateway public key: %w", err)
+	}
+	slog.Info("Loaded gateway public key", "path", cfg.GatewayPublicKeyFile)
+
+	workerServer := service.NewWorkerServer(dbClient, batchClient, logClient, store, cfg.OutputBuckets, resolver, cfg.Project, cfg.Regions, cfg.RegionCapacity)
+
+	// The gateway is trusted, so its trace context is continued rather than linked
+	tracingInterceptor, err := tracing.NewInterceptor(true)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	path, handler := jennahv1connect.NewDeploymentServiceHandler(
+		workerServer,
+		connect.WithInterceptors(metrics.NewInterceptor(), tracingInterceptor, logging.NewInterceptor(), service.NewGatewayAuthInterceptor(tokenVerifier)),
+	)
+	mux.Handle(path, handler)
+
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("OK"))
+	})
+
+	mux.Handle("/metrics", metrics.Handler())
+
+	addr := fmt.Sprintf("0.0.0.0:%s", cfg.Port)
+	server := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	sigCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go service.RunJobMetrics(sigCtx, dbClient)
+	var router *hashing.Router
+	if len(cfg.WorkerIPs) > 0 {
+		router = hashing.NewRouter(cfg.WorkerIPs)
+		slog.Info("Reconciling the jobs of tenants routed to this worker", "worker", cfg.WorkerIP, "workers", cfg.WorkerIPs)
+	} else {
+		slog.Warn("worker-ips is not set; reconciling every tenant's jobs")
+	}
+	go service.RunStatusReconciler(sigCtx, dbClient, batchClient, router, cfg.WorkerIP, store, cfg.OutputBuckets, cfg.MaxPreemptions)
+	go service.RunNotificationDispatcher(sigCtx, dbClient, webhookAllowlist)
+	if eventPublisher != nil {
+		go service.RunEventPublisher(sigCtx, dbClient, eventPublisher)
+	}
+
+	go func() {
+		slog.Info("Worker listening", "addr", addr, "project", cfg.Project, "regions", cfg.Regions,
+			"services", []string{path}, "endpoints", []string{"GET /health", "GET /metrics"})
+
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Error("Failed to start server", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	<-sigCtx.Done()
+	slog.Info("Shutdown signal received, gracefully shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("Error during server shutdown", "error", err)
+	}
+
+	slog.Info("Worker stopped")
+	return nil
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/labels"
+	"github.com/alphauslabs/jennah/internal/logging"
+	"github.com/alphauslabs/jennah/internal/storage"
+)
+
+const (
+	maxJobOutputs = 10
+	// Objects beyond this many per output are not recorded, so that a pattern
+	// matching a whole bucket cannot flood Spanner
+	maxOutputArtifacts = 1000
+	// Listing stops after this many objects per output, matching or not, so
+	// that a broad prefix cannot have the reconciler walk a whole bucket
+	maxOutputListed = 10 * maxOutputArtifacts
+)
+
+// The placeholder in output URI patterns for the job's ID, and the variable
+// the job's tasks read it from to know where to write
+const (
+	jobIDPlaceholder = "{job_id}"
+	jobIDEnvVar      = "JENNAH_JOB_ID"
+)
+
+// TenantIDPlaceholder stands for the job's tenant in output bucket allowlist
+// entries, e.g. "jennah-outputs/{tenant_id}/".
+const TenantIDPlaceholder = "{tenant_id}"
+
+// validateOutputs checks a job's declared outputs: names follow the label key
+// rules, and each URI is a gs:// pattern in a fixed bucket that outputBuckets
+// allows the tenant to write to.
+func validateOutputs(outputs map[string]string, tenantId string, outputBuckets []string) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+	if len(outputBuckets) == 0 {
+		return errors.New("outputs are not enabled on this worker")
+	}
+	if len(outputs) > maxJobOutputs {
+		return fmt.Errorf("at most %d outputs are allowed, got %d", maxJobOutputs, len(outputs))
+	}
+	names := make(map[string]string, len(outputs))
+	for name := range outputs {
+		names[name] = ""
+	}
+	if err := labels.Validate(names); err != nil {
+		return fmt.Errorf("output names: %w", err)
+	}
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(outputs)) {
+		bucket, pattern, err := outputPattern(outputs[name], "00000000-0000-0000-0000-000000000000")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("output %s: %w", name, err))
+		} else if !outputAllowed(outputBuckets, tenantId, bucket, pattern) {
+			errs = append(errs, fmt.Errorf("output %s: gs://%s/%s is not in an allowed output location (%s)",
+				name, bucket, literalPrefix(pattern), strings.ReplaceAll(strings.Join(outputBuckets, ", "), TenantIDPlaceholder, tenantId)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// outputAllowed reports whether every object an output pattern can match is
+// in one of outputBuckets: a bucket name, or a bucket and the prefix object
+// names must start with, in which TenantIDPlaceholder is the job's tenant.
+func outputAllowed(outputBuckets []string, tenantId, bucket, pattern string) bool {
+	for _, entry := range outputBuckets {
+		allowedBucket, prefix, _ := strings.Cut(strings.ReplaceAll(entry, TenantIDPlaceholder, tenantId), "/")
+		if bucket == allowedBucket && strings.HasPrefix(literalPrefix(pattern), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// outputPattern splits an output's URI pattern, with jobID in place of its
+// placeholder, into its bucket and object name pattern.
+func outputPattern(uriPattern, jobID string) (bucket, pattern string, err error) {
+	bucket, pattern, err = storage.ParseURI(strings.ReplaceAll(uriPattern, jobIDPlaceholder, jobID))
+	switch {
+	case err != nil:
+		return "", "", err
+	case strings.ContainsAny(bucket, "*?[\\{}"):
+		return "", "", fmt.Errorf("bucket %q must not be a pattern", bucket)
+	case pattern == "":
+		return "", "", fmt.Errorf("%q names no objects", uriPattern)
+	case strings.ContainsAny(pattern, "{}"):
+		return "", "", fmt.Errorf("%q has an unknown placeholder; only %s is supported", uriPattern, jobIDPlaceholder)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", "", fmt.Errorf("%q is not a valid pattern", uriPattern)
+	}
+	return bucket, pattern, nil
+}
+
+// literalPrefix returns the part of an object name pattern before its first
+// wildcard, which every matching object name starts with.
+func literalPrefix(pattern string) string {
+	if i := strings.IndexAny(pattern, "*?[\\"); i >= 0 {
+		return pattern[:i]
+	}
+	return pattern
+}
+
+// outputQuery selects the objects an output pattern can match: those under its
+// literal prefix, only one level down when no "/" follows its first wildcard,
+// since wildcards do not match "/".
+func outputQuery(pattern string) storage.Query {
+	prefix := literalPrefix(pattern)
+	query := storage.Query{Prefix: prefix, Limit: maxOutputListed}
+	if !strings.Contains(pattern[len(prefix):], "/") {
+		query.Delimiter = "/"
+	}
+	return query
+}
+
+// recordJobArtifacts records the objects that match a completed job's declared
+// outputs. An object that matches several outputs is recorded under the first
+// by name. Outputs no longer in outputBuckets are skipped. Failures are logged:
+// the job has completed either way.
+func recordJobArtifacts(ctx context.Context, dbClient *database.Client, store storage.Store, outputBuckets []string, job *database.Job) {
+	outputs := labels.Split(job.Outputs)
+	if len(outputs) == 0 {
+		return
+	}
+	attrs := []any{"tenant_id", job.TenantId, "job_id", job.JobId}
+
+	var artifacts []*database.JobArtifact
+	seen := make(map[string]bool)
+	for _, name := range slices.Sorted(maps.Keys(outputs)) {
+		bucket, pattern, err := outputPattern(outputs[name], job.JobId)
+		if err != nil {
+			slog.WarnContext(ctx, "Invalid job output", append(attrs, "output", name, "error", err)...)
+			continue
+		}
+		if !outputAllowed(outputBuckets, job.TenantId, bucket, pattern) {
+			slog.WarnContext(ctx, "Job output is not in an allowed output location", append(attrs, "output", name, "uri", outputs[name])...)
+			continue
+		}
+		objects, err := store.List(ctx, bucket, outputQuery(pattern))
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to list job output", append(attrs, "output", name, "error", err)...)
+			continue
+		}
+		if len(objects) == maxOutputListed {
+			slog.WarnContext(ctx, "Job output lists too many objects; the rest are not checked",
+				append(attrs, "output", name, "limit", maxOutputListed)...)
+		}
+
+		matched := 0
+		for _, object := range objects {
+			if ok, _ := path.Match(pattern, object.Name); !ok || seen[object.URI()] {
+				continue
+			}
+			if matched == maxOutputArtifacts {
+				slog.WarnContext(ctx, "Job output has too many objects; the rest are not recorded",
+					append(attrs, "output", name, "limit", maxOutputArtifacts)...)
+				break
+			}
+			matched++
+			seen[object.URI()] = true
+			artifacts = append(artifacts, &database.JobArtifact{
+				TenantId:  job.TenantId,
+				JobId:     job.JobId,
+				Uri:       object.URI(),
+				Output:    name,
+				SizeBytes: object.Size,
+				Crc32c:    object.Checksum(),
+				UpdatedAt: object.Updated,
+			})
+		}
+		if matched == 0 {
+			slog.WarnContext(ctx, "Job output matched no objects", append(attrs, "output", name, "uri", outputs[name])...)
+		}
+	}
+
+	if err := dbClient.RecordJobArtifacts(ctx, artifacts); err != nil {
+		slog.WarnContext(ctx, "Failed to record job artifacts", append(attrs, "error", err)...)
+		return
+	}
+	slog.InfoContext(ctx, "Job artifacts recorded", append(attrs, "artifacts", len(artifacts))...)
+}
+
+// ListJobArtifacts lists the objects recorded for a completed job's declared
+// outputs.
+func (s *WorkerServer) ListJobArtifacts(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListJobArtifactsRequest],
+) (*connect.Response[jennahv1.ListJobArtifactsResponse], error) {
+	tenantId, err := tenantIdFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+	logging.SetJobID(ctx, req.Msg.JobId)
+
+	job, err := s.getJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := labels.Split(job.Outputs)[req.Msg.Output]; req.Msg.Output != "" && !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("job %s has no output %q", job.JobId, req.Msg.Output))
+	}
+
+	artifacts, err := s.dbClient.ListJobArtifacts(ctx, tenantId, job.JobId, req.Msg.Output)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job artifacts", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list job artifacts: %w", err))
+	}
+	resp := &jennahv1.ListJobArtifactsResponse{Artifacts: make([]*jennahv1.JobArtifact, 0, len(artifacts))}
+	for _, a := range artifacts {
+		resp.Artifacts = append(resp.Artifacts, &jennahv1.JobArtifact{
+			Output:     a.Output,
+			Uri:        a.Uri,
+			SizeBytes:  a.SizeBytes,
+			Crc32C:     a.Crc32c,
+			UpdatedAt:  a.UpdatedAt.Format(time.RFC3339),
+			RecordedAt: a.RecordedAt.Format(time.RFC3339),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/alphauslabs/jennah/internal/storage"
+)
+
+func TestOutputMatching(t *testing.T) {
+	const jobID = "job-1"
+	root := t.TempDir()
+	for _, name := range []string{
+		"t1/job-1/report.csv",
+		"t1/job-1/summary.csv",
+		"t1/job-1/notes.txt",
+		"t1/job-1/part/0.parquet",
+		"t1/job-1/part/1.parquet",
+		"t1/job-1/part/nested/2.parquet",
+		"t1/job-10/report.csv",
+		"t1/job-2/report.csv",
+	} {
+		file := filepath.Join(root, "outputs", filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store := storage.NewLocalStore(root)
+
+	tests := []struct {
+		uri  string
+		want []string
+	}{
+		{"gs://outputs/t1/{job_id}/report.csv", []string{"t1/job-1/report.csv"}},
+		{"gs://outputs/t1/{job_id}/*.csv", []string{"t1/job-1/report.csv", "t1/job-1/summary.csv"}},
+		{"gs://outputs/t1/{job_id}/part/*.parquet", []string{"t1/job-1/part/0.parquet", "t1/job-1/part/1.parquet"}},
+		{"gs://outputs/t1/{job_id}/*/*.parquet", []string{"t1/job-1/part/0.parquet", "t1/job-1/part/1.parquet"}},
+		{"gs://outputs/t1/{job_id}/[rs]*", []string{"t1/job-1/report.csv", "t1/job-1/summary.csv"}},
+		{"gs://outputs/t1/{job_id}/missing.csv", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			bucket, pattern, err := outputPattern(tt.uri, jobID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			objects, err := store.List(context.Background(), bucket, outputQuery(pattern))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, object := range objects {
+				if ok, _ := path.Match(pattern, object.Name); ok {
+					got = append(got, object.Name)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("%s matched %v, want %v", tt.uri, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutputAllowed(t *testing.T) {
+	outputBuckets := []string{"shared", "outputs/" + TenantIDPlaceholder + "/"}
+	tests := []struct {
+		uri  string
+		want bool
+	}{
+		{"gs://shared/anything/*.csv", true},
+		{"gs://outputs/t1/{job_id}/*.csv", true},
+		{"gs://outputs/t1/report.csv", true},
+		{"gs://outputs/t2/{job_id}/*.csv", false},
+		{"gs://outputs/t1*/report.csv", false},
+		{"gs://outputs/*/report.csv", false},
+		{"gs://other/t1/report.csv", false},
+	}
+	for _, tt := range tests {
+		bucket, pattern, err := outputPattern(tt.uri, "job-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := outputAllowed(outputBuckets, "t1", bucket, pattern); got != tt.want {
+			t.Errorf("outputAllowed(%s) = %v, want %v", tt.uri, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/alphauslabs/jennah/internal/auth"
+	"github.com/alphauslabs/jennah/internal/logging"
+)
+
+type tenantIdKey struct{}
+
+// tenantIdFromContext returns the tenant asserted by the gateway's signed token.
+func tenantIdFromContext(ctx context.Context) (string, error) {
+	tenantId, ok := ctx.Value(tenantIdKey{}).(string)
+	if !ok || tenantId == "" {
+		return "", errors.New("request is not authenticated")
+	}
+	return tenantId, nil
+}
+
+// NewGatewayAuthInterceptor rejects requests that do not carry a valid,
+// unexpired token signed by the gateway, and exposes the tenant ID it carries
+// to handlers.
+func NewGatewayAuthInterceptor(verifier *auth.TokenVerifier) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			scheme, token, ok := strings.Cut(req.Header().Get("Authorization"), " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing gateway token"))
+			}
+			tenantId, err := verifier.Verify(strings.TrimSpace(token))
+			if err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+			logging.SetTenantID(ctx, tenantId)
+			return next(context.WithValue(ctx, tenantIdKey{}, tenantId), req)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/batch/apiv1/batchpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// maskedValue replaces environment variable values in dry run output
+const maskedValue = "********"
+
+// dryRunBatchJob converts a Batch job to its JSON form for a dry run response.
+// Environment variable values may hold credentials, like in logs, so they are
+// masked; their names are kept.
+func dryRunBatchJob(job *batchpb.Job) (*structpb.Struct, error) {
+	job = proto.Clone(job).(*batchpb.Job)
+	for _, group := range job.TaskGroups {
+		maskEnvironment(group.GetTaskSpec().GetEnvironment())
+		for _, runnable := range group.GetTaskSpec().GetRunnables() {
+			maskEnvironment(runnable.GetEnvironment())
+		}
+	}
+
+	data, err := protojson.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Batch job: %w", err)
+	}
+	batchJob := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, batchJob); err != nil {
+		return nil, fmt.Errorf("failed to encode Batch job: %w", err)
+	}
+	return batchJob, nil
+}
+
+func maskEnvironment(env *batchpb.Environment) {
+	if env == nil {
+		return
+	}
+	for _, vars := range []map[string]string{env.Variables, env.SecretVariables} {
+		for name := range vars {
+			vars[name] = maskedValue
+		}
+	}
+	env.EncryptedVariables = nil
+}
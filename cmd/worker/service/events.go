@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "github.com/alphauslabs/jennah/gen/proto/events/v1"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/events"
+	"github.com/alphauslabs/jennah/internal/labels"
+	"github.com/alphauslabs/jennah/internal/metrics"
+)
+
+// How often the worker looks for job events to publish, and how many it
+// claims at a time. A claim hides an event from the other workers for
+// eventLease.
+const (
+	eventInterval  = 5 * time.Second
+	eventBatchSize = 100
+	eventLease     = time.Minute
+)
+
+// Failed publishes are retried with exponential backoff from eventBackoff up to
+// eventMaxBackoff, indefinitely: a job's later events wait for it, so it is
+// never skipped.
+const (
+	eventBackoff    = 5 * time.Second
+	eventMaxBackoff = 5 * time.Minute
+)
+
+// RunEventPublisher publishes queued job events until ctx is cancelled, then
+// closes publisher.
+func RunEventPublisher(ctx context.Context, dbClient *database.Client, publisher events.Publisher) {
+	defer publisher.Close()
+	ticker := time.NewTicker(eventInterval)
+	defer ticker.Stop()
+
+	for {
+		// A job's next event can only be claimed once the previous one is
+		// published, so claim again straight away while events go out
+		for ctx.Err() == nil {
+			if publishJobEvents(ctx, dbClient, publisher) == 0 {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishJobEvents publishes one batch of due events and returns how many
+// were published.
+func publishJobEvents(ctx context.Context, dbClient *database.Client, publisher events.Publisher) int64 {
+	ctx, cancel := context.WithTimeout(ctx, eventLease)
+	defer cancel()
+
+	due, err := dbClient.ClaimJobEvents(ctx, eventBatchSize, eventLease)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to claim job events", "error", err)
+		return 0
+	}
+
+	// Each claimed event belongs to a different job, so they can go out in parallel
+	var published atomic.Int64
+	var wg sync.WaitGroup
+	for _, event := range due {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if publishJobEvent(ctx, dbClient, publisher, event) {
+				published.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	return published.Load()
+}
+
+// publishJobEvent publishes event and records the outcome, reporting whether
+// it was published.
+func publishJobEvent(ctx context.Context, dbClient *database.Client, publisher events.Publisher, event *database.JobEvent) bool {
+	attrs := []any{"tenant_id", event.TenantId, "job_id", event.JobId, "sequence", event.Sequence, "event_type", event.EventType}
+
+	job, err := dbClient.GetJob(ctx, event.TenantId, event.JobId)
+	if err == nil {
+		err = publisher.Publish(ctx, jobEventToProto(event, job))
+	}
+
+	now := time.Now()
+	if err == nil {
+		event.PublishedAt, event.NextAttemptAt = &now, nil
+		metrics.JobEventsPublished.WithLabelValues("published").Inc()
+	} else {
+		event.Attempts++
+		msg := err.Error()
+		event.LastError = &msg
+		next := now.Add(min(eventBackoff<<min(event.Attempts-1, 10), eventMaxBackoff))
+		event.NextAttemptAt = &next
+		metrics.JobEventsPublished.WithLabelValues("error").Inc()
+		slog.WarnContext(ctx, "Failed to publish job event, will retry", append(attrs, "attempts", event.Attempts, "next_attempt_at", next, "error", err)...)
+	}
+
+	if err := dbClient.RecordJobEventAttempt(ctx, event); err != nil {
+		// The claim expires and the event is published again, so it may arrive twice
+		slog.WarnContext(ctx, "Failed to record job event attempt", append(attrs, "error", err)...)
+		return false
+	}
+	return event.PublishedAt != nil
+}
+
+// jobEventToProto builds the published event from its queued row and the job's
+// current details.
+func jobEventToProto(event *database.JobEvent, job *database.Job) *eventsv1.JobEvent {
+	protoEvent := &eventsv1.JobEvent{
+		EventId:    event.EventId,
+		Type:       eventsv1.JobEvent_Type(eventsv1.JobEvent_Type_value[event.EventType]),
+		Sequence:   event.Sequence,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+		TenantId:   event.TenantId,
+		JobId:      event.JobId,
+		Status:     event.ToStatus,
+		ImageUri:   job.ImageUri,
+		Labels:     labels.Split(job.Labels),
+		TaskCount:  job.TaskCount,
+	}
+	if event.FromStatus != nil {
+		protoEvent.PreviousStatus = *event.FromStatus
+	}
+	if event.Reason != nil {
+		protoEvent.Reason = *event.Reason
+	}
+	if job.Location != nil {
+		protoEvent.Region = *job.Location
+	}
+	if job.CpuMilli != nil {
+		protoEvent.CpuMilli = *job.CpuMilli
+	}
+	if job.MemoryMib != nil {
+		protoEvent.MemoryMib = *job.MemoryMib
+	}
+	if job.TemplateId != nil {
+		protoEvent.TemplateId = *job.TemplateId
+	}
+	if job.TemplateRevision != nil {
+		protoEvent.TemplateRevision = *job.TemplateRevision
+	}
+	return protoEvent
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/alphauslabs/jennah/internal/imageref"
+	"github.com/alphauslabs/jennah/internal/registry"
+)
+
+// resolveImage parses a job's image and resolves its tag to a digest. It
+// returns a connect error with CodeInvalidArgument for images that are malformed,
+// do not exist or are on a loopback registry not listed as insecure,
+// CodeFailedPrecondition for images the worker may not pull and
+// CodeUnavailable when the registry cannot be reached.
+func (s *WorkerServer) resolveImage(ctx context.Context, image string) (imageref.Reference, error) {
+	ref, err := imageref.Parse(image)
+	if err != nil {
+		return ref, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resolved, err := s.resolver.Resolve(ctx, ref)
+	switch {
+	case err == nil:
+		slog.DebugContext(ctx, "Resolved image", "image_uri", image, "image_digest", resolved.Digest)
+		return resolved, nil
+	case errors.Is(err, registry.ErrNotFound), errors.Is(err, registry.ErrLoopbackRegistry):
+		return ref, connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, registry.ErrUnauthorized):
+		return ref, connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		slog.WarnContext(ctx, "Failed to resolve image", "image_uri", image, "error", err)
+		return ref, connect.NewError(connect.CodeUnavailable, err)
+	}
+}
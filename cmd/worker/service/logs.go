@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/batch/apiv1/batchpb"
+	"connectrpc.com/connect"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/internal/logging"
+	"github.com/alphauslabs/jennah/internal/metrics"
+)
+
+// Page sizes for GetJobLogs
+const (
+	defaultLogPageSize = 200
+	maxLogPageSize     = 1000
+)
+
+// GetJobLogs reads what a job's tasks wrote to stdout and stderr. GCP Batch
+// sends these to Cloud Logging as batch_task_logs, labelled with the Batch
+// job's UID.
+func (s *WorkerServer) GetJobLogs(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobLogsRequest],
+) (*connect.Response[jennahv1.GetJobLogsResponse], error) {
+	tenantId, err := tenantIdFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+	logging.SetJobID(ctx, req.Msg.JobId)
+
+	pageSize := int64(req.Msg.PageSize)
+	switch {
+	case pageSize < 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("page_size must not be negative"))
+	case pageSize == 0:
+		pageSize = defaultLogPageSize
+	case pageSize > maxLogPageSize:
+		pageSize = maxLogPageSize
+	}
+	var since time.Time
+	if req.Msg.Since != "" {
+		since, err = time.Parse(time.RFC3339Nano, req.Msg.Since)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since must be an RFC 3339 time: %w", err))
+		}
+	}
+
+	job, err := s.getJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	// A job that never reached Batch has written nothing
+	if job.GcpBatchJobName == nil {
+		return connect.NewResponse(&jennahv1.GetJobLogsResponse{}), nil
+	}
+
+	start := time.Now()
+	batchJob, err := s.batchClient.GetJob(ctx, &batchpb.GetJobRequest{Name: *job.GcpBatchJobName})
+	metrics.ObserveBatch("GetJob", start, err)
+	if status.Code(err) == codes.NotFound {
+		// Logs are only keyed by the UID of the Batch job, which is gone
+		return connect.NewResponse(&jennahv1.GetJobLogsResponse{}), nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to read GCP Batch job", "batch_job_name", *job.GcpBatchJobName, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get GCP Batch job: %w", err))
+	}
+
+	filter := fmt.Sprintf(`logName="projects/%s/logs/batch_task_logs" AND labels.job_uid=%s`,
+		s.projectId, strconv.Quote(batchJob.Uid))
+	if !since.IsZero() {
+		filter += fmt.Sprintf(` AND timestamp>"%s"`, since.UTC().Format(time.RFC3339Nano))
+	}
+
+	resp, err := s.logClient.Entries.List(&cloudlogging.ListLogEntriesRequest{
+		ResourceNames: []string{"projects/" + s.projectId},
+		Filter:        filter,
+		OrderBy:       "timestamp asc",
+		PageSize:      pageSize,
+		PageToken:     req.Msg.PageToken,
+	}).Context(ctx).Do()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to read job logs", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read job logs: %w", err))
+	}
+
+	entries := make([]*jennahv1.LogEntry, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		entries = append(entries, &jennahv1.LogEntry{
+			Timestamp: entry.Timestamp,
+			Severity:  entry.Severity,
+			Message:   entry.TextPayload,
+			TaskId:    entry.Labels["task_id"],
+		})
+	}
+	return connect.NewResponse(&jennahv1.GetJobLogsResponse{
+		Entries:       entries,
+		NextPageToken: resp.NextPageToken,
+	}), nil
+}
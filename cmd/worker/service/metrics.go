@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/metrics"
+)
+
+// How often job counts by status are refreshed from Spanner. Every worker
+// reports the same totals, so aggregate with max() rather than sum().
+const jobMetricsInterval = time.Minute
+
+// RunJobMetrics updates the jobs-by-status gauge until ctx is cancelled.
+func RunJobMetrics(ctx context.Context, dbClient *database.Client) {
+	ticker := time.NewTicker(jobMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		refreshJobMetrics(ctx, dbClient)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func refreshJobMetrics(ctx context.Context, dbClient *database.Client) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	counts, err := dbClient.CountJobsByStatus(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to refresh job metrics", "error", err)
+		return
+	}
+	metrics.Jobs.Reset()
+	for status, count := range counts {
+		metrics.Jobs.WithLabelValues(status).Set(float64(count))
+	}
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/egress"
+	"github.com/alphauslabs/jennah/internal/labels"
+	"github.com/alphauslabs/jennah/internal/metrics"
+)
+
+// How often the worker looks for notifications to send, and how many it claims
+// at a time. Deliveries are queued in the transaction that records a job's
+// transition, so every worker may send them; a claim hides a delivery from the
+// other workers for notificationLease.
+const (
+	notificationInterval   = 5 * time.Second
+	notificationBatchSize  = 20
+	notificationLease      = time.Minute
+	notificationTimeout    = 10 * time.Second
+	notificationMaxAttempt = 8
+)
+
+// Retries back off exponentially from notificationBackoff, up to
+// notificationMaxBackoff, so a delivery is dead-lettered roughly an hour after
+// its first attempt.
+const (
+	notificationBackoff    = 30 * time.Second
+	notificationMaxBackoff = time.Hour
+)
+
+// notificationEvent is the "json" format payload.
+type notificationEvent struct {
+	DeliveryId string            `json:"delivery_id"`
+	Event      string            `json:"event"`
+	TenantId   string            `json:"tenant_id"`
+	JobId      string            `json:"job_id"`
+	FromStatus string            `json:"from_status"`
+	ToStatus   string            `json:"to_status"`
+	Reason     string            `json:"reason,omitempty"`
+	OccurredAt string            `json:"occurred_at"`
+	ImageUri   string            `json:"image_uri,omitempty"`
+	Region     string            `json:"region,omitempty"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// slackMessage is the "slack" format payload, accepted by Slack incoming webhooks
+// and the many services compatible with them.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// RunNotificationDispatcher sends queued job notifications until ctx is cancelled.
+// allow lists the internal hosts and networks webhooks may reach anyway.
+func RunNotificationDispatcher(ctx context.Context, dbClient *database.Client, allow *egress.Allowlist) {
+	// Subscription URLs are chosen by tenants, so internal addresses and redirects are refused
+	httpClient := egress.NewClient(notificationTimeout, allow)
+	ticker := time.NewTicker(notificationInterval)
+	defer ticker.Stop()
+
+	for {
+		dispatchNotifications(ctx, dbClient, httpClient)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func dispatchNotifications(ctx context.Context, dbClient *database.Client, httpClient *http.Client) {
+	ctx, cancel := context.WithTimeout(ctx, notificationLease)
+	defer cancel()
+
+	due, err := dbClient.ClaimDueNotificationDeliveries(ctx, notificationBatchSize, notificationLease)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to claim notification deliveries", "error", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, n := range due {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deliverNotification(ctx, dbClient, httpClient, n)
+		}()
+	}
+	wg.Wait()
+}
+
+// deliverNotification makes one attempt at sending n and records the outcome:
+// DELIVERED on a 2xx response, otherwise a retry with backoff, or DEAD_LETTER
+// once notificationMaxAttempt attempts have failed.
+func deliverNotification(ctx context.Context, dbClient *database.Client, httpClient *http.Client, n *database.DueNotification) {
+	d := &n.Delivery
+	attrs := []any{"tenant_id", d.TenantId, "job_id", d.JobId, "subscription_id", d.SubscriptionId, "delivery_id", d.DeliveryId}
+
+	// Job details are best effort; the transition itself is in the delivery
+	job, err := dbClient.GetJob(ctx, d.TenantId, d.JobId)
+	if err != nil {
+		job = nil
+	}
+	body, err := notificationPayload(n, job)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to encode notification", append(attrs, "error", err)...)
+		return
+	}
+
+	now := time.Now()
+	code, err := sendNotification(ctx, httpClient, n.Subscription.Url, n.Subscription.Secret, d.DeliveryId, body, now)
+	result := applyNotificationAttempt(d, now, code, err)
+	switch result {
+	case database.DeliveryStatusDelivered:
+		metrics.NotificationDeliveries.WithLabelValues("delivered").Inc()
+	case database.DeliveryStatusDeadLetter:
+		metrics.NotificationDeliveries.WithLabelValues("dead_letter").Inc()
+		slog.WarnContext(ctx, "Notification dead-lettered", append(attrs, "attempts", d.Attempts, "error", err)...)
+	default:
+		metrics.NotificationDeliveries.WithLabelValues("retry").Inc()
+		slog.InfoContext(ctx, "Notification failed, will retry", append(attrs, "attempts", d.Attempts, "next_attempt_at", *d.NextAttemptAt, "error", err)...)
+	}
+
+	if err := dbClient.RecordNotificationAttempt(ctx, d); err != nil {
+		// The claim expires and the delivery is sent again, so it may arrive twice
+		slog.WarnContext(ctx, "Failed to record notification attempt", append(attrs, "result", result, "error", err)...)
+		return
+	}
+	slog.DebugContext(ctx, "Notification attempted", append(attrs, "result", result, "response_code", code)...)
+}
+
+// applyNotificationAttempt records on d an attempt made at now, which got the
+// response code, 0 for none, and err. It returns the result: DELIVERED,
+// DEAD_LETTER once notificationMaxAttempt attempts have failed, or "retry", with
+// d.NextAttemptAt set after a backoff.
+func applyNotificationAttempt(d *database.NotificationDelivery, now time.Time, code int, err error) string {
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.LastResponseCode = nil
+	if code != 0 {
+		responseCode := int64(code)
+		d.LastResponseCode = &responseCode
+	}
+	d.LastError = nil
+	if err != nil {
+		msg := err.Error()
+		d.LastError = &msg
+	}
+
+	switch {
+	case err == nil:
+		d.Status, d.DeliveredAt, d.NextAttemptAt = database.DeliveryStatusDelivered, &now, nil
+		return database.DeliveryStatusDelivered
+	case d.Attempts >= notificationMaxAttempt:
+		d.Status, d.NextAttemptAt = database.DeliveryStatusDeadLetter, nil
+		return database.DeliveryStatusDeadLetter
+	default:
+		next := now.Add(notificationRetryDelay(d.Attempts))
+		d.NextAttemptAt = &next
+		return "retry"
+	}
+}
+
+// notificationRetryDelay is the backoff after the given number of failed
+// attempts, with jitter so that the retries of one outage spread out.
+func notificationRetryDelay(attempts int64) time.Duration {
+	delay := min(notificationBackoff<<min(attempts-1, 7), notificationMaxBackoff)
+	return delay/2 + rand.N(delay/2)
+}
+
+// notificationPayload encodes a delivery in its subscription's format. job may be
+// nil if it could not be read.
+func notificationPayload(n *database.DueNotification, job *database.Job) ([]byte, error) {
+	d := &n.Delivery
+	if n.Subscription.Format == database.NotificationFormatSlack {
+		text := fmt.Sprintf("Jennah job %s is %s (was %s)", d.JobId, d.ToStatus, d.FromStatus)
+		if job != nil {
+			text += fmt.Sprintf("\nImage: %s", job.ImageUri)
+		}
+		if d.Reason != nil {
+			text += fmt.Sprintf("\nReason: %s", *d.Reason)
+		}
+		return json.Marshal(slackMessage{Text: text})
+	}
+
+	event := notificationEvent{
+		DeliveryId: d.DeliveryId,
+		Event:      "job.status_changed",
+		TenantId:   d.TenantId,
+		JobId:      d.JobId,
+		FromStatus: d.FromStatus,
+		ToStatus:   d.ToStatus,
+		OccurredAt: d.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	if d.Reason != nil {
+		event.Reason = *d.Reason
+	}
+	if job != nil {
+		event.ImageUri = job.ImageUri
+		if job.Location != nil {
+			event.Region = *job.Location
+		}
+		event.Labels = labels.Split(job.Labels)
+	}
+	return json.Marshal(event)
+}
+
+// sendNotification POSTs body to url, signed with secret. It returns the
+// response's status code, or 0 if there was no response, and an error unless
+// the status is 2xx.
+//
+// The X-Jennah-Signature header is "v1=" followed by the hex HMAC-SHA256 of
+// the X-Jennah-Timestamp header, a dot and the body, so that receivers can
+// reject both forged and replayed requests.
+func sendNotification(ctx context.Context, httpClient *http.Client, url, secret, deliveryID string, body []byte, now time.Time) (int, error) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "jennah-worker")
+	req.Header.Set("X-Jennah-Delivery", deliveryID)
+	req.Header.Set("X-Jennah-Timestamp", timestamp)
+	req.Header.Set("X-Jennah-Signature", "v1="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
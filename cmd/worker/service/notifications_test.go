@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/egress"
+)
+
+// testClient is a notification client that may reach the local test servers.
+func testClient(t *testing.T, timeout time.Duration) *http.Client {
+	t.Helper()
+	allow, err := egress.ParseAllowlist([]string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return egress.NewClient(timeout, allow)
+}
+
+func TestSendNotificationSignature(t *testing.T) {
+	const secret = "s3cret"
+	body := []byte(`{"event":"job.status_changed"}`)
+	now := time.Unix(1760000000, 0)
+
+	var got *http.Request
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	code, err := sendNotification(context.Background(), testClient(t, time.Second), srv.URL, secret, "delivery-1", body, now)
+	if err != nil || code != http.StatusNoContent {
+		t.Fatalf("sendNotification = %d, %v, want %d, nil", code, err, http.StatusNoContent)
+	}
+
+	timestamp := got.Header.Get("X-Jennah-Timestamp")
+	if timestamp != strconv.FormatInt(now.Unix(), 10) {
+		t.Errorf("X-Jennah-Timestamp = %q, want %d", timestamp, now.Unix())
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(gotBody)
+	if want := "v1=" + hex.EncodeToString(mac.Sum(nil)); got.Header.Get("X-Jennah-Signature") != want {
+		t.Errorf("X-Jennah-Signature = %q, want %q", got.Header.Get("X-Jennah-Signature"), want)
+	}
+	if string(gotBody) != string(body) {
+		t.Errorf("body = %s, want %s", gotBody, body)
+	}
+	if got.Header.Get("X-Jennah-Delivery") != "delivery-1" {
+		t.Errorf("X-Jennah-Delivery = %q, want delivery-1", got.Header.Get("X-Jennah-Delivery"))
+	}
+}
+
+func TestSendNotificationFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		wantCode int
+	}{
+		{"server error", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}, http.StatusBadGateway},
+		{"redirect", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		}, http.StatusFound},
+		{"timeout", func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(500 * time.Millisecond)
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(tt.handler)
+			defer srv.Close()
+
+			code, err := sendNotification(context.Background(), testClient(t, 100*time.Millisecond), srv.URL, "secret", "delivery-1", []byte("{}"), time.Now())
+			if err == nil || code != tt.wantCode {
+				t.Errorf("sendNotification = %d, %v, want %d and an error", code, err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestSendNotificationRefusesLoopback(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	_, err := sendNotification(context.Background(), egress.NewClient(time.Second, nil), srv.URL, "secret", "delivery-1", []byte("{}"), time.Now())
+	if !errors.Is(err, egress.ErrBlockedAddress) || hits != 0 {
+		t.Errorf("sendNotification error = %v after %d requests, want ErrBlockedAddress and none", err, hits)
+	}
+}
+
+func TestApplyNotificationAttempt(t *testing.T) {
+	now := time.Now()
+	failure := errors.New("webhook responded 503 Service Unavailable")
+
+	d := &database.NotificationDelivery{Status: database.DeliveryStatusPending}
+	for attempt := int64(1); attempt < notificationMaxAttempt; attempt++ {
+		if result := applyNotificationAttempt(d, now, http.StatusServiceUnavailable, failure); result != "retry" {
+			t.Fatalf("attempt %d: result = %q, want retry", attempt, result)
+		}
+		delay := min(notificationBackoff<<(attempt-1), notificationMaxBackoff)
+		if d.NextAttemptAt == nil || d.NextAttemptAt.Before(now.Add(delay/2)) || !d.NextAttemptAt.Before(now.Add(delay)) {
+			t.Fatalf("attempt %d: next attempt %v, want within [%v, %v) from now", attempt, d.NextAttemptAt, delay/2, delay)
+		}
+		if d.LastResponseCode == nil || *d.LastResponseCode != http.StatusServiceUnavailable || d.LastError == nil {
+			t.Fatalf("attempt %d: last response %v, error %v, want 503 and the error", attempt, d.LastResponseCode, d.LastError)
+		}
+	}
+
+	if result := applyNotificationAttempt(d, now, 0, failure); result != database.DeliveryStatusDeadLetter {
+		t.Fatalf("attempt %d: result = %q, want %s", notificationMaxAttempt, result, database.DeliveryStatusDeadLetter)
+	}
+	if d.Status != database.DeliveryStatusDeadLetter || d.NextAttemptAt != nil || d.Attempts != notificationMaxAttempt {
+		t.Errorf("delivery = status %s, next attempt %v, attempts %d, want %s, none, %d",
+			d.Status, d.NextAttemptAt, d.Attempts, database.DeliveryStatusDeadLetter, notificationMaxAttempt)
+	}
+	if d.LastResponseCode != nil {
+		t.Errorf("last response code = %d, want none for a request without a response", *d.LastResponseCode)
+	}
+
+	d = &database.NotificationDelivery{Status: database.DeliveryStatusPending, Attempts: 3}
+	if result := applyNotificationAttempt(d, now, http.StatusOK, nil); result != database.DeliveryStatusDelivered {
+		t.Fatalf("result = %q, want %s", result, database.DeliveryStatusDelivered)
+	}
+	if d.Status != database.DeliveryStatusDelivered || d.DeliveredAt == nil || d.NextAttemptAt != nil || d.LastError != nil {
+		t.Errorf("delivery = status %s, delivered at %v, next attempt %v, error %v, want delivered now with no retry",
+			d.Status, d.DeliveredAt, d.NextAttemptAt, d.LastError)
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/alphauslabs/jennah/internal/database"
+)
+
+// How long a region that returned RESOURCE_EXHAUSTED is only tried after every
+// other candidate. Batch quota is per project and region, so it usually takes a
+// while for running jobs to free some.
+const quotaErrorCooldown = 10 * time.Minute
+
+// placer decides which regions a job is created in, and in what order.
+type placer struct {
+	dbClient *database.Client
+	regions  []string         // Regions the worker serves, most preferred first
+	capacity map[string]int64 // Max vCPU in flight per region, in milli-cores; absent or 0 means unlimited
+
+	mu          sync.Mutex
+	quotaErrors map[string]time.Time // Last RESOURCE_EXHAUSTED from CreateJob, by region
+}
+
+func newPlacer(dbClient *database.Client, regions []string, capacity map[string]int64) *placer {
+	return &placer{
+		dbClient:    dbClient,
+		regions:     regions,
+		capacity:    capacity,
+		quotaErrors: make(map[string]time.Time),
+	}
+}
+
+// candidates returns the regions to try for a job needing cpuMilli in total,
+// best first: the caller's preferred regions in their order, then the other
+// allowed regions with the most free capacity. Regions that recently ran out of
+// Batch quota go last, and regions whose configured capacity cannot fit the job
+// are left out. Errors are connect errors ready to return from a handler.
+func (p *placer) candidates(ctx context.Context, allowed, preferred []string, cpuMilli int64) ([]string, error) {
+	for _, region := range append(slices.Clone(allowed), preferred...) {
+		if !slices.Contains(p.regions, region) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("region %q is not available; choose from %s", region, strings.Join(p.regions, ", ")))
+		}
+	}
+	if len(allowed) > 0 {
+		for _, region := range preferred {
+			if !slices.Contains(allowed, region) {
+				return nil, connect.NewError(connect.CodeInvalidArgument,
+					fmt.Errorf("preferred region %q is not in allowed_regions", region))
+			}
+		}
+	}
+
+	usage := p.regionUsage(ctx)
+	free := make(map[string]int64)
+	var pool []string
+	for _, region := range p.regions {
+		if len(allowed) > 0 && !slices.Contains(allowed, region) {
+			continue
+		}
+		free[region] = math.MaxInt64
+		if limit := p.capacity[region]; limit > 0 {
+			free[region] = limit - usage[region]
+			if free[region] < cpuMilli {
+				slog.DebugContext(ctx, "Region is at capacity", "region", region, "free_cpu_milli", free[region])
+				continue
+			}
+		}
+		pool = append(pool, region)
+	}
+	if len(pool) == 0 {
+		return nil, connect.NewError(connect.CodeResourceExhausted,
+			fmt.Errorf("no allowed region has capacity for %d milli-vCPU", cpuMilli))
+	}
+
+	cooling := p.coolingRegions()
+	rank := func(region string) int {
+		if i := slices.Index(preferred, region); i >= 0 {
+			return i
+		}
+		return len(preferred)
+	}
+	slices.SortStableFunc(pool, func(a, b string) int {
+		if c := compareBool(cooling[a], cooling[b]); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(rank(a), rank(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(free[b], free[a])
+	})
+	return pool, nil
+}
+
+// regionUsage reads the vCPU in flight per region. Placement fails open: if
+// Spanner cannot be read, capacity limits are not applied.
+func (p *placer) regionUsage(ctx context.Context) map[string]int64 {
+	if len(p.capacity) == 0 {
+		return nil
+	}
+	usage, err := p.dbClient.GetRegionUsage(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to read region usage, ignoring region capacity", "error", err)
+		return nil
+	}
+	return usage
+}
+
+func (p *placer) coolingRegions() map[string]bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	cooling := make(map[string]bool)
+	for region, at := range p.quotaErrors {
+		if time.Since(at) < quotaErrorCooldown {
+			cooling[region] = true
+		} else {
+			delete(p.quotaErrors, region)
+		}
+	}
+	return cooling
+}
+
+// recordQuotaError notes that CreateJob in region returned RESOURCE_EXHAUSTED.
+func (p *placer) recordQuotaError(region string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.quotaErrors[region] = time.Now()
+}
+
+// recordSuccess clears a region's quota error once a job is created there again.
+func (p *placer) recordSuccess(region string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.quotaErrors, region)
+}
+
+// compareBool orders false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
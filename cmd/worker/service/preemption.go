@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	batch "cloud.google.com/go/batch/apiv1"
+	"cloud.google.com/go/batch/apiv1/batchpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/metrics"
+)
+
+// GCP Batch fails a task with this reserved exit code when its Spot or
+// preemptible VM is preempted.
+const preemptedExitCode = 50001
+
+// setProvisioningModel makes a Batch job run on VMs of the given provisioning
+// model, keeping the rest of its allocation policy.
+func setProvisioningModel(job *batchpb.Job, provisioningModel string) {
+	model := batchpb.AllocationPolicy_ProvisioningModel(batchpb.AllocationPolicy_ProvisioningModel_value[provisioningModel])
+	if job.AllocationPolicy == nil {
+		job.AllocationPolicy = &batchpb.AllocationPolicy{}
+	}
+	for _, instance := range job.AllocationPolicy.Instances {
+		if policy := instance.GetPolicy(); policy != nil {
+			policy.ProvisioningModel = model
+			return
+		}
+	}
+	job.AllocationPolicy.Instances = append(job.AllocationPolicy.Instances, &batchpb.AllocationPolicy_InstancePolicyOrTemplate{
+		PolicyTemplate: &batchpb.AllocationPolicy_InstancePolicyOrTemplate_Policy{
+			Policy: &batchpb.AllocationPolicy_InstancePolicy{ProvisioningModel: model},
+		},
+	})
+}
+
+// preempted reports whether a failed Batch job failed because one of its VMs
+// was preempted.
+func preempted(batchJob *batchpb.Job) bool {
+	return failedWithExitCode(batchJob, preemptedExitCode)
+}
+
+// resubmitPreemptedJob handles a job whose Spot or preemptible Batch job
+// failed because its VMs were preempted: it creates a copy of the Batch job,
+// on STANDARD VMs once the job has been preempted maxPreemptions times, and
+// moves the job back to PENDING with the preemption as the reason. It reports
+// whether the job was resubmitted; if not, the job should be failed.
+//
+// The copy's ID is derived from the job's and its preemption count, so workers
+// reconciling the same job at once create it only once.
+func resubmitPreemptedJob(ctx context.Context, dbClient *database.Client, batchClient *batch.Client, job *database.Job, batchJob *batchpb.Job, maxPreemptions int64) bool {
+	current := database.ProvisioningStandard
+	if job.ProvisioningModel != nil {
+		current = *job.ProvisioningModel
+	}
+	if current == database.ProvisioningStandard || !preempted(batchJob) {
+		return false
+	}
+	attrs := []any{"tenant_id", job.TenantId, "job_id", job.JobId}
+
+	preemptions := job.PreemptionCount + 1
+	next, result := current, "resubmitted"
+	if preemptions >= maxPreemptions {
+		next, result = database.ProvisioningStandard, "fallback"
+	}
+	reason := fmt.Sprintf("%s VMs preempted (%d of %d); resubmitted on %s VMs", current, preemptions, maxPreemptions, next)
+
+	parent, _, _ := strings.Cut(batchJob.GetName(), "/jobs/")
+	batchJobID := fmt.Sprintf("jennah-%s-p%d", job.JobId[:8], preemptions)
+	name := parent + "/jobs/" + batchJobID
+
+	start := time.Now()
+	_, err := batchClient.CreateJob(ctx, &batchpb.CreateJobRequest{
+		Parent: parent,
+		JobId:  batchJobID,
+		Job:    resubmittedBatchJob(batchJob, next),
+	})
+	metrics.ObserveBatch("CreateJob", start, err)
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		metrics.JobPreemptions.WithLabelValues("error").Inc()
+		slog.WarnContext(ctx, "Failed to resubmit preempted job", append(attrs, "preemptions", preemptions, "error", err)...)
+		return false
+	}
+
+	applied, err := dbClient.ResubmitPreemptedJob(ctx, job.TenantId, job.JobId, job.Status, name, next, preemptions, reason)
+	if err != nil {
+		// The Batch job exists, so the next reconciliation records it
+		slog.WarnContext(ctx, "Failed to record preempted job resubmission", append(attrs, "error", err)...)
+		return true
+	}
+	if !applied {
+		// Another worker recorded it, or the job was cancelled meanwhile, in
+		// which case the copy must not run
+		if latest, err := dbClient.GetJob(ctx, job.TenantId, job.JobId); err == nil && latest != nil &&
+			(latest.GcpBatchJobName == nil || *latest.GcpBatchJobName != name) {
+			start := time.Now()
+			_, err := batchClient.CancelJob(ctx, &batchpb.CancelJobRequest{Name: name})
+			metrics.ObserveBatch("CancelJob", start, err)
+		}
+		return true
+	}
+
+	metrics.JobPreemptions.WithLabelValues(result).Inc()
+	slog.InfoContext(ctx, "Preempted job resubmitted", append(attrs, "from", job.Status, "preemptions", preemptions,
+		"provisioning_model", next, "batch_job_name", name)...)
+	return true
+}
+
+// resubmittedBatchJob copies what a Batch job runs, on VMs of the given
+// provisioning model.
+func resubmittedBatchJob(batchJob *batchpb.Job, provisioningModel string) *batchpb.Job {
+	job := &batchpb.Job{
+		Priority:         batchJob.GetPriority(),
+		AllocationPolicy: proto.Clone(batchJob.GetAllocationPolicy()).(*batchpb.AllocationPolicy),
+		Labels:           make(map[string]string, len(batchJob.GetLabels())),
+		LogsPolicy:       batchJob.GetLogsPolicy(),
+	}
+	for _, group := range batchJob.GetTaskGroups() {
+		group = proto.Clone(group).(*batchpb.TaskGroup)
+		group.Name = "" // Output only
+		job.TaskGroups = append(job.TaskGroups, group)
+	}
+	// Labels Batch adds itself are reserved
+	for key, value := range batchJob.GetLabels() {
+		if !strings.HasPrefix(key, "goog") {
+			job.Labels[key] = value
+		}
+	}
+	setProvisioningModel(job, provisioningModel)
+	return job
+}
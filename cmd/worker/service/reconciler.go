@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	batch "cloud.google.com/go/batch/apiv1"
+	"cloud.google.com/go/batch/apiv1/batchpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/hashing"
+	"github.com/alphauslabs/jennah/internal/metrics"
+	"github.com/alphauslabs/jennah/internal/storage"
+)
+
+// How often job statuses are refreshed from GCP Batch. Each worker reconciles
+// the active jobs of the tenants the gateway routes to it; transitions are
+// conditional on the status read, so a worker cannot undo a concurrent
+// cancellation, or another worker while the ring changes.
+const statusReconcileInterval = 30 * time.Second
+
+// A PENDING job is still being submitted until its Batch job is created. Only
+// after this long is a missing Batch job taken to mean the submission died.
+const pendingSubmitTimeout = 10 * time.Minute
+
+// statusRank orders statuses so that reconciliation only ever moves a job forward.
+var statusRank = map[string]int{
+	database.JobStatusPending:   0,
+	database.JobStatusScheduled: 1,
+	database.JobStatusRunning:   2,
+	database.JobStatusCompleted: 3,
+	database.JobStatusFailed:    3,
+	database.JobStatusCancelled: 3,
+	database.JobStatusTimedOut:  3,
+}
+
+// RunStatusReconciler copies the state of each active job's GCP Batch job to
+// Spanner until ctx is cancelled. Jobs whose Spot or preemptible VMs are
+// preempted are resubmitted instead of failed, on STANDARD VMs once they have
+// been preempted maxPreemptions times. Jobs still queued after their max queue
+// duration are cancelled and moved to TIMED_OUT. The outputs of jobs that
+// complete are recorded as artifacts from store, if they are in outputBuckets.
+// Only the jobs of tenants that router assigns to workerIP are reconciled; a
+// nil router reconciles every tenant's jobs.
+func RunStatusReconciler(ctx context.Context, dbClient *database.Client, batchClient *batch.Client, router *hashing.Router, workerIP string, store storage.Store, outputBuckets []string, maxPreemptions int64) {
+	ticker := time.NewTicker(statusReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		reconcileJobStatuses(ctx, dbClient, batchClient, router, workerIP, store, outputBuckets, maxPreemptions)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func reconcileJobStatuses(ctx context.Context, dbClient *database.Client, batchClient *batch.Client, router *hashing.Router, workerIP string, store storage.Store, outputBuckets []string, maxPreemptions int64) {
+	ctx, cancel := context.WithTimeout(ctx, statusReconcileInterval)
+	defer cancel()
+
+	jobs, err := dbClient.ListAllActiveJobs(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list active jobs for reconciliation", "error", err)
+		return
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		if router != nil && router.Owner(job.TenantId) != workerIP {
+			continue
+		}
+		to, reason, batchJob, ok := batchStatusOf(ctx, batchClient, job)
+		if queueTimedOut(job, to, ok, time.Now()) {
+			timeOutQueuedJob(ctx, dbClient, batchClient, job)
+			continue
+		}
+		if !ok || statusRank[to] <= statusRank[job.Status] {
+			continue
+		}
+		if to == database.JobStatusFailed && batchJob != nil && resubmitPreemptedJob(ctx, dbClient, batchClient, job, batchJob, maxPreemptions) {
+			continue
+		}
+
+		applied, err := dbClient.TransitionJobStatus(ctx, job.TenantId, job.JobId, job.Status, to, reason)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to update job status", "tenant_id", job.TenantId, "job_id", job.JobId, "error", err)
+			continue
+		}
+		if applied {
+			attrs := []any{"tenant_id", job.TenantId, "job_id", job.JobId, "from", job.Status, "to", to}
+			if reason != nil {
+				attrs = append(attrs, "reason", *reason)
+			}
+			slog.InfoContext(ctx, "Job status changed", attrs...)
+			if to == database.JobStatusTimedOut {
+				metrics.JobTimeouts.WithLabelValues("run").Inc()
+			}
+			if statusRank[to] == statusRank[database.JobStatusCompleted] {
+				recordJobUsage(ctx, dbClient, job.TenantId, job.JobId, batchJob)
+			}
+			if to == database.JobStatusCompleted {
+				recordJobArtifacts(ctx, dbClient, store, outputBuckets, job)
+			}
+		}
+	}
+}
+
+// batchStatusOf reads a job's Batch job and maps its state to a job status.
+// ok is false if the status cannot be determined yet. batchJob is nil if the
+// Batch job no longer exists.
+func batchStatusOf(ctx context.Context, batchClient *batch.Client, job *database.Job) (to string, reason *string, batchJob *batchpb.Job, ok bool) {
+	if job.GcpBatchJobName == nil {
+		return "", nil, nil, false
+	}
+
+	start := time.Now()
+	batchJob, err := batchClient.GetJob(ctx, &batchpb.GetJobRequest{Name: *job.GcpBatchJobName})
+	metrics.ObserveBatch("GetJob", start, err)
+	if status.Code(err) == codes.NotFound {
+		if job.Status == database.JobStatusPending && time.Since(job.CreatedAt) < pendingSubmitTimeout {
+			return "", nil, nil, false
+		}
+		msg := "GCP Batch job no longer exists"
+		return database.JobStatusFailed, &msg, nil, true
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to read GCP Batch job", "tenant_id", job.TenantId, "job_id", job.JobId, "error", err)
+		return "", nil, nil, false
+	}
+
+	switch batchJob.GetStatus().GetState() {
+	case batchpb.JobStatus_QUEUED, batchpb.JobStatus_SCHEDULED:
+		return database.JobStatusScheduled, nil, batchJob, true
+	case batchpb.JobStatus_RUNNING:
+		return database.JobStatusRunning, nil, batchJob, true
+	case batchpb.JobStatus_SUCCEEDED:
+		return database.JobStatusCompleted, nil, batchJob, true
+	case batchpb.JobStatus_FAILED:
+		if runTimedOut(batchJob) && job.MaxRunDurationSeconds != nil {
+			msg := fmt.Sprintf("A task ran longer than the job's max_run_duration of %s and was stopped",
+				time.Duration(*job.MaxRunDurationSeconds)*time.Second)
+			return database.JobStatusTimedOut, &msg, batchJob, true
+		}
+		msg := "GCP Batch job failed"
+		if events := batchJob.GetStatus().GetStatusEvents(); len(events) > 0 {
+			msg = events[len(events)-1].GetDescription()
+		}
+		return database.JobStatusFailed, &msg, batchJob, true
+	case batchpb.JobStatus_CANCELLATION_IN_PROGRESS, batchpb.JobStatus_CANCELLED, batchpb.JobStatus_DELETION_IN_PROGRESS:
+		msg := "GCP Batch job was cancelled or deleted outside Jennah"
+		return database.JobStatusCancelled, &msg, batchJob, true
+	}
+	return "", nil, nil, false
+}
+
+// failedWithExitCode reports whether a task of a failed Batch job exited with
+// code. Batch records the task's exit code on task events and in the
+// description of the job's events.
+func failedWithExitCode(batchJob *batchpb.Job, code int32) bool {
+	for _, event := range batchJob.GetStatus().GetStatusEvents() {
+		if event.GetTaskExecution().GetExitCode() == code ||
+			strings.Contains(event.GetDescription(), fmt.Sprintf("exit code %d", code)) {
+			return true
+		}
+	}
+	return false
+}
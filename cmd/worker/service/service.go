@@ -0,0 +1,592 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	batch "cloud.google.com/go/batch/apiv1"
+	"cloud.google.com/go/batch/apiv1/batchpb"
+	"cloud.google.com/go/spanner"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+	"github.com/alphauslabs/jennah/gen/proto/jennahv1connect"
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/labels"
+	"github.com/alphauslabs/jennah/internal/logging"
+	"github.com/alphauslabs/jennah/internal/metrics"
+	"github.com/alphauslabs/jennah/internal/registry"
+	"github.com/alphauslabs/jennah/internal/storage"
+	"github.com/alphauslabs/jennah/internal/tracing"
+)
+
+type WorkerServer struct {
+	jennahv1connect.UnimplementedDeploymentServiceHandler
+	dbClient      *database.Client
+	batchClient   *batch.Client
+	logClient     *cloudlogging.Service
+	store         storage.Store
+	outputBuckets []string
+	resolver      *registry.Resolver
+	projectId     string
+	regions       []string
+	placer        *placer
+}
+
+// NewWorkerServer creates the worker's DeploymentService handler. regions lists
+// the Batch locations jobs may be placed in, most preferred first; regionCapacity
+// caps the vCPU, in milli-cores, that active jobs may hold in a region.
+// logClient reads the task logs Batch writes to Cloud Logging, store the
+// objects jobs write to their outputs, which must be in outputBuckets, and
+// resolver the digests of job images.
+func NewWorkerServer(dbClient *database.Client, batchClient *batch.Client, logClient *cloudlogging.Service, store storage.Store, outputBuckets []string, resolver *registry.Resolver, projectId string, regions []string, regionCapacity map[string]int64) *WorkerServer {
+	return &WorkerServer{
+		dbClient:      dbClient,
+		batchClient:   batchClient,
+		logClient:     logClient,
+		store:         store,
+		outputBuckets: outputBuckets,
+		resolver:      resolver,
+		projectId:     projectId,
+		regions:       regions,
+		placer:        newPlacer(dbClient, regions, regionCapacity),
+	}
+}
+
+func (s *WorkerServer) SubmitJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.SubmitJobRequest],
+) (*connect.Response[jennahv1.SubmitJobResponse], error) {
+	tenantId, err := tenantIdFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if req.Msg.ImageUri == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("image_uri is required"))
+	}
+	if err := labels.Validate(req.Msg.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := labels.ValidateAnnotations(req.Msg.Annotations); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateOutputs(req.Msg.Outputs, tenantId, s.outputBuckets); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Generate internal UUID for Spanner primary key
+	internalJobID := uuid.New().String()
+	logging.SetJobID(ctx, internalJobID)
+
+	// Generate GCP Batch-compliant job ID: lowercase, starts with letter, no underscores
+	batchJobID := "jennah-" + internalJobID[:8]
+
+	// Apply GCP Batch defaults for anything the request leaves unset
+	cpuMilli := int64(database.DefaultCpuMilli)
+	memoryMib := int64(database.DefaultMemoryMib)
+	if res := req.Msg.Resources; res != nil {
+		if res.CpuMilli > 0 {
+			cpuMilli = res.CpuMilli
+		}
+		if res.MemoryMib > 0 {
+			memoryMib = res.MemoryMib
+		}
+	}
+	taskCount := req.Msg.TaskCount
+	if taskCount <= 0 {
+		taskCount = 1
+	}
+	provisioningModel := req.Msg.ProvisioningModel
+	switch provisioningModel {
+	case "":
+		provisioningModel = database.ProvisioningStandard
+	case database.ProvisioningStandard, database.ProvisioningSpot, database.ProvisioningPreemptible:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown provisioning_model %q; must be %s, %s or %s",
+			provisioningModel, database.ProvisioningStandard, database.ProvisioningSpot, database.ProvisioningPreemptible))
+	}
+	maxRunSeconds, err := timeoutSeconds("max_run_duration", req.Msg.MaxRunDuration)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	maxQueueSeconds, err := timeoutSeconds("max_queue_duration", req.Msg.MaxQueueDuration)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Batch runs the image by digest, so reruns and resubmissions run the same
+	// code even if the tag moves
+	image, err := s.resolveImage(ctx, req.Msg.ImageUri)
+	if err != nil {
+		return nil, err
+	}
+
+	// Jobs with outputs are told their ID, which their output URIs contain
+	envVars := req.Msg.EnvVars
+	if len(req.Msg.Outputs) > 0 {
+		envVars = maps.Clone(envVars)
+		if envVars == nil {
+			envVars = make(map[string]string, 1)
+		}
+		envVars[jobIDEnvVar] = internalJobID
+	}
+
+	regions, err := s.placer.candidates(ctx, req.Msg.AllowedRegions, req.Msg.PreferredRegions, cpuMilli*taskCount)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.DryRun {
+		batchJob, err := dryRunBatchJob(batchJobSpec(image.Pinned(), envVars, req.Msg.Labels, cpuMilli, memoryMib, taskCount, provisioningModel, maxRunSeconds))
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to encode dry run Batch job", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		slog.DebugContext(ctx, "Job validated", "region", regions[0])
+		return connect.NewResponse(&jennahv1.SubmitJobResponse{
+			Region:      regions[0],
+			BatchJob:    batchJob,
+			ImageDigest: image.Digest,
+		}), nil
+	}
+
+	// Construct full GCP Batch resource name in the best region; it is
+	// updated if the job ends up in a fallback region
+	gcpBatchJobName := s.batchJobName(regions[0], batchJobID)
+	slog.DebugContext(ctx, "Submitting job", "image_uri", req.Msg.ImageUri, "image_digest", image.Digest, "regions", regions, logging.EnvVars(req.Msg.EnvVars))
+
+	// Insert job record with both identifiers
+	job := &database.Job{
+		TenantId:                tenantId,
+		JobId:                   internalJobID,
+		ImageUri:                req.Msg.ImageUri,
+		ImageDigest:             &image.Digest,
+		Commands:                []string{},
+		GcpBatchJobName:         &gcpBatchJobName,
+		CpuMilli:                &cpuMilli,
+		MemoryMib:               &memoryMib,
+		TaskCount:               taskCount,
+		Labels:                  labels.Join(req.Msg.Labels),
+		ProvisioningModel:       &provisioningModel,
+		Annotations:             labels.Join(req.Msg.Annotations),
+		MaxRunDurationSeconds:   maxRunSeconds,
+		MaxQueueDurationSeconds: maxQueueSeconds,
+		Outputs:                 labels.Join(req.Msg.Outputs),
+	}
+	if req.Msg.TemplateId != "" {
+		job.TemplateId = &req.Msg.TemplateId
+		job.TemplateRevision = &req.Msg.TemplateRevision
+	}
+	var limits *database.TenantQuota
+	if q := req.Msg.Quota; q != nil {
+		limits = &database.TenantQuota{
+			TenantId:          tenantId,
+			MaxConcurrentJobs: q.MaxConcurrentJobs,
+			MaxCpuMilli:       q.MaxCpuMilli,
+			MaxMemoryMib:      q.MaxMemoryMib,
+		}
+	}
+	err = s.dbClient.InsertJob(ctx, job, limits)
+	var exceeded *database.QuotaExceededError
+	if errors.As(err, &exceeded) {
+		slog.InfoContext(ctx, "Job rejected by quota", "quota", exceeded.Quota, "reason", exceeded.Description)
+		return nil, connect.NewError(connect.CodeResourceExhausted, exceeded)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to insert job", "error", err)
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			fmt.Errorf("failed to create job record: %w", err),
+		)
+	}
+
+	// Create GCP Batch job using compliant ID
+	batchJob, region, err := s.placeGCPBatchJob(ctx, regions, batchJobID, image.Pinned(), envVars, req.Msg.Labels, cpuMilli, memoryMib, taskCount, provisioningModel, maxRunSeconds)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create GCP Batch job", "error", err)
+		reason := err.Error()
+		applied, failErr := s.dbClient.TransitionJobStatus(ctx, tenantId, internalJobID, database.JobStatusPending, database.JobStatusFailed, &reason)
+		if failErr != nil {
+			slog.ErrorContext(ctx, "Failed to mark job FAILED", "error", failErr)
+		}
+		if applied {
+			recordJobUsage(ctx, s.dbClient, tenantId, internalJobID, nil)
+		}
+		code := connect.CodeInternal
+		if status.Code(err) == codes.ResourceExhausted {
+			code = connect.CodeResourceExhausted
+		}
+		return nil, connect.NewError(code, fmt.Errorf("failed to create GCP Batch job: %w", err))
+	}
+
+	if err := s.dbClient.PlaceJob(ctx, tenantId, internalJobID, region, batchJob.Name); err != nil {
+		slog.ErrorContext(ctx, "Failed to record job placement", "region", region, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record job placement: %w", err))
+	}
+
+	// The status reconciler moves the job on from here as Batch runs it; it may
+	// already have done so, in which case the transition is skipped
+	_, err = s.dbClient.TransitionJobStatus(ctx, tenantId, internalJobID, database.JobStatusPending, database.JobStatusScheduled, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark job SCHEDULED", "error", err)
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			fmt.Errorf("failed to update job status: %w", err),
+		)
+	}
+	response := connect.NewResponse(&jennahv1.SubmitJobResponse{
+		JobId:       internalJobID, // Return internal UUID to client
+		Status:      database.JobStatusScheduled,
+		Region:      region,
+		ImageDigest: image.Digest,
+	})
+
+	slog.InfoContext(ctx, "Job submitted", "region", region, "batch_job_name", batchJob.Name)
+	return response, nil
+}
+
+func (s *WorkerServer) ListJobs(
+	ctx context.Context,
+	req *connect.Request[jennahv1.ListJobsRequest],
+) (*connect.Response[jennahv1.ListJobsResponse], error) {
+	tenantId, err := tenantIdFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	selector, err := labels.ParseSelector(req.Msg.LabelSelector)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	jobs, err := s.dbClient.ListJobs(ctx, tenantId, selector)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list jobs", "error", err)
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			fmt.Errorf("failed to list jobs: %w", err),
+		)
+	}
+
+	protoJobs := make([]*jennahv1.Job, 0, len(jobs))
+	for _, job := range jobs {
+		protoJobs = append(protoJobs, jobToProto(job))
+	}
+
+	response := connect.NewResponse(&jennahv1.ListJobsResponse{
+		Jobs: protoJobs,
+	})
+
+	slog.DebugContext(ctx, "Listed jobs", "count", len(protoJobs))
+	return response, nil
+}
+
+func (s *WorkerServer) CancelJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.CancelJobRequest],
+) (*connect.Response[jennahv1.CancelJobResponse], error) {
+	tenantId, err := tenantIdFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+	logging.SetJobID(ctx, req.Msg.JobId)
+
+	job, err := s.getJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status == database.JobStatusCancelled {
+		return connect.NewResponse(&jennahv1.CancelJobResponse{JobId: job.JobId, Status: job.Status}), nil
+	}
+	if !slices.Contains(database.ActiveJobStatuses, job.Status) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("job %s has already finished with status %s", job.JobId, job.Status))
+	}
+
+	// Jobs created before the Batch job name was persisted use the deterministic ID
+	// scheme; they predate multiple regions and live in the first one
+	gcpBatchJobName := s.batchJobName(s.regions[0], "jennah-"+job.JobId[:8])
+	if job.GcpBatchJobName != nil {
+		gcpBatchJobName = *job.GcpBatchJobName
+	}
+
+	// Cancellation completes asynchronously in Batch; the request being accepted is enough
+	batchCtx, span := tracing.Tracer().Start(ctx, "cancelGCPBatchJob",
+		trace.WithAttributes(attribute.String("batch.job_name", gcpBatchJobName)))
+	start := time.Now()
+	_, err = s.batchClient.CancelJob(batchCtx, &batchpb.CancelJobRequest{Name: gcpBatchJobName})
+	metrics.ObserveBatch("CancelJob", start, err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+	if err != nil && status.Code(err) != codes.NotFound {
+		slog.ErrorContext(ctx, "Failed to cancel GCP Batch job", "batch_job_name", gcpBatchJobName, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to cancel GCP Batch job: %w", err))
+	}
+
+	// The status reconciler may move the job on while it is being cancelled; the
+	// transition is retried from its new status until it applies or the job finishes
+	for {
+		applied, err := s.dbClient.TransitionJobStatus(ctx, tenantId, job.JobId, job.Status, database.JobStatusCancelled, nil)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to mark job CANCELLED", "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update job status: %w", err))
+		}
+		if applied {
+			break
+		}
+		if job, err = s.getJob(ctx, tenantId, job.JobId); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(database.ActiveJobStatuses, job.Status) {
+			slog.InfoContext(ctx, "Job finished before it was cancelled", "status", job.Status)
+			return connect.NewResponse(&jennahv1.CancelJobResponse{JobId: job.JobId, Status: job.Status}), nil
+		}
+	}
+
+	slog.InfoContext(ctx, "Job cancelled", "batch_job_name", gcpBatchJobName)
+
+	// The Batch job is read for the VMs it ran on; without them only the
+	// requested resources are recorded
+	start = time.Now()
+	batchJob, err := s.batchClient.GetJob(ctx, &batchpb.GetJobRequest{Name: gcpBatchJobName})
+	metrics.ObserveBatch("GetJob", start, err)
+	if err != nil {
+		batchJob = nil
+	}
+	recordJobUsage(ctx, s.dbClient, tenantId, job.JobId, batchJob)
+	return connect.NewResponse(&jennahv1.CancelJobResponse{
+		JobId:  job.JobId,
+		Status: database.JobStatusCancelled,
+	}), nil
+}
+
+func (s *WorkerServer) GetJob(
+	ctx context.Context,
+	req *connect.Request[jennahv1.GetJobRequest],
+) (*connect.Response[jennahv1.GetJobResponse], error) {
+	tenantId, err := tenantIdFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if req.Msg.JobId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job_id is required"))
+	}
+	logging.SetJobID(ctx, req.Msg.JobId)
+
+	job, err := s.getJob(ctx, tenantId, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&jennahv1.GetJobResponse{Job: jobToProto(job)}), nil
+}
+
+// getJob reads one of the tenant's jobs. Jobs are keyed by tenant, so another
+// tenant's job ID is simply not found.
+func (s *WorkerServer) getJob(ctx context.Context, tenantId, jobId string) (*database.Job, error) {
+	job, err := s.dbClient.GetJob(ctx, tenantId, jobId)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("job %s not found", jobId))
+		}
+		slog.ErrorContext(ctx, "Failed to read job", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get job: %w", err))
+	}
+	return job, nil
+}
+
+// jobToProto converts a stored job to its API form.
+func jobToProto(job *database.Job) *jennahv1.Job {
+	protoJob := &jennahv1.Job{
+		JobId:       job.JobId,
+		TenantId:    job.TenantId,
+		ImageUri:    job.ImageUri,
+		Status:      job.Status,
+		CreatedAt:   job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   job.UpdatedAt.Format(time.RFC3339),
+		Labels:      labels.Split(job.Labels),
+		Annotations: labels.Split(job.Annotations),
+		Outputs:     labels.Split(job.Outputs),
+	}
+	if job.Location != nil {
+		protoJob.Region = *job.Location
+	}
+	if job.ImageDigest != nil {
+		protoJob.ImageDigest = *job.ImageDigest
+	}
+	if job.TemplateId != nil {
+		protoJob.TemplateId = *job.TemplateId
+	}
+	if job.TemplateRevision != nil {
+		protoJob.TemplateRevision = *job.TemplateRevision
+	}
+	if job.StartedAt != nil {
+		protoJob.StartedAt = job.StartedAt.Format(time.RFC3339)
+	}
+	if job.CompletedAt != nil {
+		protoJob.CompletedAt = job.CompletedAt.Format(time.RFC3339)
+	}
+	if job.ErrorMessage != nil {
+		protoJob.ErrorMessage = *job.ErrorMessage
+	}
+	protoJob.ProvisioningModel = database.ProvisioningStandard
+	if job.ProvisioningModel != nil {
+		protoJob.ProvisioningModel = *job.ProvisioningModel
+	}
+	protoJob.PreemptionCount = job.PreemptionCount
+	protoJob.MaxRunDuration = secondsToDuration(job.MaxRunDurationSeconds)
+	protoJob.MaxQueueDuration = secondsToDuration(job.MaxQueueDurationSeconds)
+	return protoJob
+}
+
+// batchJobName returns the full resource name of a Batch job.
+func (s *WorkerServer) batchJobName(region, batchJobID string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/jobs/%s", s.projectId, region, batchJobID)
+}
+
+// placeGCPBatchJob creates the Batch job in the first of regions that accepts
+// it. A region that returns RESOURCE_EXHAUSTED is remembered by the placer and
+// the next one is tried; any other error stops placement.
+func (s *WorkerServer) placeGCPBatchJob(
+	ctx context.Context,
+	regions []string,
+	jobId string,
+	imageURI string,
+	envVars map[string]string,
+	jobLabels map[string]string,
+	cpuMilli int64,
+	memoryMib int64,
+	taskCount int64,
+	provisioningModel string,
+	maxRunSeconds *int64,
+) (*batchpb.Job, string, error) {
+	var err error
+	for _, region := range regions {
+		var batchJob *batchpb.Job
+		batchJob, err = s.createGCPBatchJob(ctx, region, jobId, imageURI, envVars, jobLabels, cpuMilli, memoryMib, taskCount, provisioningModel, maxRunSeconds)
+		if err == nil {
+			metrics.JobPlacements.WithLabelValues(region, "created").Inc()
+			s.placer.recordSuccess(region)
+			return batchJob, region, nil
+		}
+		if status.Code(err) != codes.ResourceExhausted {
+			metrics.JobPlacements.WithLabelValues(region, "error").Inc()
+			return nil, "", err
+		}
+		metrics.JobPlacements.WithLabelValues(region, "quota_exceeded").Inc()
+		s.placer.recordQuotaError(region)
+		slog.WarnContext(ctx, "Region is out of Batch quota, trying the next one", "region", region, "error", err)
+	}
+	return nil, "", err
+}
+
+func (s *WorkerServer) createGCPBatchJob(
+	ctx context.Context,
+	region string,
+	jobId string,
+	imageURI string,
+	envVars map[string]string,
+	jobLabels map[string]string,
+	cpuMilli int64,
+	memoryMib int64,
+	taskCount int64,
+	provisioningModel string,
+	maxRunSeconds *int64,
+) (*batchpb.Job, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "createGCPBatchJob", trace.WithAttributes(
+		attribute.String("batch.job_id", jobId),
+		attribute.String("batch.region", region),
+		attribute.String("batch.image_uri", imageURI),
+		attribute.Int64("batch.task_count", taskCount),
+		attribute.String("batch.provisioning_model", provisioningModel),
+	))
+	defer span.End()
+
+	req := &batchpb.CreateJobRequest{
+		Parent: fmt.Sprintf("projects/%s/locations/%s", s.projectId, region),
+		JobId:  jobId,
+		Job:    batchJobSpec(imageURI, envVars, jobLabels, cpuMilli, memoryMib, taskCount, provisioningModel, maxRunSeconds),
+	}
+
+	start := time.Now()
+	batchJob, err := s.batchClient.CreateJob(ctx, req)
+	metrics.ObserveBatch("CreateJob", start, err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	return batchJob, err
+}
+
+// batchJobSpec builds the Batch job that runs a Jennah job: one task group of
+// taskCount tasks, each running the container with the given resources for at
+// most maxRunSeconds, if set, on VMs of the given provisioning model.
+func batchJobSpec(
+	imageURI string,
+	envVars map[string]string,
+	jobLabels map[string]string,
+	cpuMilli int64,
+	memoryMib int64,
+	taskCount int64,
+	provisioningModel string,
+	maxRunSeconds *int64,
+) *batchpb.Job {
+	runnable := &batchpb.Runnable{
+		Executable: &batchpb.Runnable_Container_{
+			Container: &batchpb.Runnable_Container{
+				ImageUri: imageURI,
+			},
+		},
+	}
+
+	if len(envVars) > 0 {
+		runnable.Environment = &batchpb.Environment{
+			Variables: envVars,
+		}
+	}
+
+	job := &batchpb.Job{
+		TaskGroups: []*batchpb.TaskGroup{
+			{
+				TaskSpec: &batchpb.TaskSpec{
+					Runnables: []*batchpb.Runnable{runnable},
+					ComputeResource: &batchpb.ComputeResource{
+						CpuMilli:  cpuMilli,
+						MemoryMib: memoryMib,
+					},
+					MaxRunDuration: secondsToDuration(maxRunSeconds),
+				},
+				TaskCount: taskCount,
+			},
+		},
+		Labels: jobLabels,
+	}
+	// Batch provisions STANDARD VMs without an allocation policy
+	if provisioningModel != database.ProvisioningStandard {
+		setProvisioningModel(job, provisioningModel)
+	}
+	return job
+}
+
+// func (s *WorkerServer) GetCurrentTenant(
+// 	ctx context.Context,
+// 	req *connect.Request[jennahv1.GetCurrentTenantRequest],
+// ) (*connect.Response[jennahv1.GetCurrentTenantResponse], error) {
+// 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("GetCurrentTenant is not implemented in WorkerService"))
+// }
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	batch "cloud.google.com/go/batch/apiv1"
+	"cloud.google.com/go/batch/apiv1/batchpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/metrics"
+)
+
+// GCP Batch fails a task with this reserved exit code when it runs longer than
+// its MaxRunDuration.
+const runTimedOutExitCode = 50005
+
+// timeoutSeconds validates a SubmitJob timeout and returns it in whole
+// seconds, or nil if it is unset.
+func timeoutSeconds(name string, d *durationpb.Duration) (*int64, error) {
+	if d == nil {
+		return nil, nil
+	}
+	if err := d.CheckValid(); err != nil {
+		return nil, fmt.Errorf("%s is invalid: %w", name, err)
+	}
+	seconds := d.GetSeconds()
+	if seconds < 1 || d.GetNanos() != 0 {
+		return nil, fmt.Errorf("%s must be a positive whole number of seconds", name)
+	}
+	return &seconds, nil
+}
+
+// secondsToDuration returns a stored timeout as a proto duration, or nil if it
+// is unset.
+func secondsToDuration(seconds *int64) *durationpb.Duration {
+	if seconds == nil {
+		return nil
+	}
+	return durationpb.New(time.Duration(*seconds) * time.Second)
+}
+
+// runTimedOut reports whether a failed Batch job failed because a task ran
+// longer than its MaxRunDuration.
+func runTimedOut(batchJob *batchpb.Job) bool {
+	return failedWithExitCode(batchJob, runTimedOutExitCode)
+}
+
+// queueTimedOut reports whether a job that has never run has been waiting for
+// longer than its max queue duration. to and ok are what the job's Batch job
+// says its status is now: a job Batch has started is not timed out, even if
+// the reconciler has yet to record it.
+func queueTimedOut(job *database.Job, to string, ok bool, now time.Time) bool {
+	if job.MaxQueueDurationSeconds == nil || job.StartedAt != nil || job.GcpBatchJobName == nil {
+		return false
+	}
+	if ok && statusRank[to] > statusRank[database.JobStatusScheduled] {
+		return false
+	}
+	return now.Sub(job.CreatedAt) > time.Duration(*job.MaxQueueDurationSeconds)*time.Second
+}
+
+// timeOutQueuedJob cancels the Batch job of a job that has been queued for
+// too long and moves the job to TIMED_OUT. The transition is conditional on
+// the status the job was read with, as the reconciler's others are.
+func timeOutQueuedJob(ctx context.Context, dbClient *database.Client, batchClient *batch.Client, job *database.Job) {
+	attrs := []any{"tenant_id", job.TenantId, "job_id", job.JobId}
+	limit := time.Duration(*job.MaxQueueDurationSeconds) * time.Second
+
+	start := time.Now()
+	_, err := batchClient.CancelJob(ctx, &batchpb.CancelJobRequest{Name: *job.GcpBatchJobName})
+	metrics.ObserveBatch("CancelJob", start, err)
+	if err != nil && status.Code(err) != codes.NotFound {
+		slog.WarnContext(ctx, "Failed to cancel timed out GCP Batch job", append(attrs, "error", err)...)
+		return
+	}
+
+	reason := fmt.Sprintf("Job was still queued after its max_queue_duration of %s and was cancelled", limit)
+	applied, err := dbClient.TransitionJobStatus(ctx, job.TenantId, job.JobId, job.Status, database.JobStatusTimedOut, &reason)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to update job status", append(attrs, "error", err)...)
+		return
+	}
+	if applied {
+		metrics.JobTimeouts.WithLabelValues("queue").Inc()
+		slog.InfoContext(ctx, "Job status changed", append(attrs, "from", job.Status, "to", database.JobStatusTimedOut, "reason", reason)...)
+		recordJobUsage(ctx, dbClient, job.TenantId, job.JobId, nil)
+	}
+}
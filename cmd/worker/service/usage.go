@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/batch/apiv1/batchpb"
+
+	"github.com/alphauslabs/jennah/internal/database"
+	"github.com/alphauslabs/jennah/internal/usage"
+)
+
+// recordJobUsage records the resources a job used once it has finished.
+// batchJob is the job's GCP Batch job as last read, or nil if there is none;
+// without it only the requested resources are known. Failures are logged: the
+// job has finished either way.
+func recordJobUsage(ctx context.Context, dbClient *database.Client, tenantID, jobID string, batchJob *batchpb.Job) {
+	attrs := []any{"tenant_id", tenantID, "job_id", jobID}
+	job, err := dbClient.GetJob(ctx, tenantID, jobID)
+	if err != nil || job == nil || job.CompletedAt == nil {
+		slog.WarnContext(ctx, "Failed to read finished job for usage", append(attrs, "error", err)...)
+		return
+	}
+	if err := dbClient.RecordJobUsage(ctx, jobUsage(job, batchJob)); err != nil {
+		slog.WarnContext(ctx, "Failed to record job usage", append(attrs, "error", err)...)
+	}
+}
+
+// jobUsage works out the resources a finished job used. Its run time is from
+// StartedAt to CompletedAt, or Batch's run duration if the job was never seen
+// running. Requested resources are the job's per task times its tasks; actual
+// ones are those of the VMs Batch reports, each of which runs up to its task
+// pack of tasks at once.
+func jobUsage(job *database.Job, batchJob *batchpb.Job) *database.JobUsage {
+	var run time.Duration
+	switch {
+	case job.StartedAt != nil:
+		run = job.CompletedAt.Sub(*job.StartedAt)
+	case batchJob.GetStatus().GetRunDuration() != nil:
+		run = batchJob.GetStatus().GetRunDuration().AsDuration()
+	}
+	runSeconds := max(run.Seconds(), 0)
+
+	cpuMilli, memoryMib := int64(database.DefaultCpuMilli), int64(database.DefaultMemoryMib)
+	if job.CpuMilli != nil {
+		cpuMilli = *job.CpuMilli
+	}
+	if job.MemoryMib != nil {
+		memoryMib = *job.MemoryMib
+	}
+	tasks := float64(job.TaskCount)
+
+	u := &database.JobUsage{
+		TenantId:                  job.TenantId,
+		JobId:                     job.JobId,
+		CompletedAt:               *job.CompletedAt,
+		Status:                    job.Status,
+		Region:                    job.Location,
+		TaskCount:                 job.TaskCount,
+		RunSeconds:                runSeconds,
+		RequestedVcpuSeconds:      float64(cpuMilli) / 1000 * tasks * runSeconds,
+		RequestedMemoryGibSeconds: float64(memoryMib) / 1024 * tasks * runSeconds,
+	}
+
+	for _, group := range batchJob.GetStatus().GetTaskGroups() {
+		instances := group.GetInstances()
+		if len(instances) == 0 {
+			continue
+		}
+		instance := instances[0]
+		machineType := instance.GetMachineType()
+		provisioningModel := instance.GetProvisioningModel().String()
+		u.MachineType = &machineType
+		if instance.GetProvisioningModel() != batchpb.AllocationPolicy_PROVISIONING_MODEL_UNSPECIFIED {
+			u.ProvisioningModel = &provisioningModel
+		}
+
+		taskPack := max(instance.GetTaskPack(), 1)
+		vms := (job.TaskCount + taskPack - 1) / taskPack
+		u.Instances = &vms
+		if machine, ok := usage.ParseMachineType(machineType); ok {
+			vcpuSeconds := machine.Vcpus * float64(vms) * runSeconds
+			memoryGibSeconds := machine.MemoryGib * float64(vms) * runSeconds
+			u.VcpuSeconds, u.MemoryGibSeconds = &vcpuSeconds, &memoryGibSeconds
+		}
+		break // Jennah jobs have a single task group
+	}
+	return u
+}
@@ -0,0 +1,17 @@
+-- Migration: Add tenant-scoped API keys for machine clients
+
+CREATE TABLE ApiKeys (
+  TenantId STRING(36) NOT NULL,
+  KeyId STRING(36) NOT NULL,
+  Name STRING(255) NOT NULL,
+  KeyHash STRING(64) NOT NULL,  -- Hex SHA-256 of the key; the key itself is never stored
+  Prefix STRING(16) NOT NULL,
+  CreatedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  ExpiresAt TIMESTAMP,
+  LastUsedAt TIMESTAMP,
+  RevokedAt TIMESTAMP,
+) PRIMARY KEY (TenantId, KeyId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX ApiKeysByHash ON ApiKeys(KeyHash);
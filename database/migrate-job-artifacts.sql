@@ -0,0 +1,15 @@
+-- Migration: Add the Jobs Outputs column and the JobArtifacts table
+
+ALTER TABLE Jobs ADD COLUMN Outputs ARRAY<STRING(MAX)>;
+
+CREATE TABLE JobArtifacts (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  Uri STRING(1024) NOT NULL,
+  Output STRING(63) NOT NULL,
+  SizeBytes INT64 NOT NULL,
+  Crc32c STRING(16) NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+  RecordedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, JobId, Uri),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
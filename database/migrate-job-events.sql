@@ -0,0 +1,21 @@
+-- Migration: Add the JobEvents outbox of job lifecycle events published to Pub/Sub
+
+CREATE TABLE JobEvents (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  Sequence INT64 NOT NULL,                  -- Position in the job's events, from 1
+  EventId STRING(36) NOT NULL,
+  EventType STRING(20) NOT NULL,            -- SUBMITTED, SCHEDULED, STARTED, RETRIED, SUCCEEDED, FAILED or CANCELLED
+  FromStatus STRING(50),                    -- NULL for SUBMITTED
+  ToStatus STRING(50) NOT NULL,
+  Reason STRING(MAX),
+  OccurredAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  Attempts INT64 NOT NULL,                  -- Failed publish attempts
+  LastError STRING(MAX),
+  NextAttemptAt TIMESTAMP,                  -- NULL once published
+  PublishedAt TIMESTAMP,
+) PRIMARY KEY (TenantId, JobId, Sequence),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
+
+-- Only unpublished events have a NextAttemptAt, so this indexes the publish queue
+CREATE NULL_FILTERED INDEX JobEventsUnpublished ON JobEvents(NextAttemptAt);
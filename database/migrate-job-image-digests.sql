@@ -0,0 +1,3 @@
+-- Migration: Add the Jobs ImageDigest column
+
+ALTER TABLE Jobs ADD COLUMN ImageDigest STRING(71);
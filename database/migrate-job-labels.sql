@@ -0,0 +1,4 @@
+-- Migration: Add job labels, copied to GCP Batch and used by ListJobs label selectors, and annotations
+
+ALTER TABLE Jobs ADD COLUMN Labels ARRAY<STRING(MAX)>;
+ALTER TABLE Jobs ADD COLUMN Annotations ARRAY<STRING(MAX)>;
@@ -0,0 +1,9 @@
+-- Migration: Record the GCP region each job was placed in, for multi-region placement
+-- Run the DDL first, then the backfill DML.
+
+ALTER TABLE Jobs ADD COLUMN Location STRING(64);
+CREATE INDEX JobsByLocation ON Jobs(Location, Status) STORING (CpuMilli, TaskCount);
+
+-- Backfill from the Batch job name (projects/<project>/locations/<region>/jobs/<id>)
+UPDATE Jobs SET Location = REGEXP_EXTRACT(GcpBatchJobName, r'/locations/([^/]+)/')
+WHERE Location IS NULL AND GcpBatchJobName IS NOT NULL;
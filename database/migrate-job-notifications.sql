@@ -0,0 +1,37 @@
+-- Migration: Add per-tenant job notification subscriptions and their delivery history
+
+CREATE TABLE NotificationSubscriptions (
+  TenantId STRING(36) NOT NULL,
+  SubscriptionId STRING(36) NOT NULL,
+  Url STRING(2048) NOT NULL,
+  Secret STRING(MAX) NOT NULL,              -- HMAC-SHA256 key for the X-Jennah-Signature header
+  Statuses ARRAY<STRING(50)> NOT NULL,      -- Job statuses whose transitions are sent
+  Format STRING(16) NOT NULL,               -- json or slack
+  CreatedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, SubscriptionId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE TABLE NotificationDeliveries (
+  TenantId STRING(36) NOT NULL,
+  SubscriptionId STRING(36) NOT NULL,
+  DeliveryId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  FromStatus STRING(50) NOT NULL,
+  ToStatus STRING(50) NOT NULL,
+  Reason STRING(MAX),
+  Status STRING(20) NOT NULL,               -- PENDING, DELIVERED or DEAD_LETTER
+  Attempts INT64 NOT NULL,
+  LastResponseCode INT64,                   -- HTTP status of the last attempt; NULL if no response
+  LastError STRING(MAX),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),  -- When the transition was recorded
+  LastAttemptAt TIMESTAMP,
+  DeliveredAt TIMESTAMP,
+  NextAttemptAt TIMESTAMP,                  -- NULL once delivered or dead-lettered
+) PRIMARY KEY (TenantId, SubscriptionId, DeliveryId),
+  INTERLEAVE IN PARENT NotificationSubscriptions ON DELETE CASCADE;
+
+-- Only pending deliveries have a NextAttemptAt, so this indexes the delivery queue
+CREATE NULL_FILTERED INDEX NotificationDeliveriesDue ON NotificationDeliveries(NextAttemptAt);
+
+CREATE INDEX NotificationDeliveriesByTenant ON NotificationDeliveries(TenantId, CreatedAt DESC);
@@ -0,0 +1,4 @@
+-- Migration: Add the Jobs ProvisioningModel and PreemptionCount columns
+
+ALTER TABLE Jobs ADD COLUMN ProvisioningModel STRING(20);
+ALTER TABLE Jobs ADD COLUMN PreemptionCount INT64 NOT NULL DEFAULT (0);
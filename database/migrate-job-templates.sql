@@ -0,0 +1,32 @@
+-- Migration: Add versioned job templates and record the template revision of each job
+
+CREATE TABLE JobTemplates (
+  TenantId STRING(36) NOT NULL,
+  TemplateId STRING(36) NOT NULL,
+  Name STRING(63) NOT NULL,
+  Description STRING(MAX),
+  LatestRevision INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, TemplateId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX JobTemplatesByName ON JobTemplates(TenantId, Name);
+
+CREATE TABLE JobTemplateRevisions (
+  TenantId STRING(36) NOT NULL,
+  TemplateId STRING(36) NOT NULL,
+  Revision INT64 NOT NULL,  -- 1, 2, ...; rows are never updated
+  ImageUri STRING(1024) NOT NULL,
+  EnvVars STRING(MAX) NOT NULL,     -- JSON object of name to value
+  CpuMilli INT64,
+  MemoryMib INT64,
+  TaskCount INT64 NOT NULL,
+  Parameters STRING(MAX) NOT NULL,  -- JSON array of declared parameters
+  CreatedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, TemplateId, Revision),
+  INTERLEAVE IN PARENT JobTemplates ON DELETE CASCADE;
+
+ALTER TABLE Jobs ADD COLUMN TemplateId STRING(36);
+ALTER TABLE Jobs ADD COLUMN TemplateRevision INT64;
@@ -0,0 +1,4 @@
+-- Migration: Add the Jobs MaxRunDurationSeconds and MaxQueueDurationSeconds columns
+
+ALTER TABLE Jobs ADD COLUMN MaxRunDurationSeconds INT64;
+ALTER TABLE Jobs ADD COLUMN MaxQueueDurationSeconds INT64;
@@ -0,0 +1,25 @@
+-- Migration: Add JobUsage, the resources each finished job used
+
+CREATE TABLE JobUsage (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  CompletedAt TIMESTAMP NOT NULL,           -- Copied from Jobs, for time range queries
+  Status STRING(50) NOT NULL,               -- COMPLETED, FAILED or CANCELLED
+  Region STRING(64),
+  MachineType STRING(64),                   -- As reported by GCP Batch; NULL if unknown
+  ProvisioningModel STRING(20),             -- STANDARD, SPOT or PREEMPTIBLE; NULL if unknown
+  Instances INT64,                          -- VMs the tasks ran on; NULL if unknown
+  TaskCount INT64 NOT NULL,
+  RunSeconds FLOAT64 NOT NULL,              -- StartedAt to CompletedAt
+  RequestedVcpuSeconds FLOAT64 NOT NULL,    -- Requested vCPU per task × tasks × RunSeconds
+  RequestedMemoryGibSeconds FLOAT64 NOT NULL,
+  VcpuSeconds FLOAT64,                      -- vCPU of the VMs × Instances × RunSeconds; NULL if unknown
+  MemoryGibSeconds FLOAT64,
+  RecordedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, JobId),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
+
+CREATE INDEX JobUsageByTenant ON JobUsage(TenantId, CompletedAt);
+
+-- For platform-wide usage across tenants
+CREATE INDEX JobUsageByCompletedAt ON JobUsage(CompletedAt);
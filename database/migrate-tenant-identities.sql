@@ -0,0 +1,49 @@
+-- Migration: Move OAuth identities from Tenants into TenantIdentities
+-- A tenant can now have several linked identities (e.g. Google and GitHub).
+-- Run in four steps; deploy the new gateway between steps 2 and 3, and run
+-- step 3 as soon as every old gateway instance has stopped.
+
+-- Step 1 (DDL): create the table and index, and let Tenants rows be created
+-- without the old columns, which the new gateway no longer sets. The old
+-- index must skip NULLs, or a second tenant without them would collide.
+CREATE TABLE TenantIdentities (
+  TenantId STRING(36) NOT NULL,
+  OAuthProvider STRING(50) NOT NULL,
+  OAuthUserId STRING(255) NOT NULL,
+  Email STRING(255) NOT NULL,
+  LinkedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, OAuthProvider, OAuthUserId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX TenantIdentitiesByOAuth ON TenantIdentities(OAuthProvider, OAuthUserId);
+
+DROP INDEX TenantsByOAuth;
+ALTER TABLE Tenants ALTER COLUMN OAuthProvider STRING(50);
+ALTER TABLE Tenants ALTER COLUMN OAuthUserId STRING(255);
+CREATE UNIQUE NULL_FILTERED INDEX TenantsByOAuth ON Tenants(OAuthProvider, OAuthUserId);
+
+-- Step 2 (DML, with gcloud spanner databases execute-sql): copy existing
+-- identities. Identities already copied are skipped, so it can be run again.
+INSERT INTO TenantIdentities (TenantId, OAuthProvider, OAuthUserId, Email, LinkedAt)
+SELECT t.TenantId, t.OAuthProvider, t.OAuthUserId, t.UserEmail, t.CreatedAt FROM Tenants t
+WHERE t.OAuthProvider IS NOT NULL AND t.OAuthUserId IS NOT NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM TenantIdentities i
+    WHERE i.OAuthProvider = t.OAuthProvider AND i.OAuthUserId = t.OAuthUserId
+  );
+
+-- Deploy the new gateway.
+
+-- Step 3 (DML): run step 2 again, once no old gateway is left, to copy the
+-- identities of tenants the old gateway created after step 2. Until then, such
+-- a user signing in through the new gateway gets a second, empty tenant, and
+-- step 3 leaves their old one without an identity; find those with:
+--   SELECT TenantId, UserEmail FROM Tenants t WHERE t.OAuthProvider IS NOT NULL
+--   AND NOT EXISTS (SELECT 1 FROM TenantIdentities i WHERE i.TenantId = t.TenantId);
+-- then delete the user's new tenant with the AdminService's DeleteTenant and
+-- run step 3 again.
+
+-- Step 4 (DDL): drop the old columns
+DROP INDEX TenantsByOAuth;
+ALTER TABLE Tenants DROP COLUMN OAuthProvider;
+ALTER TABLE Tenants DROP COLUMN OAuthUserId;
@@ -0,0 +1,7 @@
+-- Migration: Add tenant profile and suspension fields for the admin lifecycle API
+-- A tenant is suspended while SuspendedAt is set.
+
+ALTER TABLE Tenants ADD COLUMN DisplayName STRING(255);
+ALTER TABLE Tenants ADD COLUMN ContactEmail STRING(255);
+ALTER TABLE Tenants ADD COLUMN SuspendedAt TIMESTAMP;
+ALTER TABLE Tenants ADD COLUMN SuspendReason STRING(MAX);
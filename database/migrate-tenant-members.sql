@@ -0,0 +1,29 @@
+-- Migration: Add multi-user tenants with membership, roles and invitations
+-- Every user keeps a personal tenant (created on first login) and is its implicit owner.
+
+CREATE TABLE TenantMembers (
+  TenantId STRING(36) NOT NULL,
+  MemberTenantId STRING(36) NOT NULL,  -- Personal tenant of the member user
+  Role STRING(20) NOT NULL,            -- owner, admin, submitter, viewer
+  MemberEmail STRING(255) NOT NULL,
+  AddedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, MemberTenantId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE INDEX TenantMembersByMember ON TenantMembers(MemberTenantId);
+
+CREATE TABLE TenantInvitations (
+  TenantId STRING(36) NOT NULL,
+  InvitationId STRING(36) NOT NULL,
+  Email STRING(255) NOT NULL,  -- Lowercased invitee email
+  Role STRING(20) NOT NULL,
+  InvitedBy STRING(255) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  ExpiresAt TIMESTAMP NOT NULL,
+  AcceptedAt TIMESTAMP,
+) PRIMARY KEY (TenantId, InvitationId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE INDEX InvitationsByEmail ON TenantInvitations(Email);
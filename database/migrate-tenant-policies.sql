@@ -0,0 +1,15 @@
+-- Migration: Add the TenantPolicies table
+
+CREATE TABLE TenantPolicies (
+  TenantId STRING(36) NOT NULL,
+  AllowedRegistries ARRAY<STRING(MAX)>,
+  RequireDigest BOOL NOT NULL,
+  MaxCpuMilli INT64 NOT NULL,
+  MaxMemoryMib INT64 NOT NULL,
+  MaxTaskCount INT64 NOT NULL,
+  MaxRunDurationSeconds INT64 NOT NULL,
+  ForbiddenOptions ARRAY<STRING(64)>,
+  RequiredLabels ARRAY<STRING(63)>,
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
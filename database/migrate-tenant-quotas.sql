@@ -0,0 +1,17 @@
+-- Migration: Add per-tenant quotas and job resource tracking
+-- Run this to add the TenantQuotas table and resource columns on Jobs
+
+ALTER TABLE Jobs ADD COLUMN CpuMilli INT64;
+ALTER TABLE Jobs ADD COLUMN MemoryMib INT64;
+ALTER TABLE Jobs ADD COLUMN TaskCount INT64 NOT NULL DEFAULT (1);
+
+CREATE TABLE TenantQuotas (
+  TenantId STRING(36) NOT NULL,
+  MaxConcurrentJobs INT64 NOT NULL,
+  MaxSubmissionsPerMinute INT64 NOT NULL,
+  MaxCpuMilli INT64 NOT NULL,
+  MaxMemoryMib INT64 NOT NULL,
+  MaxTaskCount INT64 NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
@@ -0,0 +1,10 @@
+-- Migration: Make (OAuthProvider, OAuthUserId) unique across tenants
+-- Prevents two gateway instances from creating separate tenants for the same identity.
+-- Creating the index fails if duplicates already exist; find them first with:
+--   SELECT OAuthProvider, OAuthUserId, COUNT(*) FROM Tenants
+--   GROUP BY OAuthProvider, OAuthUserId HAVING COUNT(*) > 1;
+-- and merge or delete the extra tenants before running this.
+
+DROP INDEX TenantsByOAuth;
+
+CREATE UNIQUE INDEX TenantsByOAuth ON Tenants(OAuthProvider, OAuthUserId);
@@ -1,13 +1,24 @@
 CREATE TABLE Tenants (
   TenantId STRING(36) NOT NULL,
-  UserEmail STRING(255) NOT NULL,
-  OAuthProvider STRING(50) NOT NULL,
-  OAuthUserId STRING(255) NOT NULL,
+  UserEmail STRING(255) NOT NULL,  -- Email of the identity that created the tenant
   CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
   UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  DisplayName STRING(255),
+  ContactEmail STRING(255),
+  SuspendedAt TIMESTAMP,
+  SuspendReason STRING(MAX),
 ) PRIMARY KEY (TenantId);
 
-CREATE INDEX TenantsByOAuth ON Tenants(OAuthProvider, OAuthUserId);
+CREATE TABLE TenantIdentities (
+  TenantId STRING(36) NOT NULL,
+  OAuthProvider STRING(50) NOT NULL,
+  OAuthUserId STRING(255) NOT NULL,
+  Email STRING(255) NOT NULL,
+  LinkedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, OAuthProvider, OAuthUserId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX TenantIdentitiesByOAuth ON TenantIdentities(OAuthProvider, OAuthUserId);
 
 CREATE TABLE Jobs (
   TenantId STRING(36) NOT NULL,
@@ -26,10 +37,33 @@ CREATE TABLE Jobs (
   MaxRetries INT64 NOT NULL DEFAULT (3),
   ErrorMessage STRING(MAX),
   GcpBatchJobName STRING(1024),  -- Full GCP Batch resource name (projects/.../jobs/jennah-xxx)
+  -- Requested Resources (per task)
+  CpuMilli INT64,
+  MemoryMib INT64,
+  TaskCount INT64 NOT NULL DEFAULT (1),
+  -- Placement
+  Location STRING(64),  -- GCP region the Batch job was created in
+  -- Template the job was launched from, if any
+  TemplateId STRING(36),
+  TemplateRevision INT64,
+  -- Labels are copied to the Batch job and can be selected on; annotations are not
+  Labels ARRAY<STRING(MAX)>,       -- "key=value" strings
+  Annotations ARRAY<STRING(MAX)>,  -- "key=value" strings
+  -- Spot and preemptible VMs
+  ProvisioningModel STRING(20),    -- STANDARD, SPOT or PREEMPTIBLE, of the current Batch job; NULL is STANDARD
+  PreemptionCount INT64 NOT NULL DEFAULT (0),
+  -- Timeouts; NULL is no limit
+  MaxRunDurationSeconds INT64,     -- Per task, enforced by GCP Batch
+  MaxQueueDurationSeconds INT64,   -- From CreatedAt until RUNNING, enforced by the workers
+  -- Declared outputs, recorded as JobArtifacts when the job completes
+  Outputs ARRAY<STRING(MAX)>,      -- "name=gs://bucket/pattern" strings
+  -- Digest ImageUri resolved to at submission, which the Batch job runs
+  ImageDigest STRING(71),          -- "sha256:<hex>"; NULL for jobs submitted before resolution
 ) PRIMARY KEY (TenantId, JobId),
   INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
 
 CREATE INDEX JobsByStatus ON Jobs(TenantId, Status, CreatedAt DESC);
+CREATE INDEX JobsByLocation ON Jobs(Location, Status) STORING (CpuMilli, TaskCount);
 
 CREATE TABLE JobStateTransitions (
   TenantId STRING(36) NOT NULL,
@@ -43,3 +77,192 @@ CREATE TABLE JobStateTransitions (
   INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
 
 CREATE INDEX TransitionsByJob ON JobStateTransitions(TenantId, JobId, TransitionedAt DESC);
+
+CREATE TABLE TenantQuotas (
+  TenantId STRING(36) NOT NULL,
+  MaxConcurrentJobs INT64 NOT NULL,
+  MaxSubmissionsPerMinute INT64 NOT NULL,
+  MaxCpuMilli INT64 NOT NULL,
+  MaxMemoryMib INT64 NOT NULL,
+  MaxTaskCount INT64 NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE TABLE TenantPolicies (
+  TenantId STRING(36) NOT NULL,
+  AllowedRegistries ARRAY<STRING(MAX)>,
+  RequireDigest BOOL NOT NULL,
+  MaxCpuMilli INT64 NOT NULL,
+  MaxMemoryMib INT64 NOT NULL,
+  MaxTaskCount INT64 NOT NULL,
+  MaxRunDurationSeconds INT64 NOT NULL,
+  ForbiddenOptions ARRAY<STRING(64)>,
+  RequiredLabels ARRAY<STRING(63)>,
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE TABLE ApiKeys (
+  TenantId STRING(36) NOT NULL,
+  KeyId STRING(36) NOT NULL,
+  Name STRING(255) NOT NULL,
+  KeyHash STRING(64) NOT NULL,  -- Hex SHA-256 of the key; the key itself is never stored
+  Prefix STRING(16) NOT NULL,
+  CreatedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  ExpiresAt TIMESTAMP,
+  LastUsedAt TIMESTAMP,
+  RevokedAt TIMESTAMP,
+) PRIMARY KEY (TenantId, KeyId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX ApiKeysByHash ON ApiKeys(KeyHash);
+
+CREATE TABLE TenantMembers (
+  TenantId STRING(36) NOT NULL,
+  MemberTenantId STRING(36) NOT NULL,  -- Personal tenant of the member user
+  Role STRING(20) NOT NULL,            -- owner, admin, submitter, viewer
+  MemberEmail STRING(255) NOT NULL,
+  AddedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, MemberTenantId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE INDEX TenantMembersByMember ON TenantMembers(MemberTenantId);
+
+CREATE TABLE TenantInvitations (
+  TenantId STRING(36) NOT NULL,
+  InvitationId STRING(36) NOT NULL,
+  Email STRING(255) NOT NULL,  -- Lowercased invitee email
+  Role STRING(20) NOT NULL,
+  InvitedBy STRING(255) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  ExpiresAt TIMESTAMP NOT NULL,
+  AcceptedAt TIMESTAMP,
+) PRIMARY KEY (TenantId, InvitationId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE INDEX InvitationsByEmail ON TenantInvitations(Email);
+
+CREATE TABLE JobTemplates (
+  TenantId STRING(36) NOT NULL,
+  TemplateId STRING(36) NOT NULL,
+  Name STRING(63) NOT NULL,
+  Description STRING(MAX),
+  LatestRevision INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, TemplateId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX JobTemplatesByName ON JobTemplates(TenantId, Name);
+
+CREATE TABLE JobTemplateRevisions (
+  TenantId STRING(36) NOT NULL,
+  TemplateId STRING(36) NOT NULL,
+  Revision INT64 NOT NULL,  -- 1, 2, ...; rows are never updated
+  ImageUri STRING(1024) NOT NULL,
+  EnvVars STRING(MAX) NOT NULL,     -- JSON object of name to value
+  CpuMilli INT64,
+  MemoryMib INT64,
+  TaskCount INT64 NOT NULL,
+  Parameters STRING(MAX) NOT NULL,  -- JSON array of declared parameters
+  CreatedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, TemplateId, Revision),
+  INTERLEAVE IN PARENT JobTemplates ON DELETE CASCADE;
+
+CREATE TABLE NotificationSubscriptions (
+  TenantId STRING(36) NOT NULL,
+  SubscriptionId STRING(36) NOT NULL,
+  Url STRING(2048) NOT NULL,
+  Secret STRING(MAX) NOT NULL,              -- HMAC-SHA256 key for the X-Jennah-Signature header
+  Statuses ARRAY<STRING(50)> NOT NULL,      -- Job statuses whose transitions are sent
+  Format STRING(16) NOT NULL,               -- json or slack
+  CreatedBy STRING(255),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, SubscriptionId),
+  INTERLEAVE IN PARENT Tenants ON DELETE CASCADE;
+
+CREATE TABLE NotificationDeliveries (
+  TenantId STRING(36) NOT NULL,
+  SubscriptionId STRING(36) NOT NULL,
+  DeliveryId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  FromStatus STRING(50) NOT NULL,
+  ToStatus STRING(50) NOT NULL,
+  Reason STRING(MAX),
+  Status STRING(20) NOT NULL,               -- PENDING, DELIVERED or DEAD_LETTER
+  Attempts INT64 NOT NULL,
+  LastResponseCode INT64,                   -- HTTP status of the last attempt; NULL if no response
+  LastError STRING(MAX),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),  -- When the transition was recorded
+  LastAttemptAt TIMESTAMP,
+  DeliveredAt TIMESTAMP,
+  NextAttemptAt TIMESTAMP,                  -- NULL once delivered or dead-lettered
+) PRIMARY KEY (TenantId, SubscriptionId, DeliveryId),
+  INTERLEAVE IN PARENT NotificationSubscriptions ON DELETE CASCADE;
+
+-- Only pending deliveries have a NextAttemptAt, so this indexes the delivery queue
+CREATE NULL_FILTERED INDEX NotificationDeliveriesDue ON NotificationDeliveries(NextAttemptAt);
+
+CREATE INDEX NotificationDeliveriesByTenant ON NotificationDeliveries(TenantId, CreatedAt DESC);
+
+CREATE TABLE JobEvents (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  Sequence INT64 NOT NULL,                  -- Position in the job's events, from 1
+  EventId STRING(36) NOT NULL,
+  EventType STRING(20) NOT NULL,            -- SUBMITTED, SCHEDULED, STARTED, RETRIED, SUCCEEDED, FAILED, CANCELLED or TIMED_OUT
+  FromStatus STRING(50),                    -- NULL for SUBMITTED
+  ToStatus STRING(50) NOT NULL,
+  Reason STRING(MAX),
+  OccurredAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  Attempts INT64 NOT NULL,                  -- Failed publish attempts
+  LastError STRING(MAX),
+  NextAttemptAt TIMESTAMP,                  -- NULL once published
+  PublishedAt TIMESTAMP,
+) PRIMARY KEY (TenantId, JobId, Sequence),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
+
+-- Only unpublished events have a NextAttemptAt, so this indexes the publish queue
+CREATE NULL_FILTERED INDEX JobEventsUnpublished ON JobEvents(NextAttemptAt);
+
+CREATE TABLE JobUsage (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  CompletedAt TIMESTAMP NOT NULL,           -- Copied from Jobs, for time range queries
+  Status STRING(50) NOT NULL,               -- COMPLETED, FAILED, CANCELLED or TIMED_OUT
+  Region STRING(64),
+  MachineType STRING(64),                   -- As reported by GCP Batch; NULL if unknown
+  ProvisioningModel STRING(20),             -- STANDARD, SPOT or PREEMPTIBLE; NULL if unknown
+  Instances INT64,                          -- VMs the tasks ran on; NULL if unknown
+  TaskCount INT64 NOT NULL,
+  RunSeconds FLOAT64 NOT NULL,              -- StartedAt to CompletedAt
+  RequestedVcpuSeconds FLOAT64 NOT NULL,    -- Requested vCPU per task × tasks × RunSeconds
+  RequestedMemoryGibSeconds FLOAT64 NOT NULL,
+  VcpuSeconds FLOAT64,                      -- vCPU of the VMs × Instances × RunSeconds; NULL if unknown
+  MemoryGibSeconds FLOAT64,
+  RecordedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, JobId),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
+
+CREATE INDEX JobUsageByTenant ON JobUsage(TenantId, CompletedAt);
+
+-- For platform-wide usage across tenants
+CREATE INDEX JobUsageByCompletedAt ON JobUsage(CompletedAt);
+
+-- Objects that matched a job's declared outputs when it completed
+CREATE TABLE JobArtifacts (
+  TenantId STRING(36) NOT NULL,
+  JobId STRING(36) NOT NULL,
+  Uri STRING(1024) NOT NULL,                -- gs://bucket/object
+  Output STRING(63) NOT NULL,               -- Name of the declared output it matched
+  SizeBytes INT64 NOT NULL,
+  Crc32c STRING(16) NOT NULL,               -- Base64 big-endian CRC32C, as Cloud Storage reports it
+  UpdatedAt TIMESTAMP NOT NULL,             -- When the object was last written
+  RecordedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (TenantId, JobId, Uri),
+  INTERLEAVE IN PARENT Jobs ON DELETE CASCADE;
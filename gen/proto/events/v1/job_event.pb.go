@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/events/v1/job_event.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type JobEvent_Type int32
+
+const (
+	JobEvent_TYPE_UNSPECIFIED JobEvent_Type = 0
+	JobEvent_SUBMITTED        JobEvent_Type = 1 // Job accepted and stored as PENDING
+	JobEvent_SCHEDULED        JobEvent_Type = 2 // GCP Batch job created
+	JobEvent_STARTED          JobEvent_Type = 3 // GCP Batch reports the job running
+	JobEvent_RETRIED          JobEvent_Type = 4 // Job went back to PENDING to be run again
+	JobEvent_SUCCEEDED        JobEvent_Type = 5
+	JobEvent_FAILED           JobEvent_Type = 6
+	JobEvent_CANCELLED        JobEvent_Type = 7
+	JobEvent_TIMED_OUT        JobEvent_Type = 8 // Job ran or was queued longer than its max_run_duration or max_queue_duration
+)
+
+// Enum value maps for JobEvent_Type.
+var (
+	JobEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SUBMITTED",
+		2: "SCHEDULED",
+		3: "STARTED",
+		4: "RETRIED",
+		5: "SUCCEEDED",
+		6: "FAILED",
+		7: "CANCELLED",
+		8: "TIMED_OUT",
+	}
+	JobEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SUBMITTED":        1,
+		"SCHEDULED":        2,
+		"STARTED":          3,
+		"RETRIED":          4,
+		"SUCCEEDED":        5,
+		"FAILED":           6,
+		"CANCELLED":        7,
+		"TIMED_OUT":        8,
+	}
+)
+
+func (x JobEvent_Type) Enum() *JobEvent_Type {
+	p := new(JobEvent_Type)
+	*p = x
+	return p
+}
+
+func (x JobEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_events_v1_job_event_proto_enumTypes[0].Descriptor()
+}
+
+func (JobEvent_Type) Type() protoreflect.EnumType {
+	return &file_proto_events_v1_job_event_proto_enumTypes[0]
+}
+
+func (x JobEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobEvent_Type.Descriptor instead.
+func (JobEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_events_v1_job_event_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// A change in a job's lifecycle, published by the workers to the job events topic.
+//
+// Within this package, fields are only ever added; a field is never renumbered, retyped
+// or reused. A change that breaks readers gets a new package, jennah.events.v2, published
+// alongside this one.
+type JobEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EventId          string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // Same on every redelivery; use it to drop duplicates
+	Type             JobEvent_Type          `protobuf:"varint,2,opt,name=type,proto3,enum=jennah.events.v1.JobEvent_Type" json:"type,omitempty"`
+	Sequence         int64                  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"` // Position in the job's events, from 1
+	OccurredAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	TenantId         string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId            string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                                       // Job status after the event, e.g. "RUNNING"
+	PreviousStatus   string                 `protobuf:"bytes,8,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"` // Empty for SUBMITTED
+	Reason           string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`                                       // Why the job failed or was cancelled, if known
+	ImageUri         string                 `protobuf:"bytes,10,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Region           string                 `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"` // Empty until the job is placed
+	Labels           map[string]string      `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CpuMilli         int64                  `protobuf:"varint,13,opt,name=cpu_milli,json=cpuMilli,proto3" json:"cpu_milli,omitempty"`    // Per task; 0 if the GCP Batch default is used
+	MemoryMib        int64                  `protobuf:"varint,14,opt,name=memory_mib,json=memoryMib,proto3" json:"memory_mib,omitempty"` // Per task; 0 if the GCP Batch default is used
+	TaskCount        int64                  `protobuf:"varint,15,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	TemplateId       string                 `protobuf:"bytes,16,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // Empty unless launched from a job template
+	TemplateRevision int64                  `protobuf:"varint,17,opt,name=template_revision,json=templateRevision,proto3" json:"template_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JobEvent) Reset() {
+	*x = JobEvent{}
+	mi := &file_proto_events_v1_job_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEvent) ProtoMessage() {}
+
+func (x *JobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_v1_job_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return file_proto_events_v1_job_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JobEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *JobEvent) GetType() JobEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return JobEvent_TYPE_UNSPECIFIED
+}
+
+func (x *JobEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *JobEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *JobEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *JobEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobEvent) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *JobEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *JobEvent) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *JobEvent) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *JobEvent) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *JobEvent) GetCpuMilli() int64 {
+	if x != nil {
+		return x.CpuMilli
+	}
+	return 0
+}
+
+func (x *JobEvent) GetMemoryMib() int64 {
+	if x != nil {
+		return x.MemoryMib
+	}
+	return 0
+}
+
+func (x *JobEvent) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *JobEvent) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *JobEvent) GetTemplateRevision() int64 {
+	if x != nil {
+		return x.TemplateRevision
+	}
+	return 0
+}
+
+var File_proto_events_v1_job_event_proto protoreflect.FileDescriptor
+
+const file_proto_events_v1_job_event_proto_rawDesc = "" +
+	"\n" +
+	"\x1fproto/events/v1/job_event.proto\x12\x10jennah.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x06\n" +
+	"\bJobEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.jennah.events.v1.JobEvent.TypeR\x04type\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x03R\bsequence\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12'\n" +
+	"\x0fprevious_status\x18\b \x01(\tR\x0epreviousStatus\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1b\n" +
+	"\timage_uri\x18\n" +
+	" \x01(\tR\bimageUri\x12\x16\n" +
+	"\x06region\x18\v \x01(\tR\x06region\x12>\n" +
+	"\x06labels\x18\f \x03(\v2&.jennah.events.v1.JobEvent.LabelsEntryR\x06labels\x12\x1b\n" +
+	"\tcpu_milli\x18\r \x01(\x03R\bcpuMilli\x12\x1d\n" +
+	"\n" +
+	"memory_mib\x18\x0e \x01(\x03R\tmemoryMib\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x0f \x01(\x03R\ttaskCount\x12\x1f\n" +
+	"\vtemplate_id\x18\x10 \x01(\tR\n" +
+	"templateId\x12+\n" +
+	"\x11template_revision\x18\x11 \x01(\x03R\x10templateRevision\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8d\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tSUBMITTED\x10\x01\x12\r\n" +
+	"\tSCHEDULED\x10\x02\x12\v\n" +
+	"\aSTARTED\x10\x03\x12\v\n" +
+	"\aRETRIED\x10\x04\x12\r\n" +
+	"\tSUCCEEDED\x10\x05\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x06\x12\r\n" +
+	"\tCANCELLED\x10\a\x12\r\n" +
+	"\tTIMED_OUT\x10\bB<Z:github.com/alphauslabs/jennah/gen/proto/events/v1;eventsv1b\x06proto3"
+
+var (
+	file_proto_events_v1_job_event_proto_rawDescOnce sync.Once
+	file_proto_events_v1_job_event_proto_rawDescData []byte
+)
+
+func file_proto_events_v1_job_event_proto_rawDescGZIP() []byte {
+	file_proto_events_v1_job_event_proto_rawDescOnce.Do(func() {
+		file_proto_events_v1_job_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_events_v1_job_event_proto_rawDesc), len(file_proto_events_v1_job_event_proto_rawDesc)))
+	})
+	return file_proto_events_v1_job_event_proto_rawDescData
+}
+
+var file_proto_events_v1_job_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_events_v1_job_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_events_v1_job_event_proto_goTypes = []any{
+	(JobEvent_Type)(0),            // 0: jennah.events.v1.JobEvent.Type
+	(*JobEvent)(nil),              // 1: jennah.events.v1.JobEvent
+	nil,                           // 2: jennah.events.v1.JobEvent.LabelsEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_proto_events_v1_job_event_proto_depIdxs = []int32{
+	0, // 0: jennah.events.v1.JobEvent.type:type_name -> jennah.events.v1.JobEvent.Type
+	3, // 1: jennah.events.v1.JobEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2, // 2: jennah.events.v1.JobEvent.labels:type_name -> jennah.events.v1.JobEvent.LabelsEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_events_v1_job_event_proto_init() }
+func file_proto_events_v1_job_event_proto_init() {
+	if File_proto_events_v1_job_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_events_v1_job_event_proto_rawDesc), len(file_proto_events_v1_job_event_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_events_v1_job_event_proto_goTypes,
+		DependencyIndexes: file_proto_events_v1_job_event_proto_depIdxs,
+		EnumInfos:         file_proto_events_v1_job_event_proto_enumTypes,
+		MessageInfos:      file_proto_events_v1_job_event_proto_msgTypes,
+	}.Build()
+	File_proto_events_v1_job_event_proto = out.File
+	file_proto_events_v1_job_event_proto_goTypes = nil
+	file_proto_events_v1_job_event_proto_depIdxs = nil
+}
@@ -9,6 +9,8 @@ package jennahv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,9 +24,52 @@ const (
 )
 
 type SubmitJobRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImageUri      string                 `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
-	EnvVars       map[string]string      `protobuf:"bytes,3,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Example: { "DB_HOST": "10.0.0.1", "DEBUG": "true" }
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ImageUri  string                 `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	EnvVars   map[string]string      `protobuf:"bytes,3,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Example: { "DB_HOST": "10.0.0.1", "DEBUG": "true" }
+	Resources *ResourceRequirements  `protobuf:"bytes,4,opt,name=resources,proto3" json:"resources,omitempty"`                                                                                      // Per-task compute resources. Defaults to the GCP Batch defaults.
+	TaskCount int64                  `protobuf:"varint,5,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`                                                                    // Number of tasks to run. Defaults to 1.
+	// GCP regions the job may run in, e.g. ["asia-northeast1", "asia-southeast1"].
+	// Empty allows every region the worker is configured for.
+	AllowedRegions []string `protobuf:"bytes,6,rep,name=allowed_regions,json=allowedRegions,proto3" json:"allowed_regions,omitempty"`
+	// Regions to try first, in order. Other allowed regions are still used as a
+	// fallback, ranked by free capacity, when these are full or out of quota.
+	PreferredRegions []string `protobuf:"bytes,7,rep,name=preferred_regions,json=preferredRegions,proto3" json:"preferred_regions,omitempty"`
+	// Template revision the job was rendered from. Set by the gateway for
+	// SubmitJobFromTemplate; ignored when sent by API clients.
+	TemplateId       string `protobuf:"bytes,8,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateRevision int64  `protobuf:"varint,9,opt,name=template_revision,json=templateRevision,proto3" json:"template_revision,omitempty"`
+	// Labels for organizing and selecting jobs, copied to the GCP Batch job. Keys and
+	// values follow the GCP label rules: lowercase letters, digits, "_" and "-", at most
+	// 63 characters, keys starting with a letter; at most 64 labels.
+	Labels map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Free-form notes on the job, e.g. a commit SHA or runbook URL. Not sent to GCP
+	// and not selectable.
+	Annotations map[string]string `protobuf:"bytes,11,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Validate the job and return the GCP Batch job it would create, without creating
+	// anything or using up a submission.
+	DryRun bool `protobuf:"varint,12,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// VMs to run on: STANDARD (default), SPOT or PREEMPTIBLE. Spot and preemptible VMs
+	// cost less but can be reclaimed at any time; a job whose VMs are preempted is
+	// resubmitted, on STANDARD VMs once it has been preempted the worker's configured
+	// number of times.
+	ProvisioningModel string `protobuf:"bytes,13,opt,name=provisioning_model,json=provisioningModel,proto3" json:"provisioning_model,omitempty"`
+	// Longest each task may run, e.g. "3600s". GCP Batch stops a task that runs longer and
+	// the job ends TIMED_OUT. Unset is no limit.
+	MaxRunDuration *durationpb.Duration `protobuf:"bytes,14,opt,name=max_run_duration,json=maxRunDuration,proto3" json:"max_run_duration,omitempty"`
+	// Longest the job may wait to start running, from submission. The worker cancels a job
+	// still queued after it and the job ends TIMED_OUT. Unset is no limit.
+	MaxQueueDuration *durationpb.Duration `protobuf:"bytes,15,opt,name=max_queue_duration,json=maxQueueDuration,proto3" json:"max_queue_duration,omitempty"`
+	// Files the job writes, by output name, as GCS URI patterns, e.g.
+	// { "report": "gs://my-bucket/reports/{job_id}/*.csv" }. "{job_id}" is replaced by the
+	// job's ID, which the job's tasks read from JENNAH_JOB_ID; "*", "?" and "[...]" match
+	// within one path segment. Matching objects are recorded as artifacts when the job
+	// completes. Names follow the label key rules; at most 10 outputs.
+	Outputs map[string]string `protobuf:"bytes,16,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The tenant's quota, whose capacity limits the worker checks in the same
+	// transaction as it records the job. Set by the gateway; ignored when sent by
+	// API clients.
+	Quota         *TenantQuota `protobuf:"bytes,17,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,18 +118,174 @@ func (x *SubmitJobRequest) GetEnvVars() map[string]string {
 	return nil
 }
 
+func (x *SubmitJobRequest) GetResources() *ResourceRequirements {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetAllowedRegions() []string {
+	if x != nil {
+		return x.AllowedRegions
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetPreferredRegions() []string {
+	if x != nil {
+		return x.PreferredRegions
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetTemplateRevision() int64 {
+	if x != nil {
+		return x.TemplateRevision
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SubmitJobRequest) GetProvisioningModel() string {
+	if x != nil {
+		return x.ProvisioningModel
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetMaxRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRunDuration
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetMaxQueueDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxQueueDuration
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetOutputs() map[string]string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type ResourceRequirements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CpuMilli      int64                  `protobuf:"varint,1,opt,name=cpu_milli,json=cpuMilli,proto3" json:"cpu_milli,omitempty"` // 1000 = 1 vCPU
+	MemoryMib     int64                  `protobuf:"varint,2,opt,name=memory_mib,json=memoryMib,proto3" json:"memory_mib,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceRequirements) Reset() {
+	*x = ResourceRequirements{}
+	mi := &file_proto_jennah_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRequirements) ProtoMessage() {}
+
+func (x *ResourceRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRequirements.ProtoReflect.Descriptor instead.
+func (*ResourceRequirements) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceRequirements) GetCpuMilli() int64 {
+	if x != nil {
+		return x.CpuMilli
+	}
+	return 0
+}
+
+func (x *ResourceRequirements) GetMemoryMib() int64 {
+	if x != nil {
+		return x.MemoryMib
+	}
+	return 0
+}
+
 type SubmitJobResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	JobId          string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	WorkerAssigned string                 `protobuf:"bytes,3,opt,name=worker_assigned,json=workerAssigned,proto3" json:"worker_assigned,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Region         string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"` // GCP region the Batch job was created in
+	// For dry runs only: the google.cloud.batch.v1.Job that would be sent to GCP Batch,
+	// in its JSON form, with environment variable values masked. job_id and status are empty.
+	BatchJob *structpb.Struct `protobuf:"bytes,5,opt,name=batch_job,json=batchJob,proto3" json:"batch_job,omitempty"`
+	// Digest image_uri resolved to, e.g. "sha256:...", which the Batch job runs.
+	ImageDigest   string `protobuf:"bytes,6,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitJobResponse) Reset() {
 	*x = SubmitJobResponse{}
-	mi := &file_proto_jennah_proto_msgTypes[1]
+	mi := &file_proto_jennah_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +297,7 @@ func (x *SubmitJobResponse) String() string {
 func (*SubmitJobResponse) ProtoMessage() {}
 
 func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[1]
+	mi := &file_proto_jennah_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +310,7 @@ func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitJobResponse.ProtoReflect.Descriptor instead.
 func (*SubmitJobResponse) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{1}
+	return file_proto_jennah_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SubmitJobResponse) GetJobId() string {
@@ -133,15 +334,39 @@ func (x *SubmitJobResponse) GetWorkerAssigned() string {
 	return ""
 }
 
+func (x *SubmitJobResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SubmitJobResponse) GetBatchJob() *structpb.Struct {
+	if x != nil {
+		return x.BatchJob
+	}
+	return nil
+}
+
+func (x *SubmitJobResponse) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
 type ListJobsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kubernetes-style label selector, e.g. "team=billing,env!=dev". Supports =, ==, !=,
+	// "in (a,b)", "notin (a,b)", "key" and "!key". Empty lists every job.
+	LabelSelector string `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListJobsRequest) Reset() {
 	*x = ListJobsRequest{}
-	mi := &file_proto_jennah_proto_msgTypes[2]
+	mi := &file_proto_jennah_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -153,7 +378,7 @@ func (x *ListJobsRequest) String() string {
 func (*ListJobsRequest) ProtoMessage() {}
 
 func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[2]
+	mi := &file_proto_jennah_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +391,14 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
 func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{2}
+	return file_proto_jennah_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListJobsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
 }
 
 type ListJobsResponse struct {
@@ -178,7 +410,7 @@ type ListJobsResponse struct {
 
 func (x *ListJobsResponse) Reset() {
 	*x = ListJobsResponse{}
-	mi := &file_proto_jennah_proto_msgTypes[3]
+	mi := &file_proto_jennah_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -190,7 +422,7 @@ func (x *ListJobsResponse) String() string {
 func (*ListJobsResponse) ProtoMessage() {}
 
 func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[3]
+	mi := &file_proto_jennah_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +435,7 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
 func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{3}
+	return file_proto_jennah_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListJobsResponse) GetJobs() []*Job {
@@ -214,19 +446,37 @@ func (x *ListJobsResponse) GetJobs() []*Job {
 }
 
 type Job struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	ImageUri      string                 `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	JobId             string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TenantId          string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ImageUri          string                 `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Region            string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`                           // GCP region the Batch job runs in; empty until it is placed
+	TemplateId        string                 `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // Empty unless the job was submitted from a template
+	TemplateRevision  int64                  `protobuf:"varint,8,opt,name=template_revision,json=templateRevision,proto3" json:"template_revision,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string      `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UpdatedAt         string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartedAt         string                 `protobuf:"bytes,12,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`                                                      // Empty until GCP Batch starts running the job
+	CompletedAt       string                 `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`                                                // Empty until the job reaches a terminal status
+	ErrorMessage      string                 `protobuf:"bytes,14,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`                                             // Why the job failed
+	ProvisioningModel string                 `protobuf:"bytes,15,opt,name=provisioning_model,json=provisioningModel,proto3" json:"provisioning_model,omitempty"`                              // STANDARD, SPOT or PREEMPTIBLE; STANDARD after a fallback
+	PreemptionCount   int64                  `protobuf:"varint,16,opt,name=preemption_count,json=preemptionCount,proto3" json:"preemption_count,omitempty"`                                   // Times the job's VMs were preempted and it was resubmitted
+	MaxRunDuration    *durationpb.Duration   `protobuf:"bytes,17,opt,name=max_run_duration,json=maxRunDuration,proto3" json:"max_run_duration,omitempty"`                                     // Unset if the job has no run limit
+	MaxQueueDuration  *durationpb.Duration   `protobuf:"bytes,18,opt,name=max_queue_duration,json=maxQueueDuration,proto3" json:"max_queue_duration,omitempty"`                               // Unset if the job has no queue limit
+	Outputs           map[string]string      `protobuf:"bytes,19,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Declared outputs, by name, as GCS URI patterns
+	// Digest image_uri resolved to at submission, e.g. "sha256:...". The job runs the image
+	// by this digest, so a tag moved later does not change what it runs. Empty for jobs
+	// submitted before digests were recorded.
+	ImageDigest   string `protobuf:"bytes,20,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Job) Reset() {
 	*x = Job{}
-	mi := &file_proto_jennah_proto_msgTypes[4]
+	mi := &file_proto_jennah_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -238,7 +488,7 @@ func (x *Job) String() string {
 func (*Job) ProtoMessage() {}
 
 func (x *Job) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[4]
+	mi := &file_proto_jennah_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +501,7 @@ func (x *Job) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Job.ProtoReflect.Descriptor instead.
 func (*Job) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{4}
+	return file_proto_jennah_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Job) GetJobId() string {
@@ -289,6 +539,111 @@ func (x *Job) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Job) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Job) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Job) GetTemplateRevision() int64 {
+	if x != nil {
+		return x.TemplateRevision
+	}
+	return 0
+}
+
+func (x *Job) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Job) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Job) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Job) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Job) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *Job) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *Job) GetProvisioningModel() string {
+	if x != nil {
+		return x.ProvisioningModel
+	}
+	return ""
+}
+
+func (x *Job) GetPreemptionCount() int64 {
+	if x != nil {
+		return x.PreemptionCount
+	}
+	return 0
+}
+
+func (x *Job) GetMaxRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRunDuration
+	}
+	return nil
+}
+
+func (x *Job) GetMaxQueueDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxQueueDuration
+	}
+	return nil
+}
+
+func (x *Job) GetOutputs() map[string]string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *Job) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
 type GetCurrentTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -297,7 +652,7 @@ type GetCurrentTenantRequest struct {
 
 func (x *GetCurrentTenantRequest) Reset() {
 	*x = GetCurrentTenantRequest{}
-	mi := &file_proto_jennah_proto_msgTypes[5]
+	mi := &file_proto_jennah_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +664,7 @@ func (x *GetCurrentTenantRequest) String() string {
 func (*GetCurrentTenantRequest) ProtoMessage() {}
 
 func (x *GetCurrentTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[5]
+	mi := &file_proto_jennah_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,22 +677,24 @@ func (x *GetCurrentTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCurrentTenantRequest.ProtoReflect.Descriptor instead.
 func (*GetCurrentTenantRequest) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{5}
+	return file_proto_jennah_proto_rawDescGZIP(), []int{6}
 }
 
 type GetCurrentTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	OauthProvider string                 `protobuf:"bytes,3,opt,name=oauth_provider,json=oauthProvider,proto3" json:"oauth_provider,omitempty"` // "google", "github"
+	OauthProvider string                 `protobuf:"bytes,3,opt,name=oauth_provider,json=oauthProvider,proto3" json:"oauth_provider,omitempty"` // Provider the caller signed in with: "google", "github"
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`             // Caller's role in this tenant: "owner", "admin", "submitter", "viewer"
+	Identities    []*TenantIdentity      `protobuf:"bytes,6,rep,name=identities,proto3" json:"identities,omitempty"` // All OAuth identities linked to this tenant
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCurrentTenantResponse) Reset() {
 	*x = GetCurrentTenantResponse{}
-	mi := &file_proto_jennah_proto_msgTypes[6]
+	mi := &file_proto_jennah_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +706,7 @@ func (x *GetCurrentTenantResponse) String() string {
 func (*GetCurrentTenantResponse) ProtoMessage() {}
 
 func (x *GetCurrentTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_jennah_proto_msgTypes[6]
+	mi := &file_proto_jennah_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +719,7 @@ func (x *GetCurrentTenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCurrentTenantResponse.ProtoReflect.Descriptor instead.
 func (*GetCurrentTenantResponse) Descriptor() ([]byte, []int) {
-	return file_proto_jennah_proto_rawDescGZIP(), []int{6}
+	return file_proto_jennah_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetCurrentTenantResponse) GetTenantId() string {
@@ -393,43 +750,5800 @@ func (x *GetCurrentTenantResponse) GetCreatedAt() string {
 	return ""
 }
 
-var File_proto_jennah_proto protoreflect.FileDescriptor
+func (x *GetCurrentTenantResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
 
-const file_proto_jennah_proto_rawDesc = "" +
-	"\n" +
-	"\x12proto/jennah.proto\x12\tjennah.v1\"\xb0\x01\n" +
-	"\x10SubmitJobRequest\x12\x1b\n" +
-	"\timage_uri\x18\x02 \x01(\tR\bimageUri\x12C\n" +
-	"\benv_vars\x18\x03 \x03(\v2(.jennah.v1.SubmitJobRequest.EnvVarsEntryR\aenvVars\x1a:\n" +
-	"\fEnvVarsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"k\n" +
-	"\x11SubmitJobResponse\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
-	"\x0fworker_assigned\x18\x03 \x01(\tR\x0eworkerAssigned\"\x11\n" +
-	"\x0fListJobsRequest\"6\n" +
-	"\x10ListJobsResponse\x12\"\n" +
-	"\x04jobs\x18\x01 \x03(\v2\x0e.jennah.v1.JobR\x04jobs\"\x8d\x01\n" +
+func (x *GetCurrentTenantResponse) GetIdentities() []*TenantIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// An OAuth identity that signs in to a tenant.
+type TenantIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	LinkedAt      string                 `protobuf:"bytes,4,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantIdentity) Reset() {
+	*x = TenantIdentity{}
+	mi := &file_proto_jennah_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantIdentity) ProtoMessage() {}
+
+func (x *TenantIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantIdentity.ProtoReflect.Descriptor instead.
+func (*TenantIdentity) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TenantIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TenantIdentity) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TenantIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantIdentity) GetLinkedAt() string {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return ""
+}
+
+// Proof of the identity to link is an OIDC ID token issued to it by one of the
+// gateway's configured issuers. The caller's own credentials prove the other identity.
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LinkIdentityRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type LinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*TenantIdentity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityResponse) ProtoMessage() {}
+
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LinkIdentityResponse) GetIdentities() []*TenantIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// The identity to unlink is proven by its ID token, and must differ from the one
+// the caller is signed in with.
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnlinkIdentityRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*TenantIdentity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnlinkIdentityResponse) GetIdentities() []*TenantIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CancelJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CancelJobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// A tenant as seen by platform admins.
+type Tenant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,5,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Suspended     bool                   `protobuf:"varint,6,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	SuspendedAt   string                 `protobuf:"bytes,7,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	SuspendReason string                 `protobuf:"bytes,8,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Identities    []*TenantIdentity      `protobuf:"bytes,11,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_proto_jennah_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Tenant) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Tenant) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *Tenant) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Tenant) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *Tenant) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *Tenant) GetSuspendedAt() string {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return ""
+}
+
+func (x *Tenant) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *Tenant) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Tenant) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Tenant) GetIdentities() []*TenantIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type ListTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{16}
+}
+
+type ListTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsResponse) Reset() {
+	*x = ListTenantsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse) ProtoMessage() {}
+
+func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantResponse) Reset() {
+	*x = GetTenantResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantResponse) ProtoMessage() {}
+
+func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type SuspendTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendTenantRequest) Reset() {
+	*x = SuspendTenantRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendTenantRequest) ProtoMessage() {}
+
+func (x *SuspendTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendTenantRequest.ProtoReflect.Descriptor instead.
+func (*SuspendTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuspendTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SuspendTenantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendTenantResponse) Reset() {
+	*x = SuspendTenantResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendTenantResponse) ProtoMessage() {}
+
+func (x *SuspendTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendTenantResponse.ProtoReflect.Descriptor instead.
+func (*SuspendTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SuspendTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type ResumeTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTenantRequest) Reset() {
+	*x = ResumeTenantRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTenantRequest) ProtoMessage() {}
+
+func (x *ResumeTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTenantRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResumeTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ResumeTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTenantResponse) Reset() {
+	*x = ResumeTenantResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTenantResponse) ProtoMessage() {}
+
+func (x *ResumeTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTenantResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResumeTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// Fields left unset are not changed. Set a field to "" to clear it.
+type UpdateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DisplayName   *string                `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	ContactEmail  *string                `protobuf:"bytes,3,opt,name=contact_email,json=contactEmail,proto3,oneof" json:"contact_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetContactEmail() string {
+	if x != nil && x.ContactEmail != nil {
+		return *x.ContactEmail
+	}
+	return ""
+}
+
+type UpdateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantResponse) Reset() {
+	*x = UpdateTenantResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantResponse) ProtoMessage() {}
+
+func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type DeleteTenantResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CancelledJobIds []string               `protobuf:"bytes,1,rep,name=cancelled_job_ids,json=cancelledJobIds,proto3" json:"cancelled_job_ids,omitempty"` // Live jobs stopped before the tenant was removed
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTenantResponse) GetCancelledJobIds() []string {
+	if x != nil {
+		return x.CancelledJobIds
+	}
+	return nil
+}
+
+// Limits applied to a tenant. A value of 0 means unlimited.
+type TenantQuota struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	MaxConcurrentJobs       int64                  `protobuf:"varint,1,opt,name=max_concurrent_jobs,json=maxConcurrentJobs,proto3" json:"max_concurrent_jobs,omitempty"`
+	MaxSubmissionsPerMinute int64                  `protobuf:"varint,2,opt,name=max_submissions_per_minute,json=maxSubmissionsPerMinute,proto3" json:"max_submissions_per_minute,omitempty"`
+	MaxCpuMilli             int64                  `protobuf:"varint,3,opt,name=max_cpu_milli,json=maxCpuMilli,proto3" json:"max_cpu_milli,omitempty"`    // Total vCPU (in milli-cores) across all active jobs
+	MaxMemoryMib            int64                  `protobuf:"varint,4,opt,name=max_memory_mib,json=maxMemoryMib,proto3" json:"max_memory_mib,omitempty"` // Total memory across all active jobs
+	MaxTaskCount            int64                  `protobuf:"varint,5,opt,name=max_task_count,json=maxTaskCount,proto3" json:"max_task_count,omitempty"` // Maximum tasks in a single job
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *TenantQuota) Reset() {
+	*x = TenantQuota{}
+	mi := &file_proto_jennah_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantQuota) ProtoMessage() {}
+
+func (x *TenantQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantQuota.ProtoReflect.Descriptor instead.
+func (*TenantQuota) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TenantQuota) GetMaxConcurrentJobs() int64 {
+	if x != nil {
+		return x.MaxConcurrentJobs
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxSubmissionsPerMinute() int64 {
+	if x != nil {
+		return x.MaxSubmissionsPerMinute
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxCpuMilli() int64 {
+	if x != nil {
+		return x.MaxCpuMilli
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxMemoryMib() int64 {
+	if x != nil {
+		return x.MaxMemoryMib
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxTaskCount() int64 {
+	if x != nil {
+		return x.MaxTaskCount
+	}
+	return 0
+}
+
+// Resources currently held by a tenant's active (non-terminal) jobs.
+type TenantUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveJobs    int64                  `protobuf:"varint,1,opt,name=active_jobs,json=activeJobs,proto3" json:"active_jobs,omitempty"`
+	CpuMilli      int64                  `protobuf:"varint,2,opt,name=cpu_milli,json=cpuMilli,proto3" json:"cpu_milli,omitempty"`
+	MemoryMib     int64                  `protobuf:"varint,3,opt,name=memory_mib,json=memoryMib,proto3" json:"memory_mib,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	mi := &file_proto_jennah_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TenantUsage) GetActiveJobs() int64 {
+	if x != nil {
+		return x.ActiveJobs
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetCpuMilli() int64 {
+	if x != nil {
+		return x.CpuMilli
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetMemoryMib() int64 {
+	if x != nil {
+		return x.MemoryMib
+	}
+	return 0
+}
+
+type GetTenantQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantQuotaRequest) Reset() {
+	*x = GetTenantQuotaRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantQuotaRequest) ProtoMessage() {}
+
+func (x *GetTenantQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTenantQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetTenantQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *TenantQuota           `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Usage         *TenantUsage           `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // True when the tenant has no explicit quota and gateway defaults apply
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantQuotaResponse) Reset() {
+	*x = GetTenantQuotaResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantQuotaResponse) ProtoMessage() {}
+
+func (x *GetTenantQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTenantQuotaResponse) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *GetTenantQuotaResponse) GetUsage() *TenantUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetTenantQuotaResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type UpdateTenantQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Quota         *TenantQuota           `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantQuotaRequest) Reset() {
+	*x = UpdateTenantQuotaRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantQuotaRequest) ProtoMessage() {}
+
+func (x *UpdateTenantQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantQuotaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateTenantQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantQuotaRequest) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type UpdateTenantQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *TenantQuota           `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantQuotaResponse) Reset() {
+	*x = UpdateTenantQuotaResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantQuotaResponse) ProtoMessage() {}
+
+func (x *UpdateTenantQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantQuotaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateTenantQuotaResponse) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// Rules jobs must follow to be submitted. The gateway's global policy applies to every
+// tenant and a tenant's own policy adds to it; a job breaking either is rejected with
+// PERMISSION_DENIED naming the rule. Empty lists, false and unset turn a rule off.
+type JobPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Registries, optionally followed by a path, that images must come from, e.g.
+	// "asia.gcr.io/labs-169405". Images without a registry are on docker.io.
+	AllowedRegistries []string `protobuf:"bytes,1,rep,name=allowed_registries,json=allowedRegistries,proto3" json:"allowed_registries,omitempty"`
+	RequireDigest     bool     `protobuf:"varint,2,opt,name=require_digest,json=requireDigest,proto3" json:"require_digest,omitempty"` // Images must be pinned by digest, e.g. "image@sha256:..."
+	MaxCpuMilli       int64    `protobuf:"varint,3,opt,name=max_cpu_milli,json=maxCpuMilli,proto3" json:"max_cpu_milli,omitempty"`     // Per task
+	MaxMemoryMib      int64    `protobuf:"varint,4,opt,name=max_memory_mib,json=maxMemoryMib,proto3" json:"max_memory_mib,omitempty"`  // Per task
+	MaxTaskCount      int64    `protobuf:"varint,5,opt,name=max_task_count,json=maxTaskCount,proto3" json:"max_task_count,omitempty"`
+	// Jobs must set a max_run_duration of at most this.
+	MaxRunDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=max_run_duration,json=maxRunDuration,proto3" json:"max_run_duration,omitempty"`
+	// Privileged options jobs may not use: "outputs" (declaring outputs) and
+	// "credential_env_vars" (GOOGLE_*, GCE_METADATA_* and CLOUDSDK_* env vars).
+	ForbiddenOptions []string `protobuf:"bytes,7,rep,name=forbidden_options,json=forbiddenOptions,proto3" json:"forbidden_options,omitempty"`
+	RequiredLabels   []string `protobuf:"bytes,8,rep,name=required_labels,json=requiredLabels,proto3" json:"required_labels,omitempty"` // Label keys jobs must set to a non-empty value
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JobPolicy) Reset() {
+	*x = JobPolicy{}
+	mi := &file_proto_jennah_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobPolicy) ProtoMessage() {}
+
+func (x *JobPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobPolicy.ProtoReflect.Descriptor instead.
+func (*JobPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JobPolicy) GetAllowedRegistries() []string {
+	if x != nil {
+		return x.AllowedRegistries
+	}
+	return nil
+}
+
+func (x *JobPolicy) GetRequireDigest() bool {
+	if x != nil {
+		return x.RequireDigest
+	}
+	return false
+}
+
+func (x *JobPolicy) GetMaxCpuMilli() int64 {
+	if x != nil {
+		return x.MaxCpuMilli
+	}
+	return 0
+}
+
+func (x *JobPolicy) GetMaxMemoryMib() int64 {
+	if x != nil {
+		return x.MaxMemoryMib
+	}
+	return 0
+}
+
+func (x *JobPolicy) GetMaxTaskCount() int64 {
+	if x != nil {
+		return x.MaxTaskCount
+	}
+	return 0
+}
+
+func (x *JobPolicy) GetMaxRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRunDuration
+	}
+	return nil
+}
+
+func (x *JobPolicy) GetForbiddenOptions() []string {
+	if x != nil {
+		return x.ForbiddenOptions
+	}
+	return nil
+}
+
+func (x *JobPolicy) GetRequiredLabels() []string {
+	if x != nil {
+		return x.RequiredLabels
+	}
+	return nil
+}
+
+type GetTenantPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantPolicyRequest) Reset() {
+	*x = GetTenantPolicyRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantPolicyRequest) ProtoMessage() {}
+
+func (x *GetTenantPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTenantPolicyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetTenantPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *JobPolicy             `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`                                 // The tenant's own rules; empty if it has none
+	GlobalPolicy  *JobPolicy             `protobuf:"bytes,2,opt,name=global_policy,json=globalPolicy,proto3" json:"global_policy,omitempty"` // The gateway's rules for every tenant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantPolicyResponse) Reset() {
+	*x = GetTenantPolicyResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantPolicyResponse) ProtoMessage() {}
+
+func (x *GetTenantPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTenantPolicyResponse) GetPolicy() *JobPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *GetTenantPolicyResponse) GetGlobalPolicy() *JobPolicy {
+	if x != nil {
+		return x.GlobalPolicy
+	}
+	return nil
+}
+
+type UpdateTenantPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Policy        *JobPolicy             `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantPolicyRequest) Reset() {
+	*x = UpdateTenantPolicyRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateTenantPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateTenantPolicyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantPolicyRequest) GetPolicy() *JobPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdateTenantPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *JobPolicy             `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantPolicyResponse) Reset() {
+	*x = UpdateTenantPolicyResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateTenantPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateTenantPolicyResponse) GetPolicy() *JobPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// An API key for machine clients such as CI pipelines. The secret itself is never returned after creation.
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`                        // First characters of the key, to help identify it
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // Email of the user who created the key
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Empty if the key does not expire
+	LastUsedAt    string                 `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Empty if the key has never been used
+	RevokedAt     string                 `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`      // Empty if the key is active
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_proto_jennah_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ApiKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 0 = never expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // Send as "Authorization: Bearer <key>". Store it now; it cannot be retrieved again.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{42}
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RevokeApiKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RevokeApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// Requests act on the caller's personal tenant unless the "X-Jennah-Tenant" header
+// selects another tenant the caller is a member of.
+type TenantMembership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OwnerEmail    string                 `protobuf:"bytes,2,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"` // Email of the user whose personal tenant this is
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Personal      bool                   `protobuf:"varint,4,opt,name=personal,proto3" json:"personal,omitempty"` // True for the caller's own tenant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantMembership) Reset() {
+	*x = TenantMembership{}
+	mi := &file_proto_jennah_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantMembership) ProtoMessage() {}
+
+func (x *TenantMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantMembership.ProtoReflect.Descriptor instead.
+func (*TenantMembership) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TenantMembership) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantMembership) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *TenantMembership) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TenantMembership) GetPersonal() bool {
+	if x != nil {
+		return x.Personal
+	}
+	return false
+}
+
+type TenantMember struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MemberTenantId string                 `protobuf:"bytes,1,opt,name=member_tenant_id,json=memberTenantId,proto3" json:"member_tenant_id,omitempty"` // Personal tenant ID of the member
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AddedBy        string                 `protobuf:"bytes,4,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TenantMember) Reset() {
+	*x = TenantMember{}
+	mi := &file_proto_jennah_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantMember) ProtoMessage() {}
+
+func (x *TenantMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantMember.ProtoReflect.Descriptor instead.
+func (*TenantMember) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TenantMember) GetMemberTenantId() string {
+	if x != nil {
+		return x.MemberTenantId
+	}
+	return ""
+}
+
+func (x *TenantMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TenantMember) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *TenantMember) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type TenantInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantInvitation) Reset() {
+	*x = TenantInvitation{}
+	mi := &file_proto_jennah_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantInvitation) ProtoMessage() {}
+
+func (x *TenantInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantInvitation.ProtoReflect.Descriptor instead.
+func (*TenantInvitation) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TenantInvitation) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ListMyTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTenantsRequest) Reset() {
+	*x = ListMyTenantsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTenantsRequest) ProtoMessage() {}
+
+func (x *ListMyTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{49}
+}
+
+type ListMyTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*TenantMembership    `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTenantsResponse) Reset() {
+	*x = ListMyTenantsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTenantsResponse) ProtoMessage() {}
+
+func (x *ListMyTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMyTenantsResponse) GetTenants() []*TenantMembership {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type ListTenantMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersRequest) Reset() {
+	*x = ListTenantMembersRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersRequest) ProtoMessage() {}
+
+func (x *ListTenantMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{51}
+}
+
+type ListTenantMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*TenantMember        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersResponse) Reset() {
+	*x = ListTenantMembersResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersResponse) ProtoMessage() {}
+
+func (x *ListTenantMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListTenantMembersResponse) GetMembers() []*TenantMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type InviteTenantMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteTenantMemberRequest) Reset() {
+	*x = InviteTenantMemberRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteTenantMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteTenantMemberRequest) ProtoMessage() {}
+
+func (x *InviteTenantMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteTenantMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteTenantMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InviteTenantMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteTenantMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type InviteTenantMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *TenantInvitation      `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteTenantMemberResponse) Reset() {
+	*x = InviteTenantMemberResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteTenantMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteTenantMemberResponse) ProtoMessage() {}
+
+func (x *InviteTenantMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteTenantMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteTenantMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InviteTenantMemberResponse) GetInvitation() *TenantInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type ListMyInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyInvitationsRequest) Reset() {
+	*x = ListMyInvitationsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyInvitationsRequest) ProtoMessage() {}
+
+func (x *ListMyInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{55}
+}
+
+type ListMyInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*TenantInvitation    `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyInvitationsResponse) Reset() {
+	*x = ListMyInvitationsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyInvitationsResponse) ProtoMessage() {}
+
+func (x *ListMyInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListMyInvitationsResponse) GetInvitations() []*TenantInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	InvitationId  string                 `protobuf:"bytes,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AcceptInvitationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type AcceptInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Membership    *TenantMembership      `protobuf:"bytes,1,opt,name=membership,proto3" json:"membership,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AcceptInvitationResponse) GetMembership() *TenantMembership {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type UpdateTenantMemberRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MemberTenantId string                 `protobuf:"bytes,1,opt,name=member_tenant_id,json=memberTenantId,proto3" json:"member_tenant_id,omitempty"`
+	Role           string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTenantMemberRoleRequest) Reset() {
+	*x = UpdateTenantMemberRoleRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateTenantMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateTenantMemberRoleRequest) GetMemberTenantId() string {
+	if x != nil {
+		return x.MemberTenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateTenantMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *TenantMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantMemberRoleResponse) Reset() {
+	*x = UpdateTenantMemberRoleResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateTenantMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateTenantMemberRoleResponse) GetMember() *TenantMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveTenantMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MemberTenantId string                 `protobuf:"bytes,1,opt,name=member_tenant_id,json=memberTenantId,proto3" json:"member_tenant_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveTenantMemberRequest) Reset() {
+	*x = RemoveTenantMemberRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTenantMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTenantMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveTenantMemberRequest) GetMemberTenantId() string {
+	if x != nil {
+		return x.MemberTenantId
+	}
+	return ""
+}
+
+type RemoveTenantMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTenantMemberResponse) Reset() {
+	*x = RemoveTenantMemberResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantMemberResponse) ProtoMessage() {}
+
+func (x *RemoveTenantMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTenantMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{62}
+}
+
+// A parameter declared by a job template, referenced as ${name} in its image URI
+// and env var values.
+type TemplateParameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Letters, digits and underscores, not starting with a digit
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                     // "string", "int" or "bool"
+	DefaultValue  string                 `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"` // Used when the parameter is not given at submit time
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`                            // Must be given at submit time; required parameters have no default
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateParameter) Reset() {
+	*x = TemplateParameter{}
+	mi := &file_proto_jennah_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateParameter) ProtoMessage() {}
+
+func (x *TemplateParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateParameter.ProtoReflect.Descriptor instead.
+func (*TemplateParameter) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TemplateParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateParameter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TemplateParameter) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TemplateParameter) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TemplateParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// A named job definition. Its revisions are immutable; changing a template adds a revision.
+type JobTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId     string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LatestRevision int64                  `protobuf:"varint,4,opt,name=latest_revision,json=latestRevision,proto3" json:"latest_revision,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobTemplate) Reset() {
+	*x = JobTemplate{}
+	mi := &file_proto_jennah_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTemplate) ProtoMessage() {}
+
+func (x *JobTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTemplate.ProtoReflect.Descriptor instead.
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *JobTemplate) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetLatestRevision() int64 {
+	if x != nil {
+		return x.LatestRevision
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type JobTemplateRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	ImageUri      string                 `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	EnvVars       map[string]string      `protobuf:"bytes,3,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Resources     *ResourceRequirements  `protobuf:"bytes,4,opt,name=resources,proto3" json:"resources,omitempty"`
+	TaskCount     int64                  `protobuf:"varint,5,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	Parameters    []*TemplateParameter   `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // Email of the user, or "api-key:<id>", that created the revision
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobTemplateRevision) Reset() {
+	*x = JobTemplateRevision{}
+	mi := &file_proto_jennah_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTemplateRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTemplateRevision) ProtoMessage() {}
+
+func (x *JobTemplateRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTemplateRevision.ProtoReflect.Descriptor instead.
+func (*JobTemplateRevision) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *JobTemplateRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *JobTemplateRevision) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *JobTemplateRevision) GetEnvVars() map[string]string {
+	if x != nil {
+		return x.EnvVars
+	}
+	return nil
+}
+
+func (x *JobTemplateRevision) GetResources() *ResourceRequirements {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *JobTemplateRevision) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *JobTemplateRevision) GetParameters() []*TemplateParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *JobTemplateRevision) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *JobTemplateRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                     // Lowercase letters, digits and hyphens, at most 63 characters
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"` // Unset keeps the description of an existing template
+	ImageUri      string                 `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	EnvVars       map[string]string      `protobuf:"bytes,4,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Resources     *ResourceRequirements  `protobuf:"bytes,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	TaskCount     int64                  `protobuf:"varint,6,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	Parameters    []*TemplateParameter   `protobuf:"bytes,7,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJobTemplateRequest) Reset() {
+	*x = CreateJobTemplateRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobTemplateRequest) ProtoMessage() {}
+
+func (x *CreateJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateJobTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateJobTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateJobTemplateRequest) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *CreateJobTemplateRequest) GetEnvVars() map[string]string {
+	if x != nil {
+		return x.EnvVars
+	}
+	return nil
+}
+
+func (x *CreateJobTemplateRequest) GetResources() *ResourceRequirements {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *CreateJobTemplateRequest) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *CreateJobTemplateRequest) GetParameters() []*TemplateParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type CreateJobTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *JobTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Revision      *JobTemplateRevision   `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJobTemplateResponse) Reset() {
+	*x = CreateJobTemplateResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobTemplateResponse) ProtoMessage() {}
+
+func (x *CreateJobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateJobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateJobTemplateResponse) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateJobTemplateResponse) GetRevision() *JobTemplateRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type GetJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // 0 = latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobTemplateRequest) Reset() {
+	*x = GetJobTemplateRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobTemplateRequest) ProtoMessage() {}
+
+func (x *GetJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetJobTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetJobTemplateRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetJobTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *JobTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Revision      *JobTemplateRevision   `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobTemplateResponse) Reset() {
+	*x = GetJobTemplateResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobTemplateResponse) ProtoMessage() {}
+
+func (x *GetJobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetJobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetJobTemplateResponse) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *GetJobTemplateResponse) GetRevision() *JobTemplateRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type ListJobTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobTemplatesRequest) Reset() {
+	*x = ListJobTemplatesRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesRequest) ProtoMessage() {}
+
+func (x *ListJobTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{70}
+}
+
+type ListJobTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*JobTemplate         `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobTemplatesResponse) Reset() {
+	*x = ListJobTemplatesResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesResponse) ProtoMessage() {}
+
+func (x *ListJobTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListJobTemplatesResponse) GetTemplates() []*JobTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobTemplateRequest) Reset() {
+	*x = DeleteJobTemplateRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteJobTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteJobTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *JobTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobTemplateResponse) Reset() {
+	*x = DeleteJobTemplateResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteJobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteJobTemplateResponse) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SubmitJobFromTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Revision         int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`                                                                              // 0 = latest
+	Parameters       map[string]string      `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Values for the template's parameters, overriding their defaults
+	AllowedRegions   []string               `protobuf:"bytes,4,rep,name=allowed_regions,json=allowedRegions,proto3" json:"allowed_regions,omitempty"`
+	PreferredRegions []string               `protobuf:"bytes,5,rep,name=preferred_regions,json=preferredRegions,proto3" json:"preferred_regions,omitempty"`
+	Labels           map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations      map[string]string      `protobuf:"bytes,7,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// As in SubmitJobRequest; templates do not set these, so they apply to this job only.
+	DryRun            bool                 `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	ProvisioningModel string               `protobuf:"bytes,9,opt,name=provisioning_model,json=provisioningModel,proto3" json:"provisioning_model,omitempty"`
+	MaxRunDuration    *durationpb.Duration `protobuf:"bytes,10,opt,name=max_run_duration,json=maxRunDuration,proto3" json:"max_run_duration,omitempty"`
+	MaxQueueDuration  *durationpb.Duration `protobuf:"bytes,11,opt,name=max_queue_duration,json=maxQueueDuration,proto3" json:"max_queue_duration,omitempty"`
+	Outputs           map[string]string    `protobuf:"bytes,12,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubmitJobFromTemplateRequest) Reset() {
+	*x = SubmitJobFromTemplateRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobFromTemplateRequest) ProtoMessage() {}
+
+func (x *SubmitJobFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SubmitJobFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SubmitJobFromTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SubmitJobFromTemplateRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetAllowedRegions() []string {
+	if x != nil {
+		return x.AllowedRegions
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetPreferredRegions() []string {
+	if x != nil {
+		return x.PreferredRegions
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SubmitJobFromTemplateRequest) GetProvisioningModel() string {
+	if x != nil {
+		return x.ProvisioningModel
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetMaxRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRunDuration
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetMaxQueueDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxQueueDuration
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetOutputs() map[string]string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type SubmitJobFromTemplateResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	JobId            string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status           string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	WorkerAssigned   string                 `protobuf:"bytes,3,opt,name=worker_assigned,json=workerAssigned,proto3" json:"worker_assigned,omitempty"`
+	Region           string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	TemplateId       string                 `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateRevision int64                  `protobuf:"varint,6,opt,name=template_revision,json=templateRevision,proto3" json:"template_revision,omitempty"`
+	// As in SubmitJobResponse
+	BatchJob      *structpb.Struct `protobuf:"bytes,7,opt,name=batch_job,json=batchJob,proto3" json:"batch_job,omitempty"`
+	ImageDigest   string           `protobuf:"bytes,8,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobFromTemplateResponse) Reset() {
+	*x = SubmitJobFromTemplateResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobFromTemplateResponse) ProtoMessage() {}
+
+func (x *SubmitJobFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SubmitJobFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SubmitJobFromTemplateResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateResponse) GetWorkerAssigned() string {
+	if x != nil {
+		return x.WorkerAssigned
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateResponse) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateResponse) GetTemplateRevision() int64 {
+	if x != nil {
+		return x.TemplateRevision
+	}
+	return 0
+}
+
+func (x *SubmitJobFromTemplateResponse) GetBatchJob() *structpb.Struct {
+	if x != nil {
+		return x.BatchJob
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateResponse) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetJobLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Since         string                 `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`                          // RFC 3339; only entries after this time. Empty reads from the start.
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 200, max 1000
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // From a previous response, to read the next page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobLogsRequest) Reset() {
+	*x = GetJobLogsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobLogsRequest) ProtoMessage() {}
+
+func (x *GetJobLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetJobLogsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetJobLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *GetJobLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetJobLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	TaskId        string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // Batch task that wrote the line, e.g. "task/jennah-1a2b3c4d-group0-0/0/0"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_proto_jennah_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LogEntry) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LogEntry) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogEntry) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type GetJobLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LogEntry            `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobLogsResponse) Reset() {
+	*x = GetJobLogsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobLogsResponse) ProtoMessage() {}
+
+func (x *GetJobLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetJobLogsResponse) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetJobLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListJobArtifactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Output        string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"` // Only the artifacts of this output; empty lists all of them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobArtifactsRequest) Reset() {
+	*x = ListJobArtifactsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobArtifactsRequest) ProtoMessage() {}
+
+func (x *ListJobArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListJobArtifactsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListJobArtifactsRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type JobArtifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"` // Name of the declared output the object matched
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`       // gs://bucket/object
+	SizeBytes     int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Crc32C        string                 `protobuf:"bytes,4,opt,name=crc32c,proto3" json:"crc32c,omitempty"`                           // Base64 big-endian CRC32C, as Cloud Storage reports it
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // When the object was last written
+	RecordedAt    string                 `protobuf:"bytes,6,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"` // When the worker recorded it, after the job completed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobArtifact) Reset() {
+	*x = JobArtifact{}
+	mi := &file_proto_jennah_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobArtifact) ProtoMessage() {}
+
+func (x *JobArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobArtifact.ProtoReflect.Descriptor instead.
+func (*JobArtifact) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *JobArtifact) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *JobArtifact) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *JobArtifact) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *JobArtifact) GetCrc32C() string {
+	if x != nil {
+		return x.Crc32C
+	}
+	return ""
+}
+
+func (x *JobArtifact) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *JobArtifact) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+type ListJobArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifacts     []*JobArtifact         `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"` // By output, then URI
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobArtifactsResponse) Reset() {
+	*x = ListJobArtifactsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobArtifactsResponse) ProtoMessage() {}
+
+func (x *ListJobArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListJobArtifactsResponse) GetArtifacts() []*JobArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type ValidateJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *SubmitJobRequest      `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateJobRequest) Reset() {
+	*x = ValidateJobRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateJobRequest) ProtoMessage() {}
+
+func (x *ValidateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateJobRequest.ProtoReflect.Descriptor instead.
+func (*ValidateJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ValidateJobRequest) GetJob() *SubmitJobRequest {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ValidateJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"` // Region the job would be placed in if it were submitted now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateJobResponse) Reset() {
+	*x = ValidateJobResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateJobResponse) ProtoMessage() {}
+
+func (x *ValidateJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateJobResponse.ProtoReflect.Descriptor instead.
+func (*ValidateJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ValidateJobResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// A webhook that receives the current tenant's job status changes. The secret signs
+// every request and is never returned after creation.
+type NotificationSubscription struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Url            string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Statuses       []string               `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"` // Job statuses whose transitions are sent, e.g. "FAILED"
+	Format         string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`     // "json" or "slack"
+	CreatedBy      string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotificationSubscription) Reset() {
+	*x = NotificationSubscription{}
+	mi := &file_proto_jennah_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSubscription) ProtoMessage() {}
+
+func (x *NotificationSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSubscription.ProtoReflect.Descriptor instead.
+func (*NotificationSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NotificationSubscription) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *NotificationSubscription) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateNotificationSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`           // http or https
+	Statuses      []string               `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"` // SCHEDULED, RUNNING, COMPLETED, FAILED or CANCELLED
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`     // Default "json"
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`     // Empty generates one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationSubscriptionRequest) Reset() {
+	*x = CreateNotificationSubscriptionRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateNotificationSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotificationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateNotificationSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateNotificationSubscriptionRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *CreateNotificationSubscriptionRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateNotificationSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateNotificationSubscriptionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *NotificationSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Secret        string                    `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // Key of the X-Jennah-Signature HMAC. Store it now; it cannot be retrieved again.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationSubscriptionResponse) Reset() {
+	*x = CreateNotificationSubscriptionResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateNotificationSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotificationSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateNotificationSubscriptionResponse) GetSubscription() *NotificationSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateNotificationSubscriptionResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListNotificationSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationSubscriptionsRequest) Reset() {
+	*x = ListNotificationSubscriptionsRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListNotificationSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{89}
+}
+
+type ListNotificationSubscriptionsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Subscriptions []*NotificationSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationSubscriptionsResponse) Reset() {
+	*x = ListNotificationSubscriptionsResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListNotificationSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListNotificationSubscriptionsResponse) GetSubscriptions() []*NotificationSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeleteNotificationSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationSubscriptionRequest) Reset() {
+	*x = DeleteNotificationSubscriptionRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteNotificationSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type DeleteNotificationSubscriptionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *NotificationSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationSubscriptionResponse) Reset() {
+	*x = DeleteNotificationSubscriptionResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteNotificationSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteNotificationSubscriptionResponse) GetSubscription() *NotificationSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// One job status change sent, or to be sent, to a subscription.
+type NotificationDelivery struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId       string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	SubscriptionId   string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	JobId            string                 `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	FromStatus       string                 `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus         string                 `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason           string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // PENDING, DELIVERED or DEAD_LETTER
+	Attempts         int64                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastResponseCode int32                  `protobuf:"varint,9,opt,name=last_response_code,json=lastResponseCode,proto3" json:"last_response_code,omitempty"` // HTTP status of the last attempt; 0 if there was no response
+	LastError        string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the job changed status
+	LastAttemptAt    string                 `protobuf:"bytes,12,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	DeliveredAt      string                 `protobuf:"bytes,13,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	NextAttemptAt    string                 `protobuf:"bytes,14,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"` // Empty unless PENDING
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NotificationDelivery) Reset() {
+	*x = NotificationDelivery{}
+	mi := &file_proto_jennah_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDelivery) ProtoMessage() {}
+
+func (x *NotificationDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDelivery.ProtoReflect.Descriptor instead.
+func (*NotificationDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *NotificationDelivery) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationDelivery) GetLastResponseCode() int32 {
+	if x != nil {
+		return x.LastResponseCode
+	}
+	return 0
+}
+
+func (x *NotificationDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetLastAttemptAt() string {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+type ListNotificationDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"` // Empty lists every subscription's deliveries
+	JobId          string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // PENDING, DELIVERED or DEAD_LETTER
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`  // Default 100, max 1000
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListNotificationDeliveriesRequest) Reset() {
+	*x = ListNotificationDeliveriesRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListNotificationDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListNotificationDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNotificationDeliveriesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Deliveries    []*NotificationDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationDeliveriesResponse) Reset() {
+	*x = ListNotificationDeliveriesResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListNotificationDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListNotificationDeliveriesResponse) GetDeliveries() []*NotificationDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`             // RFC 3339; jobs that finished at or after it. Required
+	EndTime       string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                   // RFC 3339; jobs that finished before it. Default now
+	LabelSelector string                 `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"` // Only jobs whose labels match, e.g. "team=billing"
+	GroupByLabel  string                 `protobuf:"bytes,4,opt,name=group_by_label,json=groupByLabel,proto3" json:"group_by_label,omitempty"`  // A row per value of this label key; jobs without it are in a row with an empty value
+	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                // AdminService only: one tenant. Empty sums every tenant, a row per tenant
+	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`                                    // "csv" also returns the rows as CSV
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_proto_jennah_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetUsageRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetGroupByLabel() string {
+	if x != nil {
+		return x.GroupByLabel
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Resources used by the jobs of a tenant, or of one value of the grouped label.
+type UsageRow struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	TenantId                  string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	LabelValue                string                 `protobuf:"bytes,2,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty"` // The group_by_label value
+	Jobs                      int64                  `protobuf:"varint,3,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	RunSeconds                float64                `protobuf:"fixed64,4,opt,name=run_seconds,json=runSeconds,proto3" json:"run_seconds,omitempty"`                                 // Summed over jobs, from StartedAt to CompletedAt
+	RequestedVcpuSeconds      float64                `protobuf:"fixed64,5,opt,name=requested_vcpu_seconds,json=requestedVcpuSeconds,proto3" json:"requested_vcpu_seconds,omitempty"` // Requested vCPU per task × tasks × run time
+	RequestedMemoryGibSeconds float64                `protobuf:"fixed64,6,opt,name=requested_memory_gib_seconds,json=requestedMemoryGibSeconds,proto3" json:"requested_memory_gib_seconds,omitempty"`
+	VcpuSeconds               float64                `protobuf:"fixed64,7,opt,name=vcpu_seconds,json=vcpuSeconds,proto3" json:"vcpu_seconds,omitempty"` // vCPUs of the VMs the jobs ran on × run time; requested if unknown
+	MemoryGibSeconds          float64                `protobuf:"fixed64,8,opt,name=memory_gib_seconds,json=memoryGibSeconds,proto3" json:"memory_gib_seconds,omitempty"`
+	EstimatedCost             float64                `protobuf:"fixed64,9,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"` // From the gateway's price table
+	UnpricedJobs              int64                  `protobuf:"varint,10,opt,name=unpriced_jobs,json=unpricedJobs,proto3" json:"unpriced_jobs,omitempty"`    // Jobs the price table has no price for, not in estimated_cost
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *UsageRow) Reset() {
+	*x = UsageRow{}
+	mi := &file_proto_jennah_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRow) ProtoMessage() {}
+
+func (x *UsageRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRow.ProtoReflect.Descriptor instead.
+func (*UsageRow) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UsageRow) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UsageRow) GetLabelValue() string {
+	if x != nil {
+		return x.LabelValue
+	}
+	return ""
+}
+
+func (x *UsageRow) GetJobs() int64 {
+	if x != nil {
+		return x.Jobs
+	}
+	return 0
+}
+
+func (x *UsageRow) GetRunSeconds() float64 {
+	if x != nil {
+		return x.RunSeconds
+	}
+	return 0
+}
+
+func (x *UsageRow) GetRequestedVcpuSeconds() float64 {
+	if x != nil {
+		return x.RequestedVcpuSeconds
+	}
+	return 0
+}
+
+func (x *UsageRow) GetRequestedMemoryGibSeconds() float64 {
+	if x != nil {
+		return x.RequestedMemoryGibSeconds
+	}
+	return 0
+}
+
+func (x *UsageRow) GetVcpuSeconds() float64 {
+	if x != nil {
+		return x.VcpuSeconds
+	}
+	return 0
+}
+
+func (x *UsageRow) GetMemoryGibSeconds() float64 {
+	if x != nil {
+		return x.MemoryGibSeconds
+	}
+	return 0
+}
+
+func (x *UsageRow) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+func (x *UsageRow) GetUnpricedJobs() int64 {
+	if x != nil {
+		return x.UnpricedJobs
+	}
+	return 0
+}
+
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*UsageRow            `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Total         *UsageRow              `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Empty if the gateway has no price table
+	Csv           string                 `protobuf:"bytes,4,opt,name=csv,proto3" json:"csv,omitempty"`           // The rows with a header line, when format is "csv"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_proto_jennah_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_jennah_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_jennah_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetUsageResponse) GetRows() []*UsageRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetTotal() *UsageRow {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+var File_proto_jennah_proto protoreflect.FileDescriptor
+
+const file_proto_jennah_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/jennah.proto\x12\tjennah.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xc2\b\n" +
+	"\x10SubmitJobRequest\x12\x1b\n" +
+	"\timage_uri\x18\x02 \x01(\tR\bimageUri\x12C\n" +
+	"\benv_vars\x18\x03 \x03(\v2(.jennah.v1.SubmitJobRequest.EnvVarsEntryR\aenvVars\x12=\n" +
+	"\tresources\x18\x04 \x01(\v2\x1f.jennah.v1.ResourceRequirementsR\tresources\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x05 \x01(\x03R\ttaskCount\x12'\n" +
+	"\x0fallowed_regions\x18\x06 \x03(\tR\x0eallowedRegions\x12+\n" +
+	"\x11preferred_regions\x18\a \x03(\tR\x10preferredRegions\x12\x1f\n" +
+	"\vtemplate_id\x18\b \x01(\tR\n" +
+	"templateId\x12+\n" +
+	"\x11template_revision\x18\t \x01(\x03R\x10templateRevision\x12?\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2'.jennah.v1.SubmitJobRequest.LabelsEntryR\x06labels\x12N\n" +
+	"\vannotations\x18\v \x03(\v2,.jennah.v1.SubmitJobRequest.AnnotationsEntryR\vannotations\x12\x17\n" +
+	"\adry_run\x18\f \x01(\bR\x06dryRun\x12-\n" +
+	"\x12provisioning_model\x18\r \x01(\tR\x11provisioningModel\x12C\n" +
+	"\x10max_run_duration\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x0emaxRunDuration\x12G\n" +
+	"\x12max_queue_duration\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\x10maxQueueDuration\x12B\n" +
+	"\aoutputs\x18\x10 \x03(\v2(.jennah.v1.SubmitJobRequest.OutputsEntryR\aoutputs\x12,\n" +
+	"\x05quota\x18\x11 \x01(\v2\x16.jennah.v1.TenantQuotaR\x05quota\x1a:\n" +
+	"\fEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fOutputsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
+	"\x14ResourceRequirements\x12\x1b\n" +
+	"\tcpu_milli\x18\x01 \x01(\x03R\bcpuMilli\x12\x1d\n" +
+	"\n" +
+	"memory_mib\x18\x02 \x01(\x03R\tmemoryMib\"\xdc\x01\n" +
+	"\x11SubmitJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fworker_assigned\x18\x03 \x01(\tR\x0eworkerAssigned\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x124\n" +
+	"\tbatch_job\x18\x05 \x01(\v2\x17.google.protobuf.StructR\bbatchJob\x12!\n" +
+	"\fimage_digest\x18\x06 \x01(\tR\vimageDigest\"8\n" +
+	"\x0fListJobsRequest\x12%\n" +
+	"\x0elabel_selector\x18\x01 \x01(\tR\rlabelSelector\"6\n" +
+	"\x10ListJobsResponse\x12\"\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0e.jennah.v1.JobR\x04jobs\"\xe9\a\n" +
 	"\x03Job\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1b\n" +
 	"\timage_uri\x18\x03 \x01(\tR\bimageUri\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x19\n" +
-	"\x17GetCurrentTenantRequest\"\x9c\x01\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12\x1f\n" +
+	"\vtemplate_id\x18\a \x01(\tR\n" +
+	"templateId\x12+\n" +
+	"\x11template_revision\x18\b \x01(\x03R\x10templateRevision\x122\n" +
+	"\x06labels\x18\t \x03(\v2\x1a.jennah.v1.Job.LabelsEntryR\x06labels\x12A\n" +
+	"\vannotations\x18\n" +
+	" \x03(\v2\x1f.jennah.v1.Job.AnnotationsEntryR\vannotations\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\f \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\r \x01(\tR\vcompletedAt\x12#\n" +
+	"\rerror_message\x18\x0e \x01(\tR\ferrorMessage\x12-\n" +
+	"\x12provisioning_model\x18\x0f \x01(\tR\x11provisioningModel\x12)\n" +
+	"\x10preemption_count\x18\x10 \x01(\x03R\x0fpreemptionCount\x12C\n" +
+	"\x10max_run_duration\x18\x11 \x01(\v2\x19.google.protobuf.DurationR\x0emaxRunDuration\x12G\n" +
+	"\x12max_queue_duration\x18\x12 \x01(\v2\x19.google.protobuf.DurationR\x10maxQueueDuration\x125\n" +
+	"\aoutputs\x18\x13 \x03(\v2\x1b.jennah.v1.Job.OutputsEntryR\aoutputs\x12!\n" +
+	"\fimage_digest\x18\x14 \x01(\tR\vimageDigest\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fOutputsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x19\n" +
+	"\x17GetCurrentTenantRequest\"\xeb\x01\n" +
 	"\x18GetCurrentTenantResponse\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
 	"\n" +
 	"user_email\x18\x02 \x01(\tR\tuserEmail\x12%\n" +
 	"\x0eoauth_provider\x18\x03 \x01(\tR\roauthProvider\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt2\xfd\x01\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"identities\x18\x06 \x03(\v2\x19.jennah.v1.TenantIdentityR\n" +
+	"identities\"x\n" +
+	"\x0eTenantIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1b\n" +
+	"\tlinked_at\x18\x04 \x01(\tR\blinkedAt\"0\n" +
+	"\x13LinkIdentityRequest\x12\x19\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\"Q\n" +
+	"\x14LinkIdentityResponse\x129\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x19.jennah.v1.TenantIdentityR\n" +
+	"identities\"2\n" +
+	"\x15UnlinkIdentityRequest\x12\x19\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\"S\n" +
+	"\x16UnlinkIdentityResponse\x129\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x19.jennah.v1.TenantIdentityR\n" +
+	"identities\")\n" +
+	"\x10CancelJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"B\n" +
+	"\x11CancelJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x83\x03\n" +
+	"\x06Tenant\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x02 \x01(\tR\tuserEmail\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12#\n" +
+	"\rcontact_email\x18\x05 \x01(\tR\fcontactEmail\x12\x1c\n" +
+	"\tsuspended\x18\x06 \x01(\bR\tsuspended\x12!\n" +
+	"\fsuspended_at\x18\a \x01(\tR\vsuspendedAt\x12%\n" +
+	"\x0esuspend_reason\x18\b \x01(\tR\rsuspendReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x129\n" +
+	"\n" +
+	"identities\x18\v \x03(\v2\x19.jennah.v1.TenantIdentityR\n" +
+	"identitiesJ\x04\b\x03\x10\x04R\x0eoauth_provider\"\x14\n" +
+	"\x12ListTenantsRequest\"B\n" +
+	"\x13ListTenantsResponse\x12+\n" +
+	"\atenants\x18\x01 \x03(\v2\x11.jennah.v1.TenantR\atenants\"/\n" +
+	"\x10GetTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\">\n" +
+	"\x11GetTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.jennah.v1.TenantR\x06tenant\"K\n" +
+	"\x14SuspendTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"B\n" +
+	"\x15SuspendTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.jennah.v1.TenantR\x06tenant\"2\n" +
+	"\x13ResumeTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"A\n" +
+	"\x14ResumeTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.jennah.v1.TenantR\x06tenant\"\xa7\x01\n" +
+	"\x13UpdateTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12&\n" +
+	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12(\n" +
+	"\rcontact_email\x18\x03 \x01(\tH\x01R\fcontactEmail\x88\x01\x01B\x0f\n" +
+	"\r_display_nameB\x10\n" +
+	"\x0e_contact_email\"A\n" +
+	"\x14UpdateTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.jennah.v1.TenantR\x06tenant\"2\n" +
+	"\x13DeleteTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"B\n" +
+	"\x14DeleteTenantResponse\x12*\n" +
+	"\x11cancelled_job_ids\x18\x01 \x03(\tR\x0fcancelledJobIds\"\xea\x01\n" +
+	"\vTenantQuota\x12.\n" +
+	"\x13max_concurrent_jobs\x18\x01 \x01(\x03R\x11maxConcurrentJobs\x12;\n" +
+	"\x1amax_submissions_per_minute\x18\x02 \x01(\x03R\x17maxSubmissionsPerMinute\x12\"\n" +
+	"\rmax_cpu_milli\x18\x03 \x01(\x03R\vmaxCpuMilli\x12$\n" +
+	"\x0emax_memory_mib\x18\x04 \x01(\x03R\fmaxMemoryMib\x12$\n" +
+	"\x0emax_task_count\x18\x05 \x01(\x03R\fmaxTaskCount\"j\n" +
+	"\vTenantUsage\x12\x1f\n" +
+	"\vactive_jobs\x18\x01 \x01(\x03R\n" +
+	"activeJobs\x12\x1b\n" +
+	"\tcpu_milli\x18\x02 \x01(\x03R\bcpuMilli\x12\x1d\n" +
+	"\n" +
+	"memory_mib\x18\x03 \x01(\x03R\tmemoryMib\"4\n" +
+	"\x15GetTenantQuotaRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\x93\x01\n" +
+	"\x16GetTenantQuotaResponse\x12,\n" +
+	"\x05quota\x18\x01 \x01(\v2\x16.jennah.v1.TenantQuotaR\x05quota\x12,\n" +
+	"\x05usage\x18\x02 \x01(\v2\x16.jennah.v1.TenantUsageR\x05usage\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x03 \x01(\bR\tisDefault\"e\n" +
+	"\x18UpdateTenantQuotaRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12,\n" +
+	"\x05quota\x18\x02 \x01(\v2\x16.jennah.v1.TenantQuotaR\x05quota\"I\n" +
+	"\x19UpdateTenantQuotaResponse\x12,\n" +
+	"\x05quota\x18\x01 \x01(\v2\x16.jennah.v1.TenantQuotaR\x05quota\"\xec\x02\n" +
+	"\tJobPolicy\x12-\n" +
+	"\x12allowed_registries\x18\x01 \x03(\tR\x11allowedRegistries\x12%\n" +
+	"\x0erequire_digest\x18\x02 \x01(\bR\rrequireDigest\x12\"\n" +
+	"\rmax_cpu_milli\x18\x03 \x01(\x03R\vmaxCpuMilli\x12$\n" +
+	"\x0emax_memory_mib\x18\x04 \x01(\x03R\fmaxMemoryMib\x12$\n" +
+	"\x0emax_task_count\x18\x05 \x01(\x03R\fmaxTaskCount\x12C\n" +
+	"\x10max_run_duration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0emaxRunDuration\x12+\n" +
+	"\x11forbidden_options\x18\a \x03(\tR\x10forbiddenOptions\x12'\n" +
+	"\x0frequired_labels\x18\b \x03(\tR\x0erequiredLabels\"5\n" +
+	"\x16GetTenantPolicyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\x82\x01\n" +
+	"\x17GetTenantPolicyResponse\x12,\n" +
+	"\x06policy\x18\x01 \x01(\v2\x14.jennah.v1.JobPolicyR\x06policy\x129\n" +
+	"\rglobal_policy\x18\x02 \x01(\v2\x14.jennah.v1.JobPolicyR\fglobalPolicy\"f\n" +
+	"\x19UpdateTenantPolicyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12,\n" +
+	"\x06policy\x18\x02 \x01(\v2\x14.jennah.v1.JobPolicyR\x06policy\"J\n" +
+	"\x1aUpdateTenantPolicyResponse\x12,\n" +
+	"\x06policy\x18\x01 \x01(\v2\x14.jennah.v1.JobPolicyR\x06policy\"\xe9\x01\n" +
+	"\x06ApiKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\"J\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\"T\n" +
+	"\x14CreateApiKeyResponse\x12*\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x11.jennah.v1.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\x14\n" +
+	"\x12ListApiKeysRequest\"C\n" +
+	"\x13ListApiKeysResponse\x12,\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x11.jennah.v1.ApiKeyR\aapiKeys\",\n" +
+	"\x13RevokeApiKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"B\n" +
+	"\x14RevokeApiKeyResponse\x12*\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x11.jennah.v1.ApiKeyR\x06apiKey\"\x80\x01\n" +
+	"\x10TenantMembership\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vowner_email\x18\x02 \x01(\tR\n" +
+	"ownerEmail\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1a\n" +
+	"\bpersonal\x18\x04 \x01(\bR\bpersonal\"\x9c\x01\n" +
+	"\fTenantMember\x12(\n" +
+	"\x10member_tenant_id\x18\x01 \x01(\tR\x0ememberTenantId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x19\n" +
+	"\badded_by\x18\x04 \x01(\tR\aaddedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xdb\x01\n" +
+	"\x10TenantInvitation\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\tR\tinvitedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\"\x16\n" +
+	"\x14ListMyTenantsRequest\"N\n" +
+	"\x15ListMyTenantsResponse\x125\n" +
+	"\atenants\x18\x01 \x03(\v2\x1b.jennah.v1.TenantMembershipR\atenants\"\x1a\n" +
+	"\x18ListTenantMembersRequest\"N\n" +
+	"\x19ListTenantMembersResponse\x121\n" +
+	"\amembers\x18\x01 \x03(\v2\x17.jennah.v1.TenantMemberR\amembers\"E\n" +
+	"\x19InviteTenantMemberRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"Y\n" +
+	"\x1aInviteTenantMemberResponse\x12;\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x1b.jennah.v1.TenantInvitationR\n" +
+	"invitation\"\x1a\n" +
+	"\x18ListMyInvitationsRequest\"Z\n" +
+	"\x19ListMyInvitationsResponse\x12=\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x1b.jennah.v1.TenantInvitationR\vinvitations\"[\n" +
+	"\x17AcceptInvitationRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12#\n" +
+	"\rinvitation_id\x18\x02 \x01(\tR\finvitationId\"W\n" +
+	"\x18AcceptInvitationResponse\x12;\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2\x1b.jennah.v1.TenantMembershipR\n" +
+	"membership\"]\n" +
+	"\x1dUpdateTenantMemberRoleRequest\x12(\n" +
+	"\x10member_tenant_id\x18\x01 \x01(\tR\x0ememberTenantId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"Q\n" +
+	"\x1eUpdateTenantMemberRoleResponse\x12/\n" +
+	"\x06member\x18\x01 \x01(\v2\x17.jennah.v1.TenantMemberR\x06member\"E\n" +
+	"\x19RemoveTenantMemberRequest\x12(\n" +
+	"\x10member_tenant_id\x18\x01 \x01(\tR\x0ememberTenantId\"\x1c\n" +
+	"\x1aRemoveTenantMemberResponse\"\x9e\x01\n" +
+	"\x11TemplateParameter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xcb\x01\n" +
+	"\vJobTemplate\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x0flatest_revision\x18\x04 \x01(\x03R\x0elatestRevision\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xac\x03\n" +
+	"\x13JobTemplateRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12\x1b\n" +
+	"\timage_uri\x18\x02 \x01(\tR\bimageUri\x12F\n" +
+	"\benv_vars\x18\x03 \x03(\v2+.jennah.v1.JobTemplateRevision.EnvVarsEntryR\aenvVars\x12=\n" +
+	"\tresources\x18\x04 \x01(\v2\x1f.jennah.v1.ResourceRequirementsR\tresources\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x05 \x01(\x03R\ttaskCount\x12<\n" +
+	"\n" +
+	"parameters\x18\x06 \x03(\v2\x1c.jennah.v1.TemplateParameterR\n" +
+	"parameters\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x1a:\n" +
+	"\fEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa7\x03\n" +
+	"\x18CreateJobTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\timage_uri\x18\x03 \x01(\tR\bimageUri\x12K\n" +
+	"\benv_vars\x18\x04 \x03(\v20.jennah.v1.CreateJobTemplateRequest.EnvVarsEntryR\aenvVars\x12=\n" +
+	"\tresources\x18\x05 \x01(\v2\x1f.jennah.v1.ResourceRequirementsR\tresources\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x06 \x01(\x03R\ttaskCount\x12<\n" +
+	"\n" +
+	"parameters\x18\a \x03(\v2\x1c.jennah.v1.TemplateParameterR\n" +
+	"parameters\x1a:\n" +
+	"\fEnvVarsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_description\"\x8b\x01\n" +
+	"\x19CreateJobTemplateResponse\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.jennah.v1.JobTemplateR\btemplate\x12:\n" +
+	"\brevision\x18\x02 \x01(\v2\x1e.jennah.v1.JobTemplateRevisionR\brevision\"G\n" +
+	"\x15GetJobTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"\x88\x01\n" +
+	"\x16GetJobTemplateResponse\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.jennah.v1.JobTemplateR\btemplate\x12:\n" +
+	"\brevision\x18\x02 \x01(\v2\x1e.jennah.v1.JobTemplateRevisionR\brevision\"\x19\n" +
+	"\x17ListJobTemplatesRequest\"P\n" +
+	"\x18ListJobTemplatesResponse\x124\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x16.jennah.v1.JobTemplateR\ttemplates\".\n" +
+	"\x18DeleteJobTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"O\n" +
+	"\x19DeleteJobTemplateResponse\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.jennah.v1.JobTemplateR\btemplate\"\xc2\a\n" +
+	"\x1cSubmitJobFromTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12W\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\v27.jennah.v1.SubmitJobFromTemplateRequest.ParametersEntryR\n" +
+	"parameters\x12'\n" +
+	"\x0fallowed_regions\x18\x04 \x03(\tR\x0eallowedRegions\x12+\n" +
+	"\x11preferred_regions\x18\x05 \x03(\tR\x10preferredRegions\x12K\n" +
+	"\x06labels\x18\x06 \x03(\v23.jennah.v1.SubmitJobFromTemplateRequest.LabelsEntryR\x06labels\x12Z\n" +
+	"\vannotations\x18\a \x03(\v28.jennah.v1.SubmitJobFromTemplateRequest.AnnotationsEntryR\vannotations\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRun\x12-\n" +
+	"\x12provisioning_model\x18\t \x01(\tR\x11provisioningModel\x12C\n" +
+	"\x10max_run_duration\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x0emaxRunDuration\x12G\n" +
+	"\x12max_queue_duration\x18\v \x01(\v2\x19.google.protobuf.DurationR\x10maxQueueDuration\x12N\n" +
+	"\aoutputs\x18\f \x03(\v24.jennah.v1.SubmitJobFromTemplateRequest.OutputsEntryR\aoutputs\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fOutputsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb6\x02\n" +
+	"\x1dSubmitJobFromTemplateResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fworker_assigned\x18\x03 \x01(\tR\x0eworkerAssigned\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\tR\n" +
+	"templateId\x12+\n" +
+	"\x11template_revision\x18\x06 \x01(\x03R\x10templateRevision\x124\n" +
+	"\tbatch_job\x18\a \x01(\v2\x17.google.protobuf.StructR\bbatchJob\x12!\n" +
+	"\fimage_digest\x18\b \x01(\tR\vimageDigest\"&\n" +
+	"\rGetJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"2\n" +
+	"\x0eGetJobResponse\x12 \n" +
+	"\x03job\x18\x01 \x01(\v2\x0e.jennah.v1.JobR\x03job\"|\n" +
+	"\x11GetJobLogsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\tR\x05since\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"w\n" +
+	"\bLogEntry\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\tR\x06taskId\"k\n" +
+	"\x12GetJobLogsResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.jennah.v1.LogEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
+	"\x17ListJobArtifactsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\"\xae\x01\n" +
+	"\vJobArtifact\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06crc32c\x18\x04 \x01(\tR\x06crc32c\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vrecorded_at\x18\x06 \x01(\tR\n" +
+	"recordedAt\"P\n" +
+	"\x18ListJobArtifactsResponse\x124\n" +
+	"\tartifacts\x18\x01 \x03(\v2\x16.jennah.v1.JobArtifactR\tartifacts\"C\n" +
+	"\x12ValidateJobRequest\x12-\n" +
+	"\x03job\x18\x01 \x01(\v2\x1b.jennah.v1.SubmitJobRequestR\x03job\"-\n" +
+	"\x13ValidateJobResponse\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\"\xc7\x01\n" +
+	"\x18NotificationSubscription\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x85\x01\n" +
+	"%CreateNotificationSubscriptionRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\tR\bstatuses\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\"\x89\x01\n" +
+	"&CreateNotificationSubscriptionResponse\x12G\n" +
+	"\fsubscription\x18\x01 \x01(\v2#.jennah.v1.NotificationSubscriptionR\fsubscription\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"&\n" +
+	"$ListNotificationSubscriptionsRequest\"r\n" +
+	"%ListNotificationSubscriptionsResponse\x12I\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2#.jennah.v1.NotificationSubscriptionR\rsubscriptions\"P\n" +
+	"%DeleteNotificationSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\"q\n" +
+	"&DeleteNotificationSubscriptionResponse\x12G\n" +
+	"\fsubscription\x18\x01 \x01(\v2#.jennah.v1.NotificationSubscriptionR\fsubscription\"\xe0\x03\n" +
+	"\x14NotificationDelivery\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\x12\x1f\n" +
+	"\vfrom_status\x18\x04 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x05 \x01(\tR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x03R\battempts\x12,\n" +
+	"\x12last_response_code\x18\t \x01(\x05R\x10lastResponseCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12&\n" +
+	"\x0flast_attempt_at\x18\f \x01(\tR\rlastAttemptAt\x12!\n" +
+	"\fdelivered_at\x18\r \x01(\tR\vdeliveredAt\x12&\n" +
+	"\x0fnext_attempt_at\x18\x0e \x01(\tR\rnextAttemptAt\"\x91\x01\n" +
+	"!ListNotificationDeliveriesRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"e\n" +
+	"\"ListNotificationDeliveriesResponse\x12?\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1f.jennah.v1.NotificationDeliveryR\n" +
+	"deliveries\"\xcd\x01\n" +
+	"\x0fGetUsageRequest\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x02 \x01(\tR\aendTime\x12%\n" +
+	"\x0elabel_selector\x18\x03 \x01(\tR\rlabelSelector\x12$\n" +
+	"\x0egroup_by_label\x18\x04 \x01(\tR\fgroupByLabel\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\"\x91\x03\n" +
+	"\bUsageRow\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vlabel_value\x18\x02 \x01(\tR\n" +
+	"labelValue\x12\x12\n" +
+	"\x04jobs\x18\x03 \x01(\x03R\x04jobs\x12\x1f\n" +
+	"\vrun_seconds\x18\x04 \x01(\x01R\n" +
+	"runSeconds\x124\n" +
+	"\x16requested_vcpu_seconds\x18\x05 \x01(\x01R\x14requestedVcpuSeconds\x12?\n" +
+	"\x1crequested_memory_gib_seconds\x18\x06 \x01(\x01R\x19requestedMemoryGibSeconds\x12!\n" +
+	"\fvcpu_seconds\x18\a \x01(\x01R\vvcpuSeconds\x12,\n" +
+	"\x12memory_gib_seconds\x18\b \x01(\x01R\x10memoryGibSeconds\x12%\n" +
+	"\x0eestimated_cost\x18\t \x01(\x01R\restimatedCost\x12#\n" +
+	"\runpriced_jobs\x18\n" +
+	" \x01(\x03R\funpricedJobs\"\x94\x01\n" +
+	"\x10GetUsageResponse\x12'\n" +
+	"\x04rows\x18\x01 \x03(\v2\x13.jennah.v1.UsageRowR\x04rows\x12)\n" +
+	"\x05total\x18\x02 \x01(\v2\x13.jennah.v1.UsageRowR\x05total\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x10\n" +
+	"\x03csv\x18\x04 \x01(\tR\x03csv2\xed\x15\n" +
 	"\x11DeploymentService\x12F\n" +
 	"\tSubmitJob\x12\x1b.jennah.v1.SubmitJobRequest\x1a\x1c.jennah.v1.SubmitJobResponse\x12C\n" +
 	"\bListJobs\x12\x1a.jennah.v1.ListJobsRequest\x1a\x1b.jennah.v1.ListJobsResponse\x12[\n" +
-	"\x10GetCurrentTenant\x12\".jennah.v1.GetCurrentTenantRequest\x1a#.jennah.v1.GetCurrentTenantResponseB2Z0github.com/alphauslabs/jennah/gen/proto;jennahv1b\x06proto3"
+	"\x10GetCurrentTenant\x12\".jennah.v1.GetCurrentTenantRequest\x1a#.jennah.v1.GetCurrentTenantResponse\x12O\n" +
+	"\fCreateApiKey\x12\x1e.jennah.v1.CreateApiKeyRequest\x1a\x1f.jennah.v1.CreateApiKeyResponse\x12L\n" +
+	"\vListApiKeys\x12\x1d.jennah.v1.ListApiKeysRequest\x1a\x1e.jennah.v1.ListApiKeysResponse\x12O\n" +
+	"\fRevokeApiKey\x12\x1e.jennah.v1.RevokeApiKeyRequest\x1a\x1f.jennah.v1.RevokeApiKeyResponse\x12R\n" +
+	"\rListMyTenants\x12\x1f.jennah.v1.ListMyTenantsRequest\x1a .jennah.v1.ListMyTenantsResponse\x12^\n" +
+	"\x11ListTenantMembers\x12#.jennah.v1.ListTenantMembersRequest\x1a$.jennah.v1.ListTenantMembersResponse\x12a\n" +
+	"\x12InviteTenantMember\x12$.jennah.v1.InviteTenantMemberRequest\x1a%.jennah.v1.InviteTenantMemberResponse\x12^\n" +
+	"\x11ListMyInvitations\x12#.jennah.v1.ListMyInvitationsRequest\x1a$.jennah.v1.ListMyInvitationsResponse\x12[\n" +
+	"\x10AcceptInvitation\x12\".jennah.v1.AcceptInvitationRequest\x1a#.jennah.v1.AcceptInvitationResponse\x12m\n" +
+	"\x16UpdateTenantMemberRole\x12(.jennah.v1.UpdateTenantMemberRoleRequest\x1a).jennah.v1.UpdateTenantMemberRoleResponse\x12a\n" +
+	"\x12RemoveTenantMember\x12$.jennah.v1.RemoveTenantMemberRequest\x1a%.jennah.v1.RemoveTenantMemberResponse\x12F\n" +
+	"\tCancelJob\x12\x1b.jennah.v1.CancelJobRequest\x1a\x1c.jennah.v1.CancelJobResponse\x12O\n" +
+	"\fLinkIdentity\x12\x1e.jennah.v1.LinkIdentityRequest\x1a\x1f.jennah.v1.LinkIdentityResponse\x12U\n" +
+	"\x0eUnlinkIdentity\x12 .jennah.v1.UnlinkIdentityRequest\x1a!.jennah.v1.UnlinkIdentityResponse\x12^\n" +
+	"\x11CreateJobTemplate\x12#.jennah.v1.CreateJobTemplateRequest\x1a$.jennah.v1.CreateJobTemplateResponse\x12U\n" +
+	"\x0eGetJobTemplate\x12 .jennah.v1.GetJobTemplateRequest\x1a!.jennah.v1.GetJobTemplateResponse\x12[\n" +
+	"\x10ListJobTemplates\x12\".jennah.v1.ListJobTemplatesRequest\x1a#.jennah.v1.ListJobTemplatesResponse\x12^\n" +
+	"\x11DeleteJobTemplate\x12#.jennah.v1.DeleteJobTemplateRequest\x1a$.jennah.v1.DeleteJobTemplateResponse\x12j\n" +
+	"\x15SubmitJobFromTemplate\x12'.jennah.v1.SubmitJobFromTemplateRequest\x1a(.jennah.v1.SubmitJobFromTemplateResponse\x12=\n" +
+	"\x06GetJob\x12\x18.jennah.v1.GetJobRequest\x1a\x19.jennah.v1.GetJobResponse\x12I\n" +
+	"\n" +
+	"GetJobLogs\x12\x1c.jennah.v1.GetJobLogsRequest\x1a\x1d.jennah.v1.GetJobLogsResponse\x12L\n" +
+	"\vValidateJob\x12\x1d.jennah.v1.ValidateJobRequest\x1a\x1e.jennah.v1.ValidateJobResponse\x12\x85\x01\n" +
+	"\x1eCreateNotificationSubscription\x120.jennah.v1.CreateNotificationSubscriptionRequest\x1a1.jennah.v1.CreateNotificationSubscriptionResponse\x12\x82\x01\n" +
+	"\x1dListNotificationSubscriptions\x12/.jennah.v1.ListNotificationSubscriptionsRequest\x1a0.jennah.v1.ListNotificationSubscriptionsResponse\x12\x85\x01\n" +
+	"\x1eDeleteNotificationSubscription\x120.jennah.v1.DeleteNotificationSubscriptionRequest\x1a1.jennah.v1.DeleteNotificationSubscriptionResponse\x12y\n" +
+	"\x1aListNotificationDeliveries\x12,.jennah.v1.ListNotificationDeliveriesRequest\x1a-.jennah.v1.ListNotificationDeliveriesResponse\x12C\n" +
+	"\bGetUsage\x12\x1a.jennah.v1.GetUsageRequest\x1a\x1b.jennah.v1.GetUsageResponse\x12[\n" +
+	"\x10ListJobArtifacts\x12\".jennah.v1.ListJobArtifactsRequest\x1a#.jennah.v1.ListJobArtifactsResponse2\xa4\a\n" +
+	"\fAdminService\x12U\n" +
+	"\x0eGetTenantQuota\x12 .jennah.v1.GetTenantQuotaRequest\x1a!.jennah.v1.GetTenantQuotaResponse\x12^\n" +
+	"\x11UpdateTenantQuota\x12#.jennah.v1.UpdateTenantQuotaRequest\x1a$.jennah.v1.UpdateTenantQuotaResponse\x12L\n" +
+	"\vListTenants\x12\x1d.jennah.v1.ListTenantsRequest\x1a\x1e.jennah.v1.ListTenantsResponse\x12F\n" +
+	"\tGetTenant\x12\x1b.jennah.v1.GetTenantRequest\x1a\x1c.jennah.v1.GetTenantResponse\x12R\n" +
+	"\rSuspendTenant\x12\x1f.jennah.v1.SuspendTenantRequest\x1a .jennah.v1.SuspendTenantResponse\x12O\n" +
+	"\fResumeTenant\x12\x1e.jennah.v1.ResumeTenantRequest\x1a\x1f.jennah.v1.ResumeTenantResponse\x12O\n" +
+	"\fUpdateTenant\x12\x1e.jennah.v1.UpdateTenantRequest\x1a\x1f.jennah.v1.UpdateTenantResponse\x12O\n" +
+	"\fDeleteTenant\x12\x1e.jennah.v1.DeleteTenantRequest\x1a\x1f.jennah.v1.DeleteTenantResponse\x12C\n" +
+	"\bGetUsage\x12\x1a.jennah.v1.GetUsageRequest\x1a\x1b.jennah.v1.GetUsageResponse\x12X\n" +
+	"\x0fGetTenantPolicy\x12!.jennah.v1.GetTenantPolicyRequest\x1a\".jennah.v1.GetTenantPolicyResponse\x12a\n" +
+	"\x12UpdateTenantPolicy\x12$.jennah.v1.UpdateTenantPolicyRequest\x1a%.jennah.v1.UpdateTenantPolicyResponseB2Z0github.com/alphauslabs/jennah/gen/proto;jennahv1b\x06proto3"
 
 var (
 	file_proto_jennah_proto_rawDescOnce sync.Once
@@ -443,31 +6557,282 @@ func file_proto_jennah_proto_rawDescGZIP() []byte {
 	return file_proto_jennah_proto_rawDescData
 }
 
-var file_proto_jennah_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_jennah_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_proto_jennah_proto_goTypes = []any{
-	(*SubmitJobRequest)(nil),         // 0: jennah.v1.SubmitJobRequest
-	(*SubmitJobResponse)(nil),        // 1: jennah.v1.SubmitJobResponse
-	(*ListJobsRequest)(nil),          // 2: jennah.v1.ListJobsRequest
-	(*ListJobsResponse)(nil),         // 3: jennah.v1.ListJobsResponse
-	(*Job)(nil),                      // 4: jennah.v1.Job
-	(*GetCurrentTenantRequest)(nil),  // 5: jennah.v1.GetCurrentTenantRequest
-	(*GetCurrentTenantResponse)(nil), // 6: jennah.v1.GetCurrentTenantResponse
-	nil,                              // 7: jennah.v1.SubmitJobRequest.EnvVarsEntry
+	(*SubmitJobRequest)(nil),                       // 0: jennah.v1.SubmitJobRequest
+	(*ResourceRequirements)(nil),                   // 1: jennah.v1.ResourceRequirements
+	(*SubmitJobResponse)(nil),                      // 2: jennah.v1.SubmitJobResponse
+	(*ListJobsRequest)(nil),                        // 3: jennah.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),                       // 4: jennah.v1.ListJobsResponse
+	(*Job)(nil),                                    // 5: jennah.v1.Job
+	(*GetCurrentTenantRequest)(nil),                // 6: jennah.v1.GetCurrentTenantRequest
+	(*GetCurrentTenantResponse)(nil),               // 7: jennah.v1.GetCurrentTenantResponse
+	(*TenantIdentity)(nil),                         // 8: jennah.v1.TenantIdentity
+	(*LinkIdentityRequest)(nil),                    // 9: jennah.v1.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),                   // 10: jennah.v1.LinkIdentityResponse
+	(*UnlinkIdentityRequest)(nil),                  // 11: jennah.v1.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),                 // 12: jennah.v1.UnlinkIdentityResponse
+	(*CancelJobRequest)(nil),                       // 13: jennah.v1.CancelJobRequest
+	(*CancelJobResponse)(nil),                      // 14: jennah.v1.CancelJobResponse
+	(*Tenant)(nil),                                 // 15: jennah.v1.Tenant
+	(*ListTenantsRequest)(nil),                     // 16: jennah.v1.ListTenantsRequest
+	(*ListTenantsResponse)(nil),                    // 17: jennah.v1.ListTenantsResponse
+	(*GetTenantRequest)(nil),                       // 18: jennah.v1.GetTenantRequest
+	(*GetTenantResponse)(nil),                      // 19: jennah.v1.GetTenantResponse
+	(*SuspendTenantRequest)(nil),                   // 20: jennah.v1.SuspendTenantRequest
+	(*SuspendTenantResponse)(nil),                  // 21: jennah.v1.SuspendTenantResponse
+	(*ResumeTenantRequest)(nil),                    // 22: jennah.v1.ResumeTenantRequest
+	(*ResumeTenantResponse)(nil),                   // 23: jennah.v1.ResumeTenantResponse
+	(*UpdateTenantRequest)(nil),                    // 24: jennah.v1.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),                   // 25: jennah.v1.UpdateTenantResponse
+	(*DeleteTenantRequest)(nil),                    // 26: jennah.v1.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),                   // 27: jennah.v1.DeleteTenantResponse
+	(*TenantQuota)(nil),                            // 28: jennah.v1.TenantQuota
+	(*TenantUsage)(nil),                            // 29: jennah.v1.TenantUsage
+	(*GetTenantQuotaRequest)(nil),                  // 30: jennah.v1.GetTenantQuotaRequest
+	(*GetTenantQuotaResponse)(nil),                 // 31: jennah.v1.GetTenantQuotaResponse
+	(*UpdateTenantQuotaRequest)(nil),               // 32: jennah.v1.UpdateTenantQuotaRequest
+	(*UpdateTenantQuotaResponse)(nil),              // 33: jennah.v1.UpdateTenantQuotaResponse
+	(*JobPolicy)(nil),                              // 34: jennah.v1.JobPolicy
+	(*GetTenantPolicyRequest)(nil),                 // 35: jennah.v1.GetTenantPolicyRequest
+	(*GetTenantPolicyResponse)(nil),                // 36: jennah.v1.GetTenantPolicyResponse
+	(*UpdateTenantPolicyRequest)(nil),              // 37: jennah.v1.UpdateTenantPolicyRequest
+	(*UpdateTenantPolicyResponse)(nil),             // 38: jennah.v1.UpdateTenantPolicyResponse
+	(*ApiKey)(nil),                                 // 39: jennah.v1.ApiKey
+	(*CreateApiKeyRequest)(nil),                    // 40: jennah.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),                   // 41: jennah.v1.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),                     // 42: jennah.v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),                    // 43: jennah.v1.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),                    // 44: jennah.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),                   // 45: jennah.v1.RevokeApiKeyResponse
+	(*TenantMembership)(nil),                       // 46: jennah.v1.TenantMembership
+	(*TenantMember)(nil),                           // 47: jennah.v1.TenantMember
+	(*TenantInvitation)(nil),                       // 48: jennah.v1.TenantInvitation
+	(*ListMyTenantsRequest)(nil),                   // 49: jennah.v1.ListMyTenantsRequest
+	(*ListMyTenantsResponse)(nil),                  // 50: jennah.v1.ListMyTenantsResponse
+	(*ListTenantMembersRequest)(nil),               // 51: jennah.v1.ListTenantMembersRequest
+	(*ListTenantMembersResponse)(nil),              // 52: jennah.v1.ListTenantMembersResponse
+	(*InviteTenantMemberRequest)(nil),              // 53: jennah.v1.InviteTenantMemberRequest
+	(*InviteTenantMemberResponse)(nil),             // 54: jennah.v1.InviteTenantMemberResponse
+	(*ListMyInvitationsRequest)(nil),               // 55: jennah.v1.ListMyInvitationsRequest
+	(*ListMyInvitationsResponse)(nil),              // 56: jennah.v1.ListMyInvitationsResponse
+	(*AcceptInvitationRequest)(nil),                // 57: jennah.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),               // 58: jennah.v1.AcceptInvitationResponse
+	(*UpdateTenantMemberRoleRequest)(nil),          // 59: jennah.v1.UpdateTenantMemberRoleRequest
+	(*UpdateTenantMemberRoleResponse)(nil),         // 60: jennah.v1.UpdateTenantMemberRoleResponse
+	(*RemoveTenantMemberRequest)(nil),              // 61: jennah.v1.RemoveTenantMemberRequest
+	(*RemoveTenantMemberResponse)(nil),             // 62: jennah.v1.RemoveTenantMemberResponse
+	(*TemplateParameter)(nil),                      // 63: jennah.v1.TemplateParameter
+	(*JobTemplate)(nil),                            // 64: jennah.v1.JobTemplate
+	(*JobTemplateRevision)(nil),                    // 65: jennah.v1.JobTemplateRevision
+	(*CreateJobTemplateRequest)(nil),               // 66: jennah.v1.CreateJobTemplateRequest
+	(*CreateJobTemplateResponse)(nil),              // 67: jennah.v1.CreateJobTemplateResponse
+	(*GetJobTemplateRequest)(nil),                  // 68: jennah.v1.GetJobTemplateRequest
+	(*GetJobTemplateResponse)(nil),                 // 69: jennah.v1.GetJobTemplateResponse
+	(*ListJobTemplatesRequest)(nil),                // 70: jennah.v1.ListJobTemplatesRequest
+	(*ListJobTemplatesResponse)(nil),               // 71: jennah.v1.ListJobTemplatesResponse
+	(*DeleteJobTemplateRequest)(nil),               // 72: jennah.v1.DeleteJobTemplateRequest
+	(*DeleteJobTemplateResponse)(nil),              // 73: jennah.v1.DeleteJobTemplateResponse
+	(*SubmitJobFromTemplateRequest)(nil),           // 74: jennah.v1.SubmitJobFromTemplateRequest
+	(*SubmitJobFromTemplateResponse)(nil),          // 75: jennah.v1.SubmitJobFromTemplateResponse
+	(*GetJobRequest)(nil),                          // 76: jennah.v1.GetJobRequest
+	(*GetJobResponse)(nil),                         // 77: jennah.v1.GetJobResponse
+	(*GetJobLogsRequest)(nil),                      // 78: jennah.v1.GetJobLogsRequest
+	(*LogEntry)(nil),                               // 79: jennah.v1.LogEntry
+	(*GetJobLogsResponse)(nil),                     // 80: jennah.v1.GetJobLogsResponse
+	(*ListJobArtifactsRequest)(nil),                // 81: jennah.v1.ListJobArtifactsRequest
+	(*JobArtifact)(nil),                            // 82: jennah.v1.JobArtifact
+	(*ListJobArtifactsResponse)(nil),               // 83: jennah.v1.ListJobArtifactsResponse
+	(*ValidateJobRequest)(nil),                     // 84: jennah.v1.ValidateJobRequest
+	(*ValidateJobResponse)(nil),                    // 85: jennah.v1.ValidateJobResponse
+	(*NotificationSubscription)(nil),               // 86: jennah.v1.NotificationSubscription
+	(*CreateNotificationSubscriptionRequest)(nil),  // 87: jennah.v1.CreateNotificationSubscriptionRequest
+	(*CreateNotificationSubscriptionResponse)(nil), // 88: jennah.v1.CreateNotificationSubscriptionResponse
+	(*ListNotificationSubscriptionsRequest)(nil),   // 89: jennah.v1.ListNotificationSubscriptionsRequest
+	(*ListNotificationSubscriptionsResponse)(nil),  // 90: jennah.v1.ListNotificationSubscriptionsResponse
+	(*DeleteNotificationSubscriptionRequest)(nil),  // 91: jennah.v1.DeleteNotificationSubscriptionRequest
+	(*DeleteNotificationSubscriptionResponse)(nil), // 92: jennah.v1.DeleteNotificationSubscriptionResponse
+	(*NotificationDelivery)(nil),                   // 93: jennah.v1.NotificationDelivery
+	(*ListNotificationDeliveriesRequest)(nil),      // 94: jennah.v1.ListNotificationDeliveriesRequest
+	(*ListNotificationDeliveriesResponse)(nil),     // 95: jennah.v1.ListNotificationDeliveriesResponse
+	(*GetUsageRequest)(nil),                        // 96: jennah.v1.GetUsageRequest
+	(*UsageRow)(nil),                               // 97: jennah.v1.UsageRow
+	(*GetUsageResponse)(nil),                       // 98: jennah.v1.GetUsageResponse
+	nil,                                            // 99: jennah.v1.SubmitJobRequest.EnvVarsEntry
+	nil,                                            // 100: jennah.v1.SubmitJobRequest.LabelsEntry
+	nil,                                            // 101: jennah.v1.SubmitJobRequest.AnnotationsEntry
+	nil,                                            // 102: jennah.v1.SubmitJobRequest.OutputsEntry
+	nil,                                            // 103: jennah.v1.Job.LabelsEntry
+	nil,                                            // 104: jennah.v1.Job.AnnotationsEntry
+	nil,                                            // 105: jennah.v1.Job.OutputsEntry
+	nil,                                            // 106: jennah.v1.JobTemplateRevision.EnvVarsEntry
+	nil,                                            // 107: jennah.v1.CreateJobTemplateRequest.EnvVarsEntry
+	nil,                                            // 108: jennah.v1.SubmitJobFromTemplateRequest.ParametersEntry
+	nil,                                            // 109: jennah.v1.SubmitJobFromTemplateRequest.LabelsEntry
+	nil,                                            // 110: jennah.v1.SubmitJobFromTemplateRequest.AnnotationsEntry
+	nil,                                            // 111: jennah.v1.SubmitJobFromTemplateRequest.OutputsEntry
+	(*durationpb.Duration)(nil),                    // 112: google.protobuf.Duration
+	(*structpb.Struct)(nil),                        // 113: google.protobuf.Struct
 }
 var file_proto_jennah_proto_depIdxs = []int32{
-	7, // 0: jennah.v1.SubmitJobRequest.env_vars:type_name -> jennah.v1.SubmitJobRequest.EnvVarsEntry
-	4, // 1: jennah.v1.ListJobsResponse.jobs:type_name -> jennah.v1.Job
-	0, // 2: jennah.v1.DeploymentService.SubmitJob:input_type -> jennah.v1.SubmitJobRequest
-	2, // 3: jennah.v1.DeploymentService.ListJobs:input_type -> jennah.v1.ListJobsRequest
-	5, // 4: jennah.v1.DeploymentService.GetCurrentTenant:input_type -> jennah.v1.GetCurrentTenantRequest
-	1, // 5: jennah.v1.DeploymentService.SubmitJob:output_type -> jennah.v1.SubmitJobResponse
-	3, // 6: jennah.v1.DeploymentService.ListJobs:output_type -> jennah.v1.ListJobsResponse
-	6, // 7: jennah.v1.DeploymentService.GetCurrentTenant:output_type -> jennah.v1.GetCurrentTenantResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	99,  // 0: jennah.v1.SubmitJobRequest.env_vars:type_name -> jennah.v1.SubmitJobRequest.EnvVarsEntry
+	1,   // 1: jennah.v1.SubmitJobRequest.resources:type_name -> jennah.v1.ResourceRequirements
+	100, // 2: jennah.v1.SubmitJobRequest.labels:type_name -> jennah.v1.SubmitJobRequest.LabelsEntry
+	101, // 3: jennah.v1.SubmitJobRequest.annotations:type_name -> jennah.v1.SubmitJobRequest.AnnotationsEntry
+	112, // 4: jennah.v1.SubmitJobRequest.max_run_duration:type_name -> google.protobuf.Duration
+	112, // 5: jennah.v1.SubmitJobRequest.max_queue_duration:type_name -> google.protobuf.Duration
+	102, // 6: jennah.v1.SubmitJobRequest.outputs:type_name -> jennah.v1.SubmitJobRequest.OutputsEntry
+	28,  // 7: jennah.v1.SubmitJobRequest.quota:type_name -> jennah.v1.TenantQuota
+	113, // 8: jennah.v1.SubmitJobResponse.batch_job:type_name -> google.protobuf.Struct
+	5,   // 9: jennah.v1.ListJobsResponse.jobs:type_name -> jennah.v1.Job
+	103, // 10: jennah.v1.Job.labels:type_name -> jennah.v1.Job.LabelsEntry
+	104, // 11: jennah.v1.Job.annotations:type_name -> jennah.v1.Job.AnnotationsEntry
+	112, // 12: jennah.v1.Job.max_run_duration:type_name -> google.protobuf.Duration
+	112, // 13: jennah.v1.Job.max_queue_duration:type_name -> google.protobuf.Duration
+	105, // 14: jennah.v1.Job.outputs:type_name -> jennah.v1.Job.OutputsEntry
+	8,   // 15: jennah.v1.GetCurrentTenantResponse.identities:type_name -> jennah.v1.TenantIdentity
+	8,   // 16: jennah.v1.LinkIdentityResponse.identities:type_name -> jennah.v1.TenantIdentity
+	8,   // 17: jennah.v1.UnlinkIdentityResponse.identities:type_name -> jennah.v1.TenantIdentity
+	8,   // 18: jennah.v1.Tenant.identities:type_name -> jennah.v1.TenantIdentity
+	15,  // 19: jennah.v1.ListTenantsResponse.tenants:type_name -> jennah.v1.Tenant
+	15,  // 20: jennah.v1.GetTenantResponse.tenant:type_name -> jennah.v1.Tenant
+	15,  // 21: jennah.v1.SuspendTenantResponse.tenant:type_name -> jennah.v1.Tenant
+	15,  // 22: jennah.v1.ResumeTenantResponse.tenant:type_name -> jennah.v1.Tenant
+	15,  // 23: jennah.v1.UpdateTenantResponse.tenant:type_name -> jennah.v1.Tenant
+	28,  // 24: jennah.v1.GetTenantQuotaResponse.quota:type_name -> jennah.v1.TenantQuota
+	29,  // 25: jennah.v1.GetTenantQuotaResponse.usage:type_name -> jennah.v1.TenantUsage
+	28,  // 26: jennah.v1.UpdateTenantQuotaRequest.quota:type_name -> jennah.v1.TenantQuota
+	28,  // 27: jennah.v1.UpdateTenantQuotaResponse.quota:type_name -> jennah.v1.TenantQuota
+	112, // 28: jennah.v1.JobPolicy.max_run_duration:type_name -> google.protobuf.Duration
+	34,  // 29: jennah.v1.GetTenantPolicyResponse.policy:type_name -> jennah.v1.JobPolicy
+	34,  // 30: jennah.v1.GetTenantPolicyResponse.global_policy:type_name -> jennah.v1.JobPolicy
+	34,  // 31: jennah.v1.UpdateTenantPolicyRequest.policy:type_name -> jennah.v1.JobPolicy
+	34,  // 32: jennah.v1.UpdateTenantPolicyResponse.policy:type_name -> jennah.v1.JobPolicy
+	39,  // 33: jennah.v1.CreateApiKeyResponse.api_key:type_name -> jennah.v1.ApiKey
+	39,  // 34: jennah.v1.ListApiKeysResponse.api_keys:type_name -> jennah.v1.ApiKey
+	39,  // 35: jennah.v1.RevokeApiKeyResponse.api_key:type_name -> jennah.v1.ApiKey
+	46,  // 36: jennah.v1.ListMyTenantsResponse.tenants:type_name -> jennah.v1.TenantMembership
+	47,  // 37: jennah.v1.ListTenantMembersResponse.members:type_name -> jennah.v1.TenantMember
+	48,  // 38: jennah.v1.InviteTenantMemberResponse.invitation:type_name -> jennah.v1.TenantInvitation
+	48,  // 39: jennah.v1.ListMyInvitationsResponse.invitations:type_name -> jennah.v1.TenantInvitation
+	46,  // 40: jennah.v1.AcceptInvitationResponse.membership:type_name -> jennah.v1.TenantMembership
+	47,  // 41: jennah.v1.UpdateTenantMemberRoleResponse.member:type_name -> jennah.v1.TenantMember
+	106, // 42: jennah.v1.JobTemplateRevision.env_vars:type_name -> jennah.v1.JobTemplateRevision.EnvVarsEntry
+	1,   // 43: jennah.v1.JobTemplateRevision.resources:type_name -> jennah.v1.ResourceRequirements
+	63,  // 44: jennah.v1.JobTemplateRevision.parameters:type_name -> jennah.v1.TemplateParameter
+	107, // 45: jennah.v1.CreateJobTemplateRequest.env_vars:type_name -> jennah.v1.CreateJobTemplateRequest.EnvVarsEntry
+	1,   // 46: jennah.v1.CreateJobTemplateRequest.resources:type_name -> jennah.v1.ResourceRequirements
+	63,  // 47: jennah.v1.CreateJobTemplateRequest.parameters:type_name -> jennah.v1.TemplateParameter
+	64,  // 48: jennah.v1.CreateJobTemplateResponse.template:type_name -> jennah.v1.JobTemplate
+	65,  // 49: jennah.v1.CreateJobTemplateResponse.revision:type_name -> jennah.v1.JobTemplateRevision
+	64,  // 50: jennah.v1.GetJobTemplateResponse.template:type_name -> jennah.v1.JobTemplate
+	65,  // 51: jennah.v1.GetJobTemplateResponse.revision:type_name -> jennah.v1.JobTemplateRevision
+	64,  // 52: jennah.v1.ListJobTemplatesResponse.templates:type_name -> jennah.v1.JobTemplate
+	64,  // 53: jennah.v1.DeleteJobTemplateResponse.template:type_name -> jennah.v1.JobTemplate
+	108, // 54: jennah.v1.SubmitJobFromTemplateRequest.parameters:type_name -> jennah.v1.SubmitJobFromTemplateRequest.ParametersEntry
+	109, // 55: jennah.v1.SubmitJobFromTemplateRequest.labels:type_name -> jennah.v1.SubmitJobFromTemplateRequest.LabelsEntry
+	110, // 56: jennah.v1.SubmitJobFromTemplateRequest.annotations:type_name -> jennah.v1.SubmitJobFromTemplateRequest.AnnotationsEntry
+	112, // 57: jennah.v1.SubmitJobFromTemplateRequest.max_run_duration:type_name -> google.protobuf.Duration
+	112, // 58: jennah.v1.SubmitJobFromTemplateRequest.max_queue_duration:type_name -> google.protobuf.Duration
+	111, // 59: jennah.v1.SubmitJobFromTemplateRequest.outputs:type_name -> jennah.v1.SubmitJobFromTemplateRequest.OutputsEntry
+	113, // 60: jennah.v1.SubmitJobFromTemplateResponse.batch_job:type_name -> google.protobuf.Struct
+	5,   // 61: jennah.v1.GetJobResponse.job:type_name -> jennah.v1.Job
+	79,  // 62: jennah.v1.GetJobLogsResponse.entries:type_name -> jennah.v1.LogEntry
+	82,  // 63: jennah.v1.ListJobArtifactsResponse.artifacts:type_name -> jennah.v1.JobArtifact
+	0,   // 64: jennah.v1.ValidateJobRequest.job:type_name -> jennah.v1.SubmitJobRequest
+	86,  // 65: jennah.v1.CreateNotificationSubscriptionResponse.subscription:type_name -> jennah.v1.NotificationSubscription
+	86,  // 66: jennah.v1.ListNotificationSubscriptionsResponse.subscriptions:type_name -> jennah.v1.NotificationSubscription
+	86,  // 67: jennah.v1.DeleteNotificationSubscriptionResponse.subscription:type_name -> jennah.v1.NotificationSubscription
+	93,  // 68: jennah.v1.ListNotificationDeliveriesResponse.deliveries:type_name -> jennah.v1.NotificationDelivery
+	97,  // 69: jennah.v1.GetUsageResponse.rows:type_name -> jennah.v1.UsageRow
+	97,  // 70: jennah.v1.GetUsageResponse.total:type_name -> jennah.v1.UsageRow
+	0,   // 71: jennah.v1.DeploymentService.SubmitJob:input_type -> jennah.v1.SubmitJobRequest
+	3,   // 72: jennah.v1.DeploymentService.ListJobs:input_type -> jennah.v1.ListJobsRequest
+	6,   // 73: jennah.v1.DeploymentService.GetCurrentTenant:input_type -> jennah.v1.GetCurrentTenantRequest
+	40,  // 74: jennah.v1.DeploymentService.CreateApiKey:input_type -> jennah.v1.CreateApiKeyRequest
+	42,  // 75: jennah.v1.DeploymentService.ListApiKeys:input_type -> jennah.v1.ListApiKeysRequest
+	44,  // 76: jennah.v1.DeploymentService.RevokeApiKey:input_type -> jennah.v1.RevokeApiKeyRequest
+	49,  // 77: jennah.v1.DeploymentService.ListMyTenants:input_type -> jennah.v1.ListMyTenantsRequest
+	51,  // 78: jennah.v1.DeploymentService.ListTenantMembers:input_type -> jennah.v1.ListTenantMembersRequest
+	53,  // 79: jennah.v1.DeploymentService.InviteTenantMember:input_type -> jennah.v1.InviteTenantMemberRequest
+	55,  // 80: jennah.v1.DeploymentService.ListMyInvitations:input_type -> jennah.v1.ListMyInvitationsRequest
+	57,  // 81: jennah.v1.DeploymentService.AcceptInvitation:input_type -> jennah.v1.AcceptInvitationRequest
+	59,  // 82: jennah.v1.DeploymentService.UpdateTenantMemberRole:input_type -> jennah.v1.UpdateTenantMemberRoleRequest
+	61,  // 83: jennah.v1.DeploymentService.RemoveTenantMember:input_type -> jennah.v1.RemoveTenantMemberRequest
+	13,  // 84: jennah.v1.DeploymentService.CancelJob:input_type -> jennah.v1.CancelJobRequest
+	9,   // 85: jennah.v1.DeploymentService.LinkIdentity:input_type -> jennah.v1.LinkIdentityRequest
+	11,  // 86: jennah.v1.DeploymentService.UnlinkIdentity:input_type -> jennah.v1.UnlinkIdentityRequest
+	66,  // 87: jennah.v1.DeploymentService.CreateJobTemplate:input_type -> jennah.v1.CreateJobTemplateRequest
+	68,  // 88: jennah.v1.DeploymentService.GetJobTemplate:input_type -> jennah.v1.GetJobTemplateRequest
+	70,  // 89: jennah.v1.DeploymentService.ListJobTemplates:input_type -> jennah.v1.ListJobTemplatesRequest
+	72,  // 90: jennah.v1.DeploymentService.DeleteJobTemplate:input_type -> jennah.v1.DeleteJobTemplateRequest
+	74,  // 91: jennah.v1.DeploymentService.SubmitJobFromTemplate:input_type -> jennah.v1.SubmitJobFromTemplateRequest
+	76,  // 92: jennah.v1.DeploymentService.GetJob:input_type -> jennah.v1.GetJobRequest
+	78,  // 93: jennah.v1.DeploymentService.GetJobLogs:input_type -> jennah.v1.GetJobLogsRequest
+	84,  // 94: jennah.v1.DeploymentService.ValidateJob:input_type -> jennah.v1.ValidateJobRequest
+	87,  // 95: jennah.v1.DeploymentService.CreateNotificationSubscription:input_type -> jennah.v1.CreateNotificationSubscriptionRequest
+	89,  // 96: jennah.v1.DeploymentService.ListNotificationSubscriptions:input_type -> jennah.v1.ListNotificationSubscriptionsRequest
+	91,  // 97: jennah.v1.DeploymentService.DeleteNotificationSubscription:input_type -> jennah.v1.DeleteNotificationSubscriptionRequest
+	94,  // 98: jennah.v1.DeploymentService.ListNotificationDeliveries:input_type -> jennah.v1.ListNotificationDeliveriesRequest
+	96,  // 99: jennah.v1.DeploymentService.GetUsage:input_type -> jennah.v1.GetUsageRequest
+	81,  // 100: jennah.v1.DeploymentService.ListJobArtifacts:input_type -> jennah.v1.ListJobArtifactsRequest
+	30,  // 101: jennah.v1.AdminService.GetTenantQuota:input_type -> jennah.v1.GetTenantQuotaRequest
+	32,  // 102: jennah.v1.AdminService.UpdateTenantQuota:input_type -> jennah.v1.UpdateTenantQuotaRequest
+	16,  // 103: jennah.v1.AdminService.ListTenants:input_type -> jennah.v1.ListTenantsRequest
+	18,  // 104: jennah.v1.AdminService.GetTenant:input_type -> jennah.v1.GetTenantRequest
+	20,  // 105: jennah.v1.AdminService.SuspendTenant:input_type -> jennah.v1.SuspendTenantRequest
+	22,  // 106: jennah.v1.AdminService.ResumeTenant:input_type -> jennah.v1.ResumeTenantRequest
+	24,  // 107: jennah.v1.AdminService.UpdateTenant:input_type -> jennah.v1.UpdateTenantRequest
+	26,  // 108: jennah.v1.AdminService.DeleteTenant:input_type -> jennah.v1.DeleteTenantRequest
+	96,  // 109: jennah.v1.AdminService.GetUsage:input_type -> jennah.v1.GetUsageRequest
+	35,  // 110: jennah.v1.AdminService.GetTenantPolicy:input_type -> jennah.v1.GetTenantPolicyRequest
+	37,  // 111: jennah.v1.AdminService.UpdateTenantPolicy:input_type -> jennah.v1.UpdateTenantPolicyRequest
+	2,   // 112: jennah.v1.DeploymentService.SubmitJob:output_type -> jennah.v1.SubmitJobResponse
+	4,   // 113: jennah.v1.DeploymentService.ListJobs:output_type -> jennah.v1.ListJobsResponse
+	7,   // 114: jennah.v1.DeploymentService.GetCurrentTenant:output_type -> jennah.v1.GetCurrentTenantResponse
+	41,  // 115: jennah.v1.DeploymentService.CreateApiKey:output_type -> jennah.v1.CreateApiKeyResponse
+	43,  // 116: jennah.v1.DeploymentService.ListApiKeys:output_type -> jennah.v1.ListApiKeysResponse
+	45,  // 117: jennah.v1.DeploymentService.RevokeApiKey:output_type -> jennah.v1.RevokeApiKeyResponse
+	50,  // 118: jennah.v1.DeploymentService.ListMyTenants:output_type -> jennah.v1.ListMyTenantsResponse
+	52,  // 119: jennah.v1.DeploymentService.ListTenantMembers:output_type -> jennah.v1.ListTenantMembersResponse
+	54,  // 120: jennah.v1.DeploymentService.InviteTenantMember:output_type -> jennah.v1.InviteTenantMemberResponse
+	56,  // 121: jennah.v1.DeploymentService.ListMyInvitations:output_type -> jennah.v1.ListMyInvitationsResponse
+	58,  // 122: jennah.v1.DeploymentService.AcceptInvitation:output_type -> jennah.v1.AcceptInvitationResponse
+	60,  // 123: jennah.v1.DeploymentService.UpdateTenantMemberRole:output_type -> jennah.v1.UpdateTenantMemberRoleResponse
+	62,  // 124: jennah.v1.DeploymentService.RemoveTenantMember:output_type -> jennah.v1.RemoveTenantMemberResponse
+	14,  // 125: jennah.v1.DeploymentService.CancelJob:output_type -> jennah.v1.CancelJobResponse
+	10,  // 126: jennah.v1.DeploymentService.LinkIdentity:output_type -> jennah.v1.LinkIdentityResponse
+	12,  // 127: jennah.v1.DeploymentService.UnlinkIdentity:output_type -> jennah.v1.UnlinkIdentityResponse
+	67,  // 128: jennah.v1.DeploymentService.CreateJobTemplate:output_type -> jennah.v1.CreateJobTemplateResponse
+	69,  // 129: jennah.v1.DeploymentService.GetJobTemplate:output_type -> jennah.v1.GetJobTemplateResponse
+	71,  // 130: jennah.v1.DeploymentService.ListJobTemplates:output_type -> jennah.v1.ListJobTemplatesResponse
+	73,  // 131: jennah.v1.DeploymentService.DeleteJobTemplate:output_type -> jennah.v1.DeleteJobTemplateResponse
+	75,  // 132: jennah.v1.DeploymentService.SubmitJobFromTemplate:output_type -> jennah.v1.SubmitJobFromTemplateResponse
+	77,  // 133: jennah.v1.DeploymentService.GetJob:output_type -> jennah.v1.GetJobResponse
+	80,  // 134: jennah.v1.DeploymentService.GetJobLogs:output_type -> jennah.v1.GetJobLogsResponse
+	85,  // 135: jennah.v1.DeploymentService.ValidateJob:output_type -> jennah.v1.ValidateJobResponse
+	88,  // 136: jennah.v1.DeploymentService.CreateNotificationSubscription:output_type -> jennah.v1.CreateNotificationSubscriptionResponse
+	90,  // 137: jennah.v1.DeploymentService.ListNotificationSubscriptions:output_type -> jennah.v1.ListNotificationSubscriptionsResponse
+	92,  // 138: jennah.v1.DeploymentService.DeleteNotificationSubscription:output_type -> jennah.v1.DeleteNotificationSubscriptionResponse
+	95,  // 139: jennah.v1.DeploymentService.ListNotificationDeliveries:output_type -> jennah.v1.ListNotificationDeliveriesResponse
+	98,  // 140: jennah.v1.DeploymentService.GetUsage:output_type -> jennah.v1.GetUsageResponse
+	83,  // 141: jennah.v1.DeploymentService.ListJobArtifacts:output_type -> jennah.v1.ListJobArtifactsResponse
+	31,  // 142: jennah.v1.AdminService.GetTenantQuota:output_type -> jennah.v1.GetTenantQuotaResponse
+	33,  // 143: jennah.v1.AdminService.UpdateTenantQuota:output_type -> jennah.v1.UpdateTenantQuotaResponse
+	17,  // 144: jennah.v1.AdminService.ListTenants:output_type -> jennah.v1.ListTenantsResponse
+	19,  // 145: jennah.v1.AdminService.GetTenant:output_type -> jennah.v1.GetTenantResponse
+	21,  // 146: jennah.v1.AdminService.SuspendTenant:output_type -> jennah.v1.SuspendTenantResponse
+	23,  // 147: jennah.v1.AdminService.ResumeTenant:output_type -> jennah.v1.ResumeTenantResponse
+	25,  // 148: jennah.v1.AdminService.UpdateTenant:output_type -> jennah.v1.UpdateTenantResponse
+	27,  // 149: jennah.v1.AdminService.DeleteTenant:output_type -> jennah.v1.DeleteTenantResponse
+	98,  // 150: jennah.v1.AdminService.GetUsage:output_type -> jennah.v1.GetUsageResponse
+	36,  // 151: jennah.v1.AdminService.GetTenantPolicy:output_type -> jennah.v1.GetTenantPolicyResponse
+	38,  // 152: jennah.v1.AdminService.UpdateTenantPolicy:output_type -> jennah.v1.UpdateTenantPolicyResponse
+	112, // [112:153] is the sub-list for method output_type
+	71,  // [71:112] is the sub-list for method input_type
+	71,  // [71:71] is the sub-list for extension type_name
+	71,  // [71:71] is the sub-list for extension extendee
+	0,   // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_proto_jennah_proto_init() }
@@ -475,15 +6840,17 @@ func file_proto_jennah_proto_init() {
 	if File_proto_jennah_proto != nil {
 		return
 	}
+	file_proto_jennah_proto_msgTypes[24].OneofWrappers = []any{}
+	file_proto_jennah_proto_msgTypes[66].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_jennah_proto_rawDesc), len(file_proto_jennah_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   112,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_jennah_proto_goTypes,
 		DependencyIndexes: file_proto_jennah_proto_depIdxs,
@@ -23,6 +23,8 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// DeploymentServiceName is the fully-qualified name of the DeploymentService service.
 	DeploymentServiceName = "jennah.v1.DeploymentService"
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "jennah.v1.AdminService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -42,6 +44,118 @@ const (
 	// DeploymentServiceGetCurrentTenantProcedure is the fully-qualified name of the DeploymentService's
 	// GetCurrentTenant RPC.
 	DeploymentServiceGetCurrentTenantProcedure = "/jennah.v1.DeploymentService/GetCurrentTenant"
+	// DeploymentServiceCreateApiKeyProcedure is the fully-qualified name of the DeploymentService's
+	// CreateApiKey RPC.
+	DeploymentServiceCreateApiKeyProcedure = "/jennah.v1.DeploymentService/CreateApiKey"
+	// DeploymentServiceListApiKeysProcedure is the fully-qualified name of the DeploymentService's
+	// ListApiKeys RPC.
+	DeploymentServiceListApiKeysProcedure = "/jennah.v1.DeploymentService/ListApiKeys"
+	// DeploymentServiceRevokeApiKeyProcedure is the fully-qualified name of the DeploymentService's
+	// RevokeApiKey RPC.
+	DeploymentServiceRevokeApiKeyProcedure = "/jennah.v1.DeploymentService/RevokeApiKey"
+	// DeploymentServiceListMyTenantsProcedure is the fully-qualified name of the DeploymentService's
+	// ListMyTenants RPC.
+	DeploymentServiceListMyTenantsProcedure = "/jennah.v1.DeploymentService/ListMyTenants"
+	// DeploymentServiceListTenantMembersProcedure is the fully-qualified name of the
+	// DeploymentService's ListTenantMembers RPC.
+	DeploymentServiceListTenantMembersProcedure = "/jennah.v1.DeploymentService/ListTenantMembers"
+	// DeploymentServiceInviteTenantMemberProcedure is the fully-qualified name of the
+	// DeploymentService's InviteTenantMember RPC.
+	DeploymentServiceInviteTenantMemberProcedure = "/jennah.v1.DeploymentService/InviteTenantMember"
+	// DeploymentServiceListMyInvitationsProcedure is the fully-qualified name of the
+	// DeploymentService's ListMyInvitations RPC.
+	DeploymentServiceListMyInvitationsProcedure = "/jennah.v1.DeploymentService/ListMyInvitations"
+	// DeploymentServiceAcceptInvitationProcedure is the fully-qualified name of the DeploymentService's
+	// AcceptInvitation RPC.
+	DeploymentServiceAcceptInvitationProcedure = "/jennah.v1.DeploymentService/AcceptInvitation"
+	// DeploymentServiceUpdateTenantMemberRoleProcedure is the fully-qualified name of the
+	// DeploymentService's UpdateTenantMemberRole RPC.
+	DeploymentServiceUpdateTenantMemberRoleProcedure = "/jennah.v1.DeploymentService/UpdateTenantMemberRole"
+	// DeploymentServiceRemoveTenantMemberProcedure is the fully-qualified name of the
+	// DeploymentService's RemoveTenantMember RPC.
+	DeploymentServiceRemoveTenantMemberProcedure = "/jennah.v1.DeploymentService/RemoveTenantMember"
+	// DeploymentServiceCancelJobProcedure is the fully-qualified name of the DeploymentService's
+	// CancelJob RPC.
+	DeploymentServiceCancelJobProcedure = "/jennah.v1.DeploymentService/CancelJob"
+	// DeploymentServiceLinkIdentityProcedure is the fully-qualified name of the DeploymentService's
+	// LinkIdentity RPC.
+	DeploymentServiceLinkIdentityProcedure = "/jennah.v1.DeploymentService/LinkIdentity"
+	// DeploymentServiceUnlinkIdentityProcedure is the fully-qualified name of the DeploymentService's
+	// UnlinkIdentity RPC.
+	DeploymentServiceUnlinkIdentityProcedure = "/jennah.v1.DeploymentService/UnlinkIdentity"
+	// DeploymentServiceCreateJobTemplateProcedure is the fully-qualified name of the
+	// DeploymentService's CreateJobTemplate RPC.
+	DeploymentServiceCreateJobTemplateProcedure = "/jennah.v1.DeploymentService/CreateJobTemplate"
+	// DeploymentServiceGetJobTemplateProcedure is the fully-qualified name of the DeploymentService's
+	// GetJobTemplate RPC.
+	DeploymentServiceGetJobTemplateProcedure = "/jennah.v1.DeploymentService/GetJobTemplate"
+	// DeploymentServiceListJobTemplatesProcedure is the fully-qualified name of the DeploymentService's
+	// ListJobTemplates RPC.
+	DeploymentServiceListJobTemplatesProcedure = "/jennah.v1.DeploymentService/ListJobTemplates"
+	// DeploymentServiceDeleteJobTemplateProcedure is the fully-qualified name of the
+	// DeploymentService's DeleteJobTemplate RPC.
+	DeploymentServiceDeleteJobTemplateProcedure = "/jennah.v1.DeploymentService/DeleteJobTemplate"
+	// DeploymentServiceSubmitJobFromTemplateProcedure is the fully-qualified name of the
+	// DeploymentService's SubmitJobFromTemplate RPC.
+	DeploymentServiceSubmitJobFromTemplateProcedure = "/jennah.v1.DeploymentService/SubmitJobFromTemplate"
+	// DeploymentServiceGetJobProcedure is the fully-qualified name of the DeploymentService's GetJob
+	// RPC.
+	DeploymentServiceGetJobProcedure = "/jennah.v1.DeploymentService/GetJob"
+	// DeploymentServiceGetJobLogsProcedure is the fully-qualified name of the DeploymentService's
+	// GetJobLogs RPC.
+	DeploymentServiceGetJobLogsProcedure = "/jennah.v1.DeploymentService/GetJobLogs"
+	// DeploymentServiceValidateJobProcedure is the fully-qualified name of the DeploymentService's
+	// ValidateJob RPC.
+	DeploymentServiceValidateJobProcedure = "/jennah.v1.DeploymentService/ValidateJob"
+	// DeploymentServiceCreateNotificationSubscriptionProcedure is the fully-qualified name of the
+	// DeploymentService's CreateNotificationSubscription RPC.
+	DeploymentServiceCreateNotificationSubscriptionProcedure = "/jennah.v1.DeploymentService/CreateNotificationSubscription"
+	// DeploymentServiceListNotificationSubscriptionsProcedure is the fully-qualified name of the
+	// DeploymentService's ListNotificationSubscriptions RPC.
+	DeploymentServiceListNotificationSubscriptionsProcedure = "/jennah.v1.DeploymentService/ListNotificationSubscriptions"
+	// DeploymentServiceDeleteNotificationSubscriptionProcedure is the fully-qualified name of the
+	// DeploymentService's DeleteNotificationSubscription RPC.
+	DeploymentServiceDeleteNotificationSubscriptionProcedure = "/jennah.v1.DeploymentService/DeleteNotificationSubscription"
+	// DeploymentServiceListNotificationDeliveriesProcedure is the fully-qualified name of the
+	// DeploymentService's ListNotificationDeliveries RPC.
+	DeploymentServiceListNotificationDeliveriesProcedure = "/jennah.v1.DeploymentService/ListNotificationDeliveries"
+	// DeploymentServiceGetUsageProcedure is the fully-qualified name of the DeploymentService's
+	// GetUsage RPC.
+	DeploymentServiceGetUsageProcedure = "/jennah.v1.DeploymentService/GetUsage"
+	// DeploymentServiceListJobArtifactsProcedure is the fully-qualified name of the DeploymentService's
+	// ListJobArtifacts RPC.
+	DeploymentServiceListJobArtifactsProcedure = "/jennah.v1.DeploymentService/ListJobArtifacts"
+	// AdminServiceGetTenantQuotaProcedure is the fully-qualified name of the AdminService's
+	// GetTenantQuota RPC.
+	AdminServiceGetTenantQuotaProcedure = "/jennah.v1.AdminService/GetTenantQuota"
+	// AdminServiceUpdateTenantQuotaProcedure is the fully-qualified name of the AdminService's
+	// UpdateTenantQuota RPC.
+	AdminServiceUpdateTenantQuotaProcedure = "/jennah.v1.AdminService/UpdateTenantQuota"
+	// AdminServiceListTenantsProcedure is the fully-qualified name of the AdminService's ListTenants
+	// RPC.
+	AdminServiceListTenantsProcedure = "/jennah.v1.AdminService/ListTenants"
+	// AdminServiceGetTenantProcedure is the fully-qualified name of the AdminService's GetTenant RPC.
+	AdminServiceGetTenantProcedure = "/jennah.v1.AdminService/GetTenant"
+	// AdminServiceSuspendTenantProcedure is the fully-qualified name of the AdminService's
+	// SuspendTenant RPC.
+	AdminServiceSuspendTenantProcedure = "/jennah.v1.AdminService/SuspendTenant"
+	// AdminServiceResumeTenantProcedure is the fully-qualified name of the AdminService's ResumeTenant
+	// RPC.
+	AdminServiceResumeTenantProcedure = "/jennah.v1.AdminService/ResumeTenant"
+	// AdminServiceUpdateTenantProcedure is the fully-qualified name of the AdminService's UpdateTenant
+	// RPC.
+	AdminServiceUpdateTenantProcedure = "/jennah.v1.AdminService/UpdateTenant"
+	// AdminServiceDeleteTenantProcedure is the fully-qualified name of the AdminService's DeleteTenant
+	// RPC.
+	AdminServiceDeleteTenantProcedure = "/jennah.v1.AdminService/DeleteTenant"
+	// AdminServiceGetUsageProcedure is the fully-qualified name of the AdminService's GetUsage RPC.
+	AdminServiceGetUsageProcedure = "/jennah.v1.AdminService/GetUsage"
+	// AdminServiceGetTenantPolicyProcedure is the fully-qualified name of the AdminService's
+	// GetTenantPolicy RPC.
+	AdminServiceGetTenantPolicyProcedure = "/jennah.v1.AdminService/GetTenantPolicy"
+	// AdminServiceUpdateTenantPolicyProcedure is the fully-qualified name of the AdminService's
+	// UpdateTenantPolicy RPC.
+	AdminServiceUpdateTenantPolicyProcedure = "/jennah.v1.AdminService/UpdateTenantPolicy"
 )
 
 // DeploymentServiceClient is a client for the jennah.v1.DeploymentService service.
@@ -52,6 +166,62 @@ type DeploymentServiceClient interface {
 	ListJobs(context.Context, *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error)
 	// Get the current tenant's information.
 	GetCurrentTenant(context.Context, *connect.Request[proto.GetCurrentTenantRequest]) (*connect.Response[proto.GetCurrentTenantResponse], error)
+	// Create an API key for the current tenant. The key is only returned once.
+	CreateApiKey(context.Context, *connect.Request[proto.CreateApiKeyRequest]) (*connect.Response[proto.CreateApiKeyResponse], error)
+	// List the current tenant's API keys.
+	ListApiKeys(context.Context, *connect.Request[proto.ListApiKeysRequest]) (*connect.Response[proto.ListApiKeysResponse], error)
+	// Revoke one of the current tenant's API keys.
+	RevokeApiKey(context.Context, *connect.Request[proto.RevokeApiKeyRequest]) (*connect.Response[proto.RevokeApiKeyResponse], error)
+	// List the tenants the current user belongs to, with their role in each.
+	ListMyTenants(context.Context, *connect.Request[proto.ListMyTenantsRequest]) (*connect.Response[proto.ListMyTenantsResponse], error)
+	// List the members of the current tenant.
+	ListTenantMembers(context.Context, *connect.Request[proto.ListTenantMembersRequest]) (*connect.Response[proto.ListTenantMembersResponse], error)
+	// Invite a user, by email, to the current tenant.
+	InviteTenantMember(context.Context, *connect.Request[proto.InviteTenantMemberRequest]) (*connect.Response[proto.InviteTenantMemberResponse], error)
+	// List pending invitations addressed to the current user's email.
+	ListMyInvitations(context.Context, *connect.Request[proto.ListMyInvitationsRequest]) (*connect.Response[proto.ListMyInvitationsResponse], error)
+	// Accept an invitation addressed to the current user's email.
+	AcceptInvitation(context.Context, *connect.Request[proto.AcceptInvitationRequest]) (*connect.Response[proto.AcceptInvitationResponse], error)
+	// Change a member's role in the current tenant.
+	UpdateTenantMemberRole(context.Context, *connect.Request[proto.UpdateTenantMemberRoleRequest]) (*connect.Response[proto.UpdateTenantMemberRoleResponse], error)
+	// Remove a member from the current tenant.
+	RemoveTenantMember(context.Context, *connect.Request[proto.RemoveTenantMemberRequest]) (*connect.Response[proto.RemoveTenantMemberResponse], error)
+	// Cancel one of the current tenant's jobs, stopping it in GCP Batch.
+	CancelJob(context.Context, *connect.Request[proto.CancelJobRequest]) (*connect.Response[proto.CancelJobResponse], error)
+	// Link another OAuth identity to the current user's personal tenant.
+	LinkIdentity(context.Context, *connect.Request[proto.LinkIdentityRequest]) (*connect.Response[proto.LinkIdentityResponse], error)
+	// Unlink an OAuth identity from the current user's personal tenant.
+	UnlinkIdentity(context.Context, *connect.Request[proto.UnlinkIdentityRequest]) (*connect.Response[proto.UnlinkIdentityResponse], error)
+	// Create a job template, or a new revision of the current tenant's template with the same name.
+	CreateJobTemplate(context.Context, *connect.Request[proto.CreateJobTemplateRequest]) (*connect.Response[proto.CreateJobTemplateResponse], error)
+	// Get one revision of a job template, the latest by default.
+	GetJobTemplate(context.Context, *connect.Request[proto.GetJobTemplateRequest]) (*connect.Response[proto.GetJobTemplateResponse], error)
+	// List the current tenant's job templates.
+	ListJobTemplates(context.Context, *connect.Request[proto.ListJobTemplatesRequest]) (*connect.Response[proto.ListJobTemplatesResponse], error)
+	// Delete a job template and all its revisions.
+	DeleteJobTemplate(context.Context, *connect.Request[proto.DeleteJobTemplateRequest]) (*connect.Response[proto.DeleteJobTemplateResponse], error)
+	// Submit a job from a template revision, filling in its parameters.
+	SubmitJobFromTemplate(context.Context, *connect.Request[proto.SubmitJobFromTemplateRequest]) (*connect.Response[proto.SubmitJobFromTemplateResponse], error)
+	// Get one of the current tenant's jobs.
+	GetJob(context.Context, *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error)
+	// Read the log lines a job's tasks wrote to Cloud Logging, oldest first.
+	GetJobLogs(context.Context, *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error)
+	// Run every check SubmitJob runs, including quotas and region placement, without
+	// creating anything.
+	ValidateJob(context.Context, *connect.Request[proto.ValidateJobRequest]) (*connect.Response[proto.ValidateJobResponse], error)
+	// Subscribe a webhook to the current tenant's job status changes.
+	CreateNotificationSubscription(context.Context, *connect.Request[proto.CreateNotificationSubscriptionRequest]) (*connect.Response[proto.CreateNotificationSubscriptionResponse], error)
+	// List the current tenant's notification subscriptions.
+	ListNotificationSubscriptions(context.Context, *connect.Request[proto.ListNotificationSubscriptionsRequest]) (*connect.Response[proto.ListNotificationSubscriptionsResponse], error)
+	// Delete a notification subscription and its delivery history.
+	DeleteNotificationSubscription(context.Context, *connect.Request[proto.DeleteNotificationSubscriptionRequest]) (*connect.Response[proto.DeleteNotificationSubscriptionResponse], error)
+	// List notification deliveries and their attempts, newest first.
+	ListNotificationDeliveries(context.Context, *connect.Request[proto.ListNotificationDeliveriesRequest]) (*connect.Response[proto.ListNotificationDeliveriesResponse], error)
+	// Sum the resources and estimated cost of the current tenant's jobs that finished in a
+	// time range, optionally per value of a label.
+	GetUsage(context.Context, *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error)
+	// List the files a completed job wrote to its declared outputs.
+	ListJobArtifacts(context.Context, *connect.Request[proto.ListJobArtifactsRequest]) (*connect.Response[proto.ListJobArtifactsResponse], error)
 }
 
 // NewDeploymentServiceClient constructs a client for the jennah.v1.DeploymentService service. By
@@ -83,14 +253,203 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("GetCurrentTenant")),
 			connect.WithClientOptions(opts...),
 		),
+		createApiKey: connect.NewClient[proto.CreateApiKeyRequest, proto.CreateApiKeyResponse](
+			httpClient,
+			baseURL+DeploymentServiceCreateApiKeyProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("CreateApiKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listApiKeys: connect.NewClient[proto.ListApiKeysRequest, proto.ListApiKeysResponse](
+			httpClient,
+			baseURL+DeploymentServiceListApiKeysProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListApiKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiKey: connect.NewClient[proto.RevokeApiKeyRequest, proto.RevokeApiKeyResponse](
+			httpClient,
+			baseURL+DeploymentServiceRevokeApiKeyProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("RevokeApiKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyTenants: connect.NewClient[proto.ListMyTenantsRequest, proto.ListMyTenantsResponse](
+			httpClient,
+			baseURL+DeploymentServiceListMyTenantsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListMyTenants")),
+			connect.WithClientOptions(opts...),
+		),
+		listTenantMembers: connect.NewClient[proto.ListTenantMembersRequest, proto.ListTenantMembersResponse](
+			httpClient,
+			baseURL+DeploymentServiceListTenantMembersProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListTenantMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteTenantMember: connect.NewClient[proto.InviteTenantMemberRequest, proto.InviteTenantMemberResponse](
+			httpClient,
+			baseURL+DeploymentServiceInviteTenantMemberProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("InviteTenantMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyInvitations: connect.NewClient[proto.ListMyInvitationsRequest, proto.ListMyInvitationsResponse](
+			httpClient,
+			baseURL+DeploymentServiceListMyInvitationsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListMyInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[proto.AcceptInvitationRequest, proto.AcceptInvitationResponse](
+			httpClient,
+			baseURL+DeploymentServiceAcceptInvitationProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("AcceptInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTenantMemberRole: connect.NewClient[proto.UpdateTenantMemberRoleRequest, proto.UpdateTenantMemberRoleResponse](
+			httpClient,
+			baseURL+DeploymentServiceUpdateTenantMemberRoleProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("UpdateTenantMemberRole")),
+			connect.WithClientOptions(opts...),
+		),
+		removeTenantMember: connect.NewClient[proto.RemoveTenantMemberRequest, proto.RemoveTenantMemberResponse](
+			httpClient,
+			baseURL+DeploymentServiceRemoveTenantMemberProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("RemoveTenantMember")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelJob: connect.NewClient[proto.CancelJobRequest, proto.CancelJobResponse](
+			httpClient,
+			baseURL+DeploymentServiceCancelJobProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("CancelJob")),
+			connect.WithClientOptions(opts...),
+		),
+		linkIdentity: connect.NewClient[proto.LinkIdentityRequest, proto.LinkIdentityResponse](
+			httpClient,
+			baseURL+DeploymentServiceLinkIdentityProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("LinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkIdentity: connect.NewClient[proto.UnlinkIdentityRequest, proto.UnlinkIdentityResponse](
+			httpClient,
+			baseURL+DeploymentServiceUnlinkIdentityProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("UnlinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		createJobTemplate: connect.NewClient[proto.CreateJobTemplateRequest, proto.CreateJobTemplateResponse](
+			httpClient,
+			baseURL+DeploymentServiceCreateJobTemplateProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("CreateJobTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		getJobTemplate: connect.NewClient[proto.GetJobTemplateRequest, proto.GetJobTemplateResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetJobTemplateProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetJobTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		listJobTemplates: connect.NewClient[proto.ListJobTemplatesRequest, proto.ListJobTemplatesResponse](
+			httpClient,
+			baseURL+DeploymentServiceListJobTemplatesProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListJobTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteJobTemplate: connect.NewClient[proto.DeleteJobTemplateRequest, proto.DeleteJobTemplateResponse](
+			httpClient,
+			baseURL+DeploymentServiceDeleteJobTemplateProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("DeleteJobTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		submitJobFromTemplate: connect.NewClient[proto.SubmitJobFromTemplateRequest, proto.SubmitJobFromTemplateResponse](
+			httpClient,
+			baseURL+DeploymentServiceSubmitJobFromTemplateProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("SubmitJobFromTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		getJob: connect.NewClient[proto.GetJobRequest, proto.GetJobResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetJobProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetJob")),
+			connect.WithClientOptions(opts...),
+		),
+		getJobLogs: connect.NewClient[proto.GetJobLogsRequest, proto.GetJobLogsResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetJobLogsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetJobLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		validateJob: connect.NewClient[proto.ValidateJobRequest, proto.ValidateJobResponse](
+			httpClient,
+			baseURL+DeploymentServiceValidateJobProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ValidateJob")),
+			connect.WithClientOptions(opts...),
+		),
+		createNotificationSubscription: connect.NewClient[proto.CreateNotificationSubscriptionRequest, proto.CreateNotificationSubscriptionResponse](
+			httpClient,
+			baseURL+DeploymentServiceCreateNotificationSubscriptionProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("CreateNotificationSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listNotificationSubscriptions: connect.NewClient[proto.ListNotificationSubscriptionsRequest, proto.ListNotificationSubscriptionsResponse](
+			httpClient,
+			baseURL+DeploymentServiceListNotificationSubscriptionsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListNotificationSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNotificationSubscription: connect.NewClient[proto.DeleteNotificationSubscriptionRequest, proto.DeleteNotificationSubscriptionResponse](
+			httpClient,
+			baseURL+DeploymentServiceDeleteNotificationSubscriptionProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("DeleteNotificationSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listNotificationDeliveries: connect.NewClient[proto.ListNotificationDeliveriesRequest, proto.ListNotificationDeliveriesResponse](
+			httpClient,
+			baseURL+DeploymentServiceListNotificationDeliveriesProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListNotificationDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[proto.GetUsageRequest, proto.GetUsageResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetUsageProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		listJobArtifacts: connect.NewClient[proto.ListJobArtifactsRequest, proto.ListJobArtifactsResponse](
+			httpClient,
+			baseURL+DeploymentServiceListJobArtifactsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListJobArtifacts")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // deploymentServiceClient implements DeploymentServiceClient.
 type deploymentServiceClient struct {
-	submitJob        *connect.Client[proto.SubmitJobRequest, proto.SubmitJobResponse]
-	listJobs         *connect.Client[proto.ListJobsRequest, proto.ListJobsResponse]
-	getCurrentTenant *connect.Client[proto.GetCurrentTenantRequest, proto.GetCurrentTenantResponse]
+	submitJob                      *connect.Client[proto.SubmitJobRequest, proto.SubmitJobResponse]
+	listJobs                       *connect.Client[proto.ListJobsRequest, proto.ListJobsResponse]
+	getCurrentTenant               *connect.Client[proto.GetCurrentTenantRequest, proto.GetCurrentTenantResponse]
+	createApiKey                   *connect.Client[proto.CreateApiKeyRequest, proto.CreateApiKeyResponse]
+	listApiKeys                    *connect.Client[proto.ListApiKeysRequest, proto.ListApiKeysResponse]
+	revokeApiKey                   *connect.Client[proto.RevokeApiKeyRequest, proto.RevokeApiKeyResponse]
+	listMyTenants                  *connect.Client[proto.ListMyTenantsRequest, proto.ListMyTenantsResponse]
+	listTenantMembers              *connect.Client[proto.ListTenantMembersRequest, proto.ListTenantMembersResponse]
+	inviteTenantMember             *connect.Client[proto.InviteTenantMemberRequest, proto.InviteTenantMemberResponse]
+	listMyInvitations              *connect.Client[proto.ListMyInvitationsRequest, proto.ListMyInvitationsResponse]
+	acceptInvitation               *connect.Client[proto.AcceptInvitationRequest, proto.AcceptInvitationResponse]
+	updateTenantMemberRole         *connect.Client[proto.UpdateTenantMemberRoleRequest, proto.UpdateTenantMemberRoleResponse]
+	removeTenantMember             *connect.Client[proto.RemoveTenantMemberRequest, proto.RemoveTenantMemberResponse]
+	cancelJob                      *connect.Client[proto.CancelJobRequest, proto.CancelJobResponse]
+	linkIdentity                   *connect.Client[proto.LinkIdentityRequest, proto.LinkIdentityResponse]
+	unlinkIdentity                 *connect.Client[proto.UnlinkIdentityRequest, proto.UnlinkIdentityResponse]
+	createJobTemplate              *connect.Client[proto.CreateJobTemplateRequest, proto.CreateJobTemplateResponse]
+	getJobTemplate                 *connect.Client[proto.GetJobTemplateRequest, proto.GetJobTemplateResponse]
+	listJobTemplates               *connect.Client[proto.ListJobTemplatesRequest, proto.ListJobTemplatesResponse]
+	deleteJobTemplate              *connect.Client[proto.DeleteJobTemplateRequest, proto.DeleteJobTemplateResponse]
+	submitJobFromTemplate          *connect.Client[proto.SubmitJobFromTemplateRequest, proto.SubmitJobFromTemplateResponse]
+	getJob                         *connect.Client[proto.GetJobRequest, proto.GetJobResponse]
+	getJobLogs                     *connect.Client[proto.GetJobLogsRequest, proto.GetJobLogsResponse]
+	validateJob                    *connect.Client[proto.ValidateJobRequest, proto.ValidateJobResponse]
+	createNotificationSubscription *connect.Client[proto.CreateNotificationSubscriptionRequest, proto.CreateNotificationSubscriptionResponse]
+	listNotificationSubscriptions  *connect.Client[proto.ListNotificationSubscriptionsRequest, proto.ListNotificationSubscriptionsResponse]
+	deleteNotificationSubscription *connect.Client[proto.DeleteNotificationSubscriptionRequest, proto.DeleteNotificationSubscriptionResponse]
+	listNotificationDeliveries     *connect.Client[proto.ListNotificationDeliveriesRequest, proto.ListNotificationDeliveriesResponse]
+	getUsage                       *connect.Client[proto.GetUsageRequest, proto.GetUsageResponse]
+	listJobArtifacts               *connect.Client[proto.ListJobArtifactsRequest, proto.ListJobArtifactsResponse]
 }
 
 // SubmitJob calls jennah.v1.DeploymentService.SubmitJob.
@@ -108,6 +467,141 @@ func (c *deploymentServiceClient) GetCurrentTenant(ctx context.Context, req *con
 	return c.getCurrentTenant.CallUnary(ctx, req)
 }
 
+// CreateApiKey calls jennah.v1.DeploymentService.CreateApiKey.
+func (c *deploymentServiceClient) CreateApiKey(ctx context.Context, req *connect.Request[proto.CreateApiKeyRequest]) (*connect.Response[proto.CreateApiKeyResponse], error) {
+	return c.createApiKey.CallUnary(ctx, req)
+}
+
+// ListApiKeys calls jennah.v1.DeploymentService.ListApiKeys.
+func (c *deploymentServiceClient) ListApiKeys(ctx context.Context, req *connect.Request[proto.ListApiKeysRequest]) (*connect.Response[proto.ListApiKeysResponse], error) {
+	return c.listApiKeys.CallUnary(ctx, req)
+}
+
+// RevokeApiKey calls jennah.v1.DeploymentService.RevokeApiKey.
+func (c *deploymentServiceClient) RevokeApiKey(ctx context.Context, req *connect.Request[proto.RevokeApiKeyRequest]) (*connect.Response[proto.RevokeApiKeyResponse], error) {
+	return c.revokeApiKey.CallUnary(ctx, req)
+}
+
+// ListMyTenants calls jennah.v1.DeploymentService.ListMyTenants.
+func (c *deploymentServiceClient) ListMyTenants(ctx context.Context, req *connect.Request[proto.ListMyTenantsRequest]) (*connect.Response[proto.ListMyTenantsResponse], error) {
+	return c.listMyTenants.CallUnary(ctx, req)
+}
+
+// ListTenantMembers calls jennah.v1.DeploymentService.ListTenantMembers.
+func (c *deploymentServiceClient) ListTenantMembers(ctx context.Context, req *connect.Request[proto.ListTenantMembersRequest]) (*connect.Response[proto.ListTenantMembersResponse], error) {
+	return c.listTenantMembers.CallUnary(ctx, req)
+}
+
+// InviteTenantMember calls jennah.v1.DeploymentService.InviteTenantMember.
+func (c *deploymentServiceClient) InviteTenantMember(ctx context.Context, req *connect.Request[proto.InviteTenantMemberRequest]) (*connect.Response[proto.InviteTenantMemberResponse], error) {
+	return c.inviteTenantMember.CallUnary(ctx, req)
+}
+
+// ListMyInvitations calls jennah.v1.DeploymentService.ListMyInvitations.
+func (c *deploymentServiceClient) ListMyInvitations(ctx context.Context, req *connect.Request[proto.ListMyInvitationsRequest]) (*connect.Response[proto.ListMyInvitationsResponse], error) {
+	return c.listMyInvitations.CallUnary(ctx, req)
+}
+
+// AcceptInvitation calls jennah.v1.DeploymentService.AcceptInvitation.
+func (c *deploymentServiceClient) AcceptInvitation(ctx context.Context, req *connect.Request[proto.AcceptInvitationRequest]) (*connect.Response[proto.AcceptInvitationResponse], error) {
+	return c.acceptInvitation.CallUnary(ctx, req)
+}
+
+// UpdateTenantMemberRole calls jennah.v1.DeploymentService.UpdateTenantMemberRole.
+func (c *deploymentServiceClient) UpdateTenantMemberRole(ctx context.Context, req *connect.Request[proto.UpdateTenantMemberRoleRequest]) (*connect.Response[proto.UpdateTenantMemberRoleResponse], error) {
+	return c.updateTenantMemberRole.CallUnary(ctx, req)
+}
+
+// RemoveTenantMember calls jennah.v1.DeploymentService.RemoveTenantMember.
+func (c *deploymentServiceClient) RemoveTenantMember(ctx context.Context, req *connect.Request[proto.RemoveTenantMemberRequest]) (*connect.Response[proto.RemoveTenantMemberResponse], error) {
+	return c.removeTenantMember.CallUnary(ctx, req)
+}
+
+// CancelJob calls jennah.v1.DeploymentService.CancelJob.
+func (c *deploymentServiceClient) CancelJob(ctx context.Context, req *connect.Request[proto.CancelJobRequest]) (*connect.Response[proto.CancelJobResponse], error) {
+	return c.cancelJob.CallUnary(ctx, req)
+}
+
+// LinkIdentity calls jennah.v1.DeploymentService.LinkIdentity.
+func (c *deploymentServiceClient) LinkIdentity(ctx context.Context, req *connect.Request[proto.LinkIdentityRequest]) (*connect.Response[proto.LinkIdentityResponse], error) {
+	return c.linkIdentity.CallUnary(ctx, req)
+}
+
+// UnlinkIdentity calls jennah.v1.DeploymentService.UnlinkIdentity.
+func (c *deploymentServiceClient) UnlinkIdentity(ctx context.Context, req *connect.Request[proto.UnlinkIdentityRequest]) (*connect.Response[proto.UnlinkIdentityResponse], error) {
+	return c.unlinkIdentity.CallUnary(ctx, req)
+}
+
+// CreateJobTemplate calls jennah.v1.DeploymentService.CreateJobTemplate.
+func (c *deploymentServiceClient) CreateJobTemplate(ctx context.Context, req *connect.Request[proto.CreateJobTemplateRequest]) (*connect.Response[proto.CreateJobTemplateResponse], error) {
+	return c.createJobTemplate.CallUnary(ctx, req)
+}
+
+// GetJobTemplate calls jennah.v1.DeploymentService.GetJobTemplate.
+func (c *deploymentServiceClient) GetJobTemplate(ctx context.Context, req *connect.Request[proto.GetJobTemplateRequest]) (*connect.Response[proto.GetJobTemplateResponse], error) {
+	return c.getJobTemplate.CallUnary(ctx, req)
+}
+
+// ListJobTemplates calls jennah.v1.DeploymentService.ListJobTemplates.
+func (c *deploymentServiceClient) ListJobTemplates(ctx context.Context, req *connect.Request[proto.ListJobTemplatesRequest]) (*connect.Response[proto.ListJobTemplatesResponse], error) {
+	return c.listJobTemplates.CallUnary(ctx, req)
+}
+
+// DeleteJobTemplate calls jennah.v1.DeploymentService.DeleteJobTemplate.
+func (c *deploymentServiceClient) DeleteJobTemplate(ctx context.Context, req *connect.Request[proto.DeleteJobTemplateRequest]) (*connect.Response[proto.DeleteJobTemplateResponse], error) {
+	return c.deleteJobTemplate.CallUnary(ctx, req)
+}
+
+// SubmitJobFromTemplate calls jennah.v1.DeploymentService.SubmitJobFromTemplate.
+func (c *deploymentServiceClient) SubmitJobFromTemplate(ctx context.Context, req *connect.Request[proto.SubmitJobFromTemplateRequest]) (*connect.Response[proto.SubmitJobFromTemplateResponse], error) {
+	return c.submitJobFromTemplate.CallUnary(ctx, req)
+}
+
+// GetJob calls jennah.v1.DeploymentService.GetJob.
+func (c *deploymentServiceClient) GetJob(ctx context.Context, req *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error) {
+	return c.getJob.CallUnary(ctx, req)
+}
+
+// GetJobLogs calls jennah.v1.DeploymentService.GetJobLogs.
+func (c *deploymentServiceClient) GetJobLogs(ctx context.Context, req *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error) {
+	return c.getJobLogs.CallUnary(ctx, req)
+}
+
+// ValidateJob calls jennah.v1.DeploymentService.ValidateJob.
+func (c *deploymentServiceClient) ValidateJob(ctx context.Context, req *connect.Request[proto.ValidateJobRequest]) (*connect.Response[proto.ValidateJobResponse], error) {
+	return c.validateJob.CallUnary(ctx, req)
+}
+
+// CreateNotificationSubscription calls jennah.v1.DeploymentService.CreateNotificationSubscription.
+func (c *deploymentServiceClient) CreateNotificationSubscription(ctx context.Context, req *connect.Request[proto.CreateNotificationSubscriptionRequest]) (*connect.Response[proto.CreateNotificationSubscriptionResponse], error) {
+	return c.createNotificationSubscription.CallUnary(ctx, req)
+}
+
+// ListNotificationSubscriptions calls jennah.v1.DeploymentService.ListNotificationSubscriptions.
+func (c *deploymentServiceClient) ListNotificationSubscriptions(ctx context.Context, req *connect.Request[proto.ListNotificationSubscriptionsRequest]) (*connect.Response[proto.ListNotificationSubscriptionsResponse], error) {
+	return c.listNotificationSubscriptions.CallUnary(ctx, req)
+}
+
+// DeleteNotificationSubscription calls jennah.v1.DeploymentService.DeleteNotificationSubscription.
+func (c *deploymentServiceClient) DeleteNotificationSubscription(ctx context.Context, req *connect.Request[proto.DeleteNotificationSubscriptionRequest]) (*connect.Response[proto.DeleteNotificationSubscriptionResponse], error) {
+	return c.deleteNotificationSubscription.CallUnary(ctx, req)
+}
+
+// ListNotificationDeliveries calls jennah.v1.DeploymentService.ListNotificationDeliveries.
+func (c *deploymentServiceClient) ListNotificationDeliveries(ctx context.Context, req *connect.Request[proto.ListNotificationDeliveriesRequest]) (*connect.Response[proto.ListNotificationDeliveriesResponse], error) {
+	return c.listNotificationDeliveries.CallUnary(ctx, req)
+}
+
+// GetUsage calls jennah.v1.DeploymentService.GetUsage.
+func (c *deploymentServiceClient) GetUsage(ctx context.Context, req *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// ListJobArtifacts calls jennah.v1.DeploymentService.ListJobArtifacts.
+func (c *deploymentServiceClient) ListJobArtifacts(ctx context.Context, req *connect.Request[proto.ListJobArtifactsRequest]) (*connect.Response[proto.ListJobArtifactsResponse], error) {
+	return c.listJobArtifacts.CallUnary(ctx, req)
+}
+
 // DeploymentServiceHandler is an implementation of the jennah.v1.DeploymentService service.
 type DeploymentServiceHandler interface {
 	// Submit a job for deployment.
@@ -116,6 +610,62 @@ type DeploymentServiceHandler interface {
 	ListJobs(context.Context, *connect.Request[proto.ListJobsRequest]) (*connect.Response[proto.ListJobsResponse], error)
 	// Get the current tenant's information.
 	GetCurrentTenant(context.Context, *connect.Request[proto.GetCurrentTenantRequest]) (*connect.Response[proto.GetCurrentTenantResponse], error)
+	// Create an API key for the current tenant. The key is only returned once.
+	CreateApiKey(context.Context, *connect.Request[proto.CreateApiKeyRequest]) (*connect.Response[proto.CreateApiKeyResponse], error)
+	// List the current tenant's API keys.
+	ListApiKeys(context.Context, *connect.Request[proto.ListApiKeysRequest]) (*connect.Response[proto.ListApiKeysResponse], error)
+	// Revoke one of the current tenant's API keys.
+	RevokeApiKey(context.Context, *connect.Request[proto.RevokeApiKeyRequest]) (*connect.Response[proto.RevokeApiKeyResponse], error)
+	// List the tenants the current user belongs to, with their role in each.
+	ListMyTenants(context.Context, *connect.Request[proto.ListMyTenantsRequest]) (*connect.Response[proto.ListMyTenantsResponse], error)
+	// List the members of the current tenant.
+	ListTenantMembers(context.Context, *connect.Request[proto.ListTenantMembersRequest]) (*connect.Response[proto.ListTenantMembersResponse], error)
+	// Invite a user, by email, to the current tenant.
+	InviteTenantMember(context.Context, *connect.Request[proto.InviteTenantMemberRequest]) (*connect.Response[proto.InviteTenantMemberResponse], error)
+	// List pending invitations addressed to the current user's email.
+	ListMyInvitations(context.Context, *connect.Request[proto.ListMyInvitationsRequest]) (*connect.Response[proto.ListMyInvitationsResponse], error)
+	// Accept an invitation addressed to the current user's email.
+	AcceptInvitation(context.Context, *connect.Request[proto.AcceptInvitationRequest]) (*connect.Response[proto.AcceptInvitationResponse], error)
+	// Change a member's role in the current tenant.
+	UpdateTenantMemberRole(context.Context, *connect.Request[proto.UpdateTenantMemberRoleRequest]) (*connect.Response[proto.UpdateTenantMemberRoleResponse], error)
+	// Remove a member from the current tenant.
+	RemoveTenantMember(context.Context, *connect.Request[proto.RemoveTenantMemberRequest]) (*connect.Response[proto.RemoveTenantMemberResponse], error)
+	// Cancel one of the current tenant's jobs, stopping it in GCP Batch.
+	CancelJob(context.Context, *connect.Request[proto.CancelJobRequest]) (*connect.Response[proto.CancelJobResponse], error)
+	// Link another OAuth identity to the current user's personal tenant.
+	LinkIdentity(context.Context, *connect.Request[proto.LinkIdentityRequest]) (*connect.Response[proto.LinkIdentityResponse], error)
+	// Unlink an OAuth identity from the current user's personal tenant.
+	UnlinkIdentity(context.Context, *connect.Request[proto.UnlinkIdentityRequest]) (*connect.Response[proto.UnlinkIdentityResponse], error)
+	// Create a job template, or a new revision of the current tenant's template with the same name.
+	CreateJobTemplate(context.Context, *connect.Request[proto.CreateJobTemplateRequest]) (*connect.Response[proto.CreateJobTemplateResponse], error)
+	// Get one revision of a job template, the latest by default.
+	GetJobTemplate(context.Context, *connect.Request[proto.GetJobTemplateRequest]) (*connect.Response[proto.GetJobTemplateResponse], error)
+	// List the current tenant's job templates.
+	ListJobTemplates(context.Context, *connect.Request[proto.ListJobTemplatesRequest]) (*connect.Response[proto.ListJobTemplatesResponse], error)
+	// Delete a job template and all its revisions.
+	DeleteJobTemplate(context.Context, *connect.Request[proto.DeleteJobTemplateRequest]) (*connect.Response[proto.DeleteJobTemplateResponse], error)
+	// Submit a job from a template revision, filling in its parameters.
+	SubmitJobFromTemplate(context.Context, *connect.Request[proto.SubmitJobFromTemplateRequest]) (*connect.Response[proto.SubmitJobFromTemplateResponse], error)
+	// Get one of the current tenant's jobs.
+	GetJob(context.Context, *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error)
+	// Read the log lines a job's tasks wrote to Cloud Logging, oldest first.
+	GetJobLogs(context.Context, *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error)
+	// Run every check SubmitJob runs, including quotas and region placement, without
+	// creating anything.
+	ValidateJob(context.Context, *connect.Request[proto.ValidateJobRequest]) (*connect.Response[proto.ValidateJobResponse], error)
+	// Subscribe a webhook to the current tenant's job status changes.
+	CreateNotificationSubscription(context.Context, *connect.Request[proto.CreateNotificationSubscriptionRequest]) (*connect.Response[proto.CreateNotificationSubscriptionResponse], error)
+	// List the current tenant's notification subscriptions.
+	ListNotificationSubscriptions(context.Context, *connect.Request[proto.ListNotificationSubscriptionsRequest]) (*connect.Response[proto.ListNotificationSubscriptionsResponse], error)
+	// Delete a notification subscription and its delivery history.
+	DeleteNotificationSubscription(context.Context, *connect.Request[proto.DeleteNotificationSubscriptionRequest]) (*connect.Response[proto.DeleteNotificationSubscriptionResponse], error)
+	// List notification deliveries and their attempts, newest first.
+	ListNotificationDeliveries(context.Context, *connect.Request[proto.ListNotificationDeliveriesRequest]) (*connect.Response[proto.ListNotificationDeliveriesResponse], error)
+	// Sum the resources and estimated cost of the current tenant's jobs that finished in a
+	// time range, optionally per value of a label.
+	GetUsage(context.Context, *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error)
+	// List the files a completed job wrote to its declared outputs.
+	ListJobArtifacts(context.Context, *connect.Request[proto.ListJobArtifactsRequest]) (*connect.Response[proto.ListJobArtifactsResponse], error)
 }
 
 // NewDeploymentServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -143,6 +693,168 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("GetCurrentTenant")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceCreateApiKeyHandler := connect.NewUnaryHandler(
+		DeploymentServiceCreateApiKeyProcedure,
+		svc.CreateApiKey,
+		connect.WithSchema(deploymentServiceMethods.ByName("CreateApiKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListApiKeysHandler := connect.NewUnaryHandler(
+		DeploymentServiceListApiKeysProcedure,
+		svc.ListApiKeys,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListApiKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceRevokeApiKeyHandler := connect.NewUnaryHandler(
+		DeploymentServiceRevokeApiKeyProcedure,
+		svc.RevokeApiKey,
+		connect.WithSchema(deploymentServiceMethods.ByName("RevokeApiKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListMyTenantsHandler := connect.NewUnaryHandler(
+		DeploymentServiceListMyTenantsProcedure,
+		svc.ListMyTenants,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListMyTenants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListTenantMembersHandler := connect.NewUnaryHandler(
+		DeploymentServiceListTenantMembersProcedure,
+		svc.ListTenantMembers,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListTenantMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceInviteTenantMemberHandler := connect.NewUnaryHandler(
+		DeploymentServiceInviteTenantMemberProcedure,
+		svc.InviteTenantMember,
+		connect.WithSchema(deploymentServiceMethods.ByName("InviteTenantMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListMyInvitationsHandler := connect.NewUnaryHandler(
+		DeploymentServiceListMyInvitationsProcedure,
+		svc.ListMyInvitations,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListMyInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceAcceptInvitationHandler := connect.NewUnaryHandler(
+		DeploymentServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(deploymentServiceMethods.ByName("AcceptInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceUpdateTenantMemberRoleHandler := connect.NewUnaryHandler(
+		DeploymentServiceUpdateTenantMemberRoleProcedure,
+		svc.UpdateTenantMemberRole,
+		connect.WithSchema(deploymentServiceMethods.ByName("UpdateTenantMemberRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceRemoveTenantMemberHandler := connect.NewUnaryHandler(
+		DeploymentServiceRemoveTenantMemberProcedure,
+		svc.RemoveTenantMember,
+		connect.WithSchema(deploymentServiceMethods.ByName("RemoveTenantMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceCancelJobHandler := connect.NewUnaryHandler(
+		DeploymentServiceCancelJobProcedure,
+		svc.CancelJob,
+		connect.WithSchema(deploymentServiceMethods.ByName("CancelJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceLinkIdentityHandler := connect.NewUnaryHandler(
+		DeploymentServiceLinkIdentityProcedure,
+		svc.LinkIdentity,
+		connect.WithSchema(deploymentServiceMethods.ByName("LinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceUnlinkIdentityHandler := connect.NewUnaryHandler(
+		DeploymentServiceUnlinkIdentityProcedure,
+		svc.UnlinkIdentity,
+		connect.WithSchema(deploymentServiceMethods.ByName("UnlinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceCreateJobTemplateHandler := connect.NewUnaryHandler(
+		DeploymentServiceCreateJobTemplateProcedure,
+		svc.CreateJobTemplate,
+		connect.WithSchema(deploymentServiceMethods.ByName("CreateJobTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetJobTemplateHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetJobTemplateProcedure,
+		svc.GetJobTemplate,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetJobTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListJobTemplatesHandler := connect.NewUnaryHandler(
+		DeploymentServiceListJobTemplatesProcedure,
+		svc.ListJobTemplates,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListJobTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceDeleteJobTemplateHandler := connect.NewUnaryHandler(
+		DeploymentServiceDeleteJobTemplateProcedure,
+		svc.DeleteJobTemplate,
+		connect.WithSchema(deploymentServiceMethods.ByName("DeleteJobTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceSubmitJobFromTemplateHandler := connect.NewUnaryHandler(
+		DeploymentServiceSubmitJobFromTemplateProcedure,
+		svc.SubmitJobFromTemplate,
+		connect.WithSchema(deploymentServiceMethods.ByName("SubmitJobFromTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetJobHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetJobProcedure,
+		svc.GetJob,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetJobLogsHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetJobLogsProcedure,
+		svc.GetJobLogs,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetJobLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceValidateJobHandler := connect.NewUnaryHandler(
+		DeploymentServiceValidateJobProcedure,
+		svc.ValidateJob,
+		connect.WithSchema(deploymentServiceMethods.ByName("ValidateJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceCreateNotificationSubscriptionHandler := connect.NewUnaryHandler(
+		DeploymentServiceCreateNotificationSubscriptionProcedure,
+		svc.CreateNotificationSubscription,
+		connect.WithSchema(deploymentServiceMethods.ByName("CreateNotificationSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListNotificationSubscriptionsHandler := connect.NewUnaryHandler(
+		DeploymentServiceListNotificationSubscriptionsProcedure,
+		svc.ListNotificationSubscriptions,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListNotificationSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceDeleteNotificationSubscriptionHandler := connect.NewUnaryHandler(
+		DeploymentServiceDeleteNotificationSubscriptionProcedure,
+		svc.DeleteNotificationSubscription,
+		connect.WithSchema(deploymentServiceMethods.ByName("DeleteNotificationSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListNotificationDeliveriesHandler := connect.NewUnaryHandler(
+		DeploymentServiceListNotificationDeliveriesProcedure,
+		svc.ListNotificationDeliveries,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListNotificationDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetUsageHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListJobArtifactsHandler := connect.NewUnaryHandler(
+		DeploymentServiceListJobArtifactsProcedure,
+		svc.ListJobArtifacts,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListJobArtifacts")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/jennah.v1.DeploymentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DeploymentServiceSubmitJobProcedure:
@@ -151,6 +863,60 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 			deploymentServiceListJobsHandler.ServeHTTP(w, r)
 		case DeploymentServiceGetCurrentTenantProcedure:
 			deploymentServiceGetCurrentTenantHandler.ServeHTTP(w, r)
+		case DeploymentServiceCreateApiKeyProcedure:
+			deploymentServiceCreateApiKeyHandler.ServeHTTP(w, r)
+		case DeploymentServiceListApiKeysProcedure:
+			deploymentServiceListApiKeysHandler.ServeHTTP(w, r)
+		case DeploymentServiceRevokeApiKeyProcedure:
+			deploymentServiceRevokeApiKeyHandler.ServeHTTP(w, r)
+		case DeploymentServiceListMyTenantsProcedure:
+			deploymentServiceListMyTenantsHandler.ServeHTTP(w, r)
+		case DeploymentServiceListTenantMembersProcedure:
+			deploymentServiceListTenantMembersHandler.ServeHTTP(w, r)
+		case DeploymentServiceInviteTenantMemberProcedure:
+			deploymentServiceInviteTenantMemberHandler.ServeHTTP(w, r)
+		case DeploymentServiceListMyInvitationsProcedure:
+			deploymentServiceListMyInvitationsHandler.ServeHTTP(w, r)
+		case DeploymentServiceAcceptInvitationProcedure:
+			deploymentServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case DeploymentServiceUpdateTenantMemberRoleProcedure:
+			deploymentServiceUpdateTenantMemberRoleHandler.ServeHTTP(w, r)
+		case DeploymentServiceRemoveTenantMemberProcedure:
+			deploymentServiceRemoveTenantMemberHandler.ServeHTTP(w, r)
+		case DeploymentServiceCancelJobProcedure:
+			deploymentServiceCancelJobHandler.ServeHTTP(w, r)
+		case DeploymentServiceLinkIdentityProcedure:
+			deploymentServiceLinkIdentityHandler.ServeHTTP(w, r)
+		case DeploymentServiceUnlinkIdentityProcedure:
+			deploymentServiceUnlinkIdentityHandler.ServeHTTP(w, r)
+		case DeploymentServiceCreateJobTemplateProcedure:
+			deploymentServiceCreateJobTemplateHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetJobTemplateProcedure:
+			deploymentServiceGetJobTemplateHandler.ServeHTTP(w, r)
+		case DeploymentServiceListJobTemplatesProcedure:
+			deploymentServiceListJobTemplatesHandler.ServeHTTP(w, r)
+		case DeploymentServiceDeleteJobTemplateProcedure:
+			deploymentServiceDeleteJobTemplateHandler.ServeHTTP(w, r)
+		case DeploymentServiceSubmitJobFromTemplateProcedure:
+			deploymentServiceSubmitJobFromTemplateHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetJobProcedure:
+			deploymentServiceGetJobHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetJobLogsProcedure:
+			deploymentServiceGetJobLogsHandler.ServeHTTP(w, r)
+		case DeploymentServiceValidateJobProcedure:
+			deploymentServiceValidateJobHandler.ServeHTTP(w, r)
+		case DeploymentServiceCreateNotificationSubscriptionProcedure:
+			deploymentServiceCreateNotificationSubscriptionHandler.ServeHTTP(w, r)
+		case DeploymentServiceListNotificationSubscriptionsProcedure:
+			deploymentServiceListNotificationSubscriptionsHandler.ServeHTTP(w, r)
+		case DeploymentServiceDeleteNotificationSubscriptionProcedure:
+			deploymentServiceDeleteNotificationSubscriptionHandler.ServeHTTP(w, r)
+		case DeploymentServiceListNotificationDeliveriesProcedure:
+			deploymentServiceListNotificationDeliveriesHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetUsageProcedure:
+			deploymentServiceGetUsageHandler.ServeHTTP(w, r)
+		case DeploymentServiceListJobArtifactsProcedure:
+			deploymentServiceListJobArtifactsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +937,465 @@ func (UnimplementedDeploymentServiceHandler) ListJobs(context.Context, *connect.
 func (UnimplementedDeploymentServiceHandler) GetCurrentTenant(context.Context, *connect.Request[proto.GetCurrentTenantRequest]) (*connect.Response[proto.GetCurrentTenantResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetCurrentTenant is not implemented"))
 }
+
+func (UnimplementedDeploymentServiceHandler) CreateApiKey(context.Context, *connect.Request[proto.CreateApiKeyRequest]) (*connect.Response[proto.CreateApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.CreateApiKey is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListApiKeys(context.Context, *connect.Request[proto.ListApiKeysRequest]) (*connect.Response[proto.ListApiKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListApiKeys is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) RevokeApiKey(context.Context, *connect.Request[proto.RevokeApiKeyRequest]) (*connect.Response[proto.RevokeApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.RevokeApiKey is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListMyTenants(context.Context, *connect.Request[proto.ListMyTenantsRequest]) (*connect.Response[proto.ListMyTenantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListMyTenants is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListTenantMembers(context.Context, *connect.Request[proto.ListTenantMembersRequest]) (*connect.Response[proto.ListTenantMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListTenantMembers is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) InviteTenantMember(context.Context, *connect.Request[proto.InviteTenantMemberRequest]) (*connect.Response[proto.InviteTenantMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.InviteTenantMember is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListMyInvitations(context.Context, *connect.Request[proto.ListMyInvitationsRequest]) (*connect.Response[proto.ListMyInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListMyInvitations is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) AcceptInvitation(context.Context, *connect.Request[proto.AcceptInvitationRequest]) (*connect.Response[proto.AcceptInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) UpdateTenantMemberRole(context.Context, *connect.Request[proto.UpdateTenantMemberRoleRequest]) (*connect.Response[proto.UpdateTenantMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.UpdateTenantMemberRole is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) RemoveTenantMember(context.Context, *connect.Request[proto.RemoveTenantMemberRequest]) (*connect.Response[proto.RemoveTenantMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.RemoveTenantMember is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) CancelJob(context.Context, *connect.Request[proto.CancelJobRequest]) (*connect.Response[proto.CancelJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.CancelJob is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) LinkIdentity(context.Context, *connect.Request[proto.LinkIdentityRequest]) (*connect.Response[proto.LinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.LinkIdentity is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) UnlinkIdentity(context.Context, *connect.Request[proto.UnlinkIdentityRequest]) (*connect.Response[proto.UnlinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.UnlinkIdentity is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) CreateJobTemplate(context.Context, *connect.Request[proto.CreateJobTemplateRequest]) (*connect.Response[proto.CreateJobTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.CreateJobTemplate is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetJobTemplate(context.Context, *connect.Request[proto.GetJobTemplateRequest]) (*connect.Response[proto.GetJobTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetJobTemplate is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListJobTemplates(context.Context, *connect.Request[proto.ListJobTemplatesRequest]) (*connect.Response[proto.ListJobTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListJobTemplates is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) DeleteJobTemplate(context.Context, *connect.Request[proto.DeleteJobTemplateRequest]) (*connect.Response[proto.DeleteJobTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.DeleteJobTemplate is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) SubmitJobFromTemplate(context.Context, *connect.Request[proto.SubmitJobFromTemplateRequest]) (*connect.Response[proto.SubmitJobFromTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.SubmitJobFromTemplate is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetJob(context.Context, *connect.Request[proto.GetJobRequest]) (*connect.Response[proto.GetJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetJob is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetJobLogs(context.Context, *connect.Request[proto.GetJobLogsRequest]) (*connect.Response[proto.GetJobLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetJobLogs is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ValidateJob(context.Context, *connect.Request[proto.ValidateJobRequest]) (*connect.Response[proto.ValidateJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ValidateJob is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) CreateNotificationSubscription(context.Context, *connect.Request[proto.CreateNotificationSubscriptionRequest]) (*connect.Response[proto.CreateNotificationSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.CreateNotificationSubscription is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListNotificationSubscriptions(context.Context, *connect.Request[proto.ListNotificationSubscriptionsRequest]) (*connect.Response[proto.ListNotificationSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListNotificationSubscriptions is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) DeleteNotificationSubscription(context.Context, *connect.Request[proto.DeleteNotificationSubscriptionRequest]) (*connect.Response[proto.DeleteNotificationSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.DeleteNotificationSubscription is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListNotificationDeliveries(context.Context, *connect.Request[proto.ListNotificationDeliveriesRequest]) (*connect.Response[proto.ListNotificationDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListNotificationDeliveries is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetUsage(context.Context, *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.GetUsage is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListJobArtifacts(context.Context, *connect.Request[proto.ListJobArtifactsRequest]) (*connect.Response[proto.ListJobArtifactsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.DeploymentService.ListJobArtifacts is not implemented"))
+}
+
+// AdminServiceClient is a client for the jennah.v1.AdminService service.
+type AdminServiceClient interface {
+	// Get a tenant's quota and its current usage.
+	GetTenantQuota(context.Context, *connect.Request[proto.GetTenantQuotaRequest]) (*connect.Response[proto.GetTenantQuotaResponse], error)
+	// Create or replace a tenant's quota.
+	UpdateTenantQuota(context.Context, *connect.Request[proto.UpdateTenantQuotaRequest]) (*connect.Response[proto.UpdateTenantQuotaResponse], error)
+	// List all tenants.
+	ListTenants(context.Context, *connect.Request[proto.ListTenantsRequest]) (*connect.Response[proto.ListTenantsResponse], error)
+	// Get a single tenant.
+	GetTenant(context.Context, *connect.Request[proto.GetTenantRequest]) (*connect.Response[proto.GetTenantResponse], error)
+	// Suspend a tenant. Requests acting on a suspended tenant are rejected.
+	SuspendTenant(context.Context, *connect.Request[proto.SuspendTenantRequest]) (*connect.Response[proto.SuspendTenantResponse], error)
+	// Lift a tenant's suspension.
+	ResumeTenant(context.Context, *connect.Request[proto.ResumeTenantRequest]) (*connect.Response[proto.ResumeTenantResponse], error)
+	// Update a tenant's display name and contact email.
+	UpdateTenant(context.Context, *connect.Request[proto.UpdateTenantRequest]) (*connect.Response[proto.UpdateTenantResponse], error)
+	// Cancel a tenant's live jobs and wait for their events and notifications to be
+	// sent, then delete the tenant and all its data.
+	DeleteTenant(context.Context, *connect.Request[proto.DeleteTenantRequest]) (*connect.Response[proto.DeleteTenantResponse], error)
+	// Sum the resources and estimated cost of jobs that finished in a time range, per tenant.
+	GetUsage(context.Context, *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error)
+	// Get a tenant's job policy and the gateway's global policy.
+	GetTenantPolicy(context.Context, *connect.Request[proto.GetTenantPolicyRequest]) (*connect.Response[proto.GetTenantPolicyResponse], error)
+	// Create or replace a tenant's job policy.
+	UpdateTenantPolicy(context.Context, *connect.Request[proto.UpdateTenantPolicyRequest]) (*connect.Response[proto.UpdateTenantPolicyResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the jennah.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := proto.File_proto_jennah_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		getTenantQuota: connect.NewClient[proto.GetTenantQuotaRequest, proto.GetTenantQuotaResponse](
+			httpClient,
+			baseURL+AdminServiceGetTenantQuotaProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetTenantQuota")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTenantQuota: connect.NewClient[proto.UpdateTenantQuotaRequest, proto.UpdateTenantQuotaResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateTenantQuotaProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateTenantQuota")),
+			connect.WithClientOptions(opts...),
+		),
+		listTenants: connect.NewClient[proto.ListTenantsRequest, proto.ListTenantsResponse](
+			httpClient,
+			baseURL+AdminServiceListTenantsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListTenants")),
+			connect.WithClientOptions(opts...),
+		),
+		getTenant: connect.NewClient[proto.GetTenantRequest, proto.GetTenantResponse](
+			httpClient,
+			baseURL+AdminServiceGetTenantProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendTenant: connect.NewClient[proto.SuspendTenantRequest, proto.SuspendTenantResponse](
+			httpClient,
+			baseURL+AdminServiceSuspendTenantProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SuspendTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeTenant: connect.NewClient[proto.ResumeTenantRequest, proto.ResumeTenantResponse](
+			httpClient,
+			baseURL+AdminServiceResumeTenantProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResumeTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTenant: connect.NewClient[proto.UpdateTenantRequest, proto.UpdateTenantResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateTenantProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTenant: connect.NewClient[proto.DeleteTenantRequest, proto.DeleteTenantResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteTenantProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[proto.GetUsageRequest, proto.GetUsageResponse](
+			httpClient,
+			baseURL+AdminServiceGetUsageProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		getTenantPolicy: connect.NewClient[proto.GetTenantPolicyRequest, proto.GetTenantPolicyResponse](
+			httpClient,
+			baseURL+AdminServiceGetTenantPolicyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetTenantPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTenantPolicy: connect.NewClient[proto.UpdateTenantPolicyRequest, proto.UpdateTenantPolicyResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateTenantPolicyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateTenantPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	getTenantQuota     *connect.Client[proto.GetTenantQuotaRequest, proto.GetTenantQuotaResponse]
+	updateTenantQuota  *connect.Client[proto.UpdateTenantQuotaRequest, proto.UpdateTenantQuotaResponse]
+	listTenants        *connect.Client[proto.ListTenantsRequest, proto.ListTenantsResponse]
+	getTenant          *connect.Client[proto.GetTenantRequest, proto.GetTenantResponse]
+	suspendTenant      *connect.Client[proto.SuspendTenantRequest, proto.SuspendTenantResponse]
+	resumeTenant       *connect.Client[proto.ResumeTenantRequest, proto.ResumeTenantResponse]
+	updateTenant       *connect.Client[proto.UpdateTenantRequest, proto.UpdateTenantResponse]
+	deleteTenant       *connect.Client[proto.DeleteTenantRequest, proto.DeleteTenantResponse]
+	getUsage           *connect.Client[proto.GetUsageRequest, proto.GetUsageResponse]
+	getTenantPolicy    *connect.Client[proto.GetTenantPolicyRequest, proto.GetTenantPolicyResponse]
+	updateTenantPolicy *connect.Client[proto.UpdateTenantPolicyRequest, proto.UpdateTenantPolicyResponse]
+}
+
+// GetTenantQuota calls jennah.v1.AdminService.GetTenantQuota.
+func (c *adminServiceClient) GetTenantQuota(ctx context.Context, req *connect.Request[proto.GetTenantQuotaRequest]) (*connect.Response[proto.GetTenantQuotaResponse], error) {
+	return c.getTenantQuota.CallUnary(ctx, req)
+}
+
+// UpdateTenantQuota calls jennah.v1.AdminService.UpdateTenantQuota.
+func (c *adminServiceClient) UpdateTenantQuota(ctx context.Context, req *connect.Request[proto.UpdateTenantQuotaRequest]) (*connect.Response[proto.UpdateTenantQuotaResponse], error) {
+	return c.updateTenantQuota.CallUnary(ctx, req)
+}
+
+// ListTenants calls jennah.v1.AdminService.ListTenants.
+func (c *adminServiceClient) ListTenants(ctx context.Context, req *connect.Request[proto.ListTenantsRequest]) (*connect.Response[proto.ListTenantsResponse], error) {
+	return c.listTenants.CallUnary(ctx, req)
+}
+
+// GetTenant calls jennah.v1.AdminService.GetTenant.
+func (c *adminServiceClient) GetTenant(ctx context.Context, req *connect.Request[proto.GetTenantRequest]) (*connect.Response[proto.GetTenantResponse], error) {
+	return c.getTenant.CallUnary(ctx, req)
+}
+
+// SuspendTenant calls jennah.v1.AdminService.SuspendTenant.
+func (c *adminServiceClient) SuspendTenant(ctx context.Context, req *connect.Request[proto.SuspendTenantRequest]) (*connect.Response[proto.SuspendTenantResponse], error) {
+	return c.suspendTenant.CallUnary(ctx, req)
+}
+
+// ResumeTenant calls jennah.v1.AdminService.ResumeTenant.
+func (c *adminServiceClient) ResumeTenant(ctx context.Context, req *connect.Request[proto.ResumeTenantRequest]) (*connect.Response[proto.ResumeTenantResponse], error) {
+	return c.resumeTenant.CallUnary(ctx, req)
+}
+
+// UpdateTenant calls jennah.v1.AdminService.UpdateTenant.
+func (c *adminServiceClient) UpdateTenant(ctx context.Context, req *connect.Request[proto.UpdateTenantRequest]) (*connect.Response[proto.UpdateTenantResponse], error) {
+	return c.updateTenant.CallUnary(ctx, req)
+}
+
+// DeleteTenant calls jennah.v1.AdminService.DeleteTenant.
+func (c *adminServiceClient) DeleteTenant(ctx context.Context, req *connect.Request[proto.DeleteTenantRequest]) (*connect.Response[proto.DeleteTenantResponse], error) {
+	return c.deleteTenant.CallUnary(ctx, req)
+}
+
+// GetUsage calls jennah.v1.AdminService.GetUsage.
+func (c *adminServiceClient) GetUsage(ctx context.Context, req *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// GetTenantPolicy calls jennah.v1.AdminService.GetTenantPolicy.
+func (c *adminServiceClient) GetTenantPolicy(ctx context.Context, req *connect.Request[proto.GetTenantPolicyRequest]) (*connect.Response[proto.GetTenantPolicyResponse], error) {
+	return c.getTenantPolicy.CallUnary(ctx, req)
+}
+
+// UpdateTenantPolicy calls jennah.v1.AdminService.UpdateTenantPolicy.
+func (c *adminServiceClient) UpdateTenantPolicy(ctx context.Context, req *connect.Request[proto.UpdateTenantPolicyRequest]) (*connect.Response[proto.UpdateTenantPolicyResponse], error) {
+	return c.updateTenantPolicy.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the jennah.v1.AdminService service.
+type AdminServiceHandler interface {
+	// Get a tenant's quota and its current usage.
+	GetTenantQuota(context.Context, *connect.Request[proto.GetTenantQuotaRequest]) (*connect.Response[proto.GetTenantQuotaResponse], error)
+	// Create or replace a tenant's quota.
+	UpdateTenantQuota(context.Context, *connect.Request[proto.UpdateTenantQuotaRequest]) (*connect.Response[proto.UpdateTenantQuotaResponse], error)
+	// List all tenants.
+	ListTenants(context.Context, *connect.Request[proto.ListTenantsRequest]) (*connect.Response[proto.ListTenantsResponse], error)
+	// Get a single tenant.
+	GetTenant(context.Context, *connect.Request[proto.GetTenantRequest]) (*connect.Response[proto.GetTenantResponse], error)
+	// Suspend a tenant. Requests acting on a suspended tenant are rejected.
+	SuspendTenant(context.Context, *connect.Request[proto.SuspendTenantRequest]) (*connect.Response[proto.SuspendTenantResponse], error)
+	// Lift a tenant's suspension.
+	ResumeTenant(context.Context, *connect.Request[proto.ResumeTenantRequest]) (*connect.Response[proto.ResumeTenantResponse], error)
+	// Update a tenant's display name and contact email.
+	UpdateTenant(context.Context, *connect.Request[proto.UpdateTenantRequest]) (*connect.Response[proto.UpdateTenantResponse], error)
+	// Cancel a tenant's live jobs and wait for their events and notifications to be
+	// sent, then delete the tenant and all its data.
+	DeleteTenant(context.Context, *connect.Request[proto.DeleteTenantRequest]) (*connect.Response[proto.DeleteTenantResponse], error)
+	// Sum the resources and estimated cost of jobs that finished in a time range, per tenant.
+	GetUsage(context.Context, *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error)
+	// Get a tenant's job policy and the gateway's global policy.
+	GetTenantPolicy(context.Context, *connect.Request[proto.GetTenantPolicyRequest]) (*connect.Response[proto.GetTenantPolicyResponse], error)
+	// Create or replace a tenant's job policy.
+	UpdateTenantPolicy(context.Context, *connect.Request[proto.UpdateTenantPolicyRequest]) (*connect.Response[proto.UpdateTenantPolicyResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := proto.File_proto_jennah_proto.Services().ByName("AdminService").Methods()
+	adminServiceGetTenantQuotaHandler := connect.NewUnaryHandler(
+		AdminServiceGetTenantQuotaProcedure,
+		svc.GetTenantQuota,
+		connect.WithSchema(adminServiceMethods.ByName("GetTenantQuota")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateTenantQuotaHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateTenantQuotaProcedure,
+		svc.UpdateTenantQuota,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateTenantQuota")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListTenantsHandler := connect.NewUnaryHandler(
+		AdminServiceListTenantsProcedure,
+		svc.ListTenants,
+		connect.WithSchema(adminServiceMethods.ByName("ListTenants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetTenantHandler := connect.NewUnaryHandler(
+		AdminServiceGetTenantProcedure,
+		svc.GetTenant,
+		connect.WithSchema(adminServiceMethods.ByName("GetTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSuspendTenantHandler := connect.NewUnaryHandler(
+		AdminServiceSuspendTenantProcedure,
+		svc.SuspendTenant,
+		connect.WithSchema(adminServiceMethods.ByName("SuspendTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResumeTenantHandler := connect.NewUnaryHandler(
+		AdminServiceResumeTenantProcedure,
+		svc.ResumeTenant,
+		connect.WithSchema(adminServiceMethods.ByName("ResumeTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateTenantHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateTenantProcedure,
+		svc.UpdateTenant,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteTenantHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteTenantProcedure,
+		svc.DeleteTenant,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetUsageHandler := connect.NewUnaryHandler(
+		AdminServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(adminServiceMethods.ByName("GetUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetTenantPolicyHandler := connect.NewUnaryHandler(
+		AdminServiceGetTenantPolicyProcedure,
+		svc.GetTenantPolicy,
+		connect.WithSchema(adminServiceMethods.ByName("GetTenantPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateTenantPolicyHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateTenantPolicyProcedure,
+		svc.UpdateTenantPolicy,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateTenantPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/jennah.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceGetTenantQuotaProcedure:
+			adminServiceGetTenantQuotaHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateTenantQuotaProcedure:
+			adminServiceUpdateTenantQuotaHandler.ServeHTTP(w, r)
+		case AdminServiceListTenantsProcedure:
+			adminServiceListTenantsHandler.ServeHTTP(w, r)
+		case AdminServiceGetTenantProcedure:
+			adminServiceGetTenantHandler.ServeHTTP(w, r)
+		case AdminServiceSuspendTenantProcedure:
+			adminServiceSuspendTenantHandler.ServeHTTP(w, r)
+		case AdminServiceResumeTenantProcedure:
+			adminServiceResumeTenantHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateTenantProcedure:
+			adminServiceUpdateTenantHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteTenantProcedure:
+			adminServiceDeleteTenantHandler.ServeHTTP(w, r)
+		case AdminServiceGetUsageProcedure:
+			adminServiceGetUsageHandler.ServeHTTP(w, r)
+		case AdminServiceGetTenantPolicyProcedure:
+			adminServiceGetTenantPolicyHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateTenantPolicyProcedure:
+			adminServiceUpdateTenantPolicyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) GetTenantQuota(context.Context, *connect.Request[proto.GetTenantQuotaRequest]) (*connect.Response[proto.GetTenantQuotaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.GetTenantQuota is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateTenantQuota(context.Context, *connect.Request[proto.UpdateTenantQuotaRequest]) (*connect.Response[proto.UpdateTenantQuotaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.UpdateTenantQuota is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListTenants(context.Context, *connect.Request[proto.ListTenantsRequest]) (*connect.Response[proto.ListTenantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.ListTenants is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetTenant(context.Context, *connect.Request[proto.GetTenantRequest]) (*connect.Response[proto.GetTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.GetTenant is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SuspendTenant(context.Context, *connect.Request[proto.SuspendTenantRequest]) (*connect.Response[proto.SuspendTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.SuspendTenant is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResumeTenant(context.Context, *connect.Request[proto.ResumeTenantRequest]) (*connect.Response[proto.ResumeTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.ResumeTenant is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateTenant(context.Context, *connect.Request[proto.UpdateTenantRequest]) (*connect.Response[proto.UpdateTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.UpdateTenant is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteTenant(context.Context, *connect.Request[proto.DeleteTenantRequest]) (*connect.Response[proto.DeleteTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.DeleteTenant is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetUsage(context.Context, *connect.Request[proto.GetUsageRequest]) (*connect.Response[proto.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.GetUsage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetTenantPolicy(context.Context, *connect.Request[proto.GetTenantPolicyRequest]) (*connect.Response[proto.GetTenantPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.GetTenantPolicy is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateTenantPolicy(context.Context, *connect.Request[proto.UpdateTenantPolicyRequest]) (*connect.Response[proto.UpdateTenantPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jennah.v1.AdminService.UpdateTenantPolicy is not implemented"))
+}
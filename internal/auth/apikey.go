@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// APIKeyPrefix marks bearer tokens that are Jennah API keys rather than JWTs
+const APIKeyPrefix = "jennah_"
+
+// apiKeyDisplayLength is how much of a key is kept in clear for identification
+const apiKeyDisplayLength = 12
+
+// GenerateAPIKey returns a new random API key, its hash for storage and a short
+// display prefix. Only the hash and prefix may be persisted.
+func GenerateAPIKey() (key, hash, prefix string, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return key, HashAPIKey(key), key[:apiKeyDisplayLength], nil
+}
+
+// HashAPIKey returns the hex SHA-256 of an API key. Keys carry 256 bits of
+// entropy, so a fast unsalted hash is sufficient and allows lookup by hash.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey reports whether a bearer token looks like an API key
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	// jwksTTL is how long a fetched key set is trusted before it is refreshed
+	jwksTTL = time.Hour
+	// jwksMinRefresh limits refetches triggered by tokens signed with an unknown key
+	jwksMinRefresh = time.Minute
+	// clockLeeway tolerates small clock differences when checking exp/nbf/iat
+	clockLeeway = time.Minute
+)
+
+// Signature algorithms accepted on ID tokens and access tokens.
+var supportedAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.EdDSA,
+}
+
+// Issuer is a trusted OIDC issuer.
+type Issuer struct {
+	Provider string // Name stored as the tenant's OAuth provider (e.g. "google")
+	URL      string // Issuer URL, matched exactly against the "iss" claim
+}
+
+// Identity is the user described by a verified token.
+type Identity struct {
+	Provider string
+	Subject  string
+	Email    string
+	// EmailVerified is set when the token's email_verified claim is true.
+	// Tokens without the claim are accepted, but their email is unverified.
+	EmailVerified bool
+}
+
+// Verifier validates bearer JWTs against a set of trusted OIDC issuers.
+// Signing keys are discovered through each issuer's openid-configuration and
+// cached; a token signed with an unknown key triggers a refetch so that key
+// rotation is picked up without a restart.
+type Verifier struct {
+	issuers    map[string]*issuerKeys
+	audiences  jwt.Audience
+	httpClient *http.Client
+}
+
+type issuerKeys struct {
+	Issuer
+	mu          sync.Mutex
+	jwksURI     string
+	keys        *jose.JSONWebKeySet
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+// NewVerifier creates a verifier for the given issuers. Tokens must carry at
+// least one of the given audiences in their "aud" claim.
+func NewVerifier(issuers []Issuer, audiences []string) (*Verifier, error) {
+	if len(issuers) == 0 {
+		return nil, errors.New("at least one issuer is required")
+	}
+	if len(audiences) == 0 {
+		return nil, errors.New("at least one audience is required")
+	}
+
+	v := &Verifier{
+		issuers:    make(map[string]*issuerKeys, len(issuers)),
+		audiences:  jwt.Audience(audiences),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+	for _, iss := range issuers {
+		if iss.Provider == "" || iss.URL == "" {
+			return nil, fmt.Errorf("issuer %q: provider and URL are required", iss.URL)
+		}
+		v.issuers[iss.URL] = &issuerKeys{Issuer: iss}
+	}
+	return v, nil
+}
+
+// ParseIssuer parses a "provider=issuer-url" flag value.
+func ParseIssuer(s string) (Issuer, error) {
+	provider, url, ok := strings.Cut(s, "=")
+	if !ok || provider == "" || url == "" {
+		return Issuer{}, fmt.Errorf("invalid issuer %q, expected provider=url", s)
+	}
+	return Issuer{Provider: provider, URL: url}, nil
+}
+
+type oidcClaims struct {
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified"`
+}
+
+// Verify checks the token's signature, issuer, audience and expiry and returns
+// the identity it describes.
+func (v *Verifier) Verify(ctx context.Context, rawToken string) (*Identity, error) {
+	token, err := jwt.ParseSigned(rawToken, supportedAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("malformed token: %w", err)
+	}
+
+	// Read the issuer before verification to pick the key set; it is
+	// validated again against the verified claims below.
+	var unverified jwt.Claims
+	if err := token.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return nil, fmt.Errorf("malformed claims: %w", err)
+	}
+	iss, ok := v.issuers[unverified.Issuer]
+	if !ok {
+		return nil, fmt.Errorf("untrusted issuer %q", unverified.Issuer)
+	}
+
+	if len(token.Headers) != 1 {
+		return nil, errors.New("token must have exactly one signature")
+	}
+	key, err := iss.key(ctx, v.httpClient, token.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims jwt.Claims
+	var extra oidcClaims
+	if err := token.Claims(key, &claims, &extra); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if claims.Expiry == nil {
+		return nil, errors.New("token has no expiry")
+	}
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      iss.URL,
+		AnyAudience: v.audiences,
+		Time:        time.Now(),
+	}, clockLeeway)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claims: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	if extra.Email == "" {
+		return nil, errors.New("token has no email claim")
+	}
+	if extra.EmailVerified != nil && !*extra.EmailVerified {
+		return nil, errors.New("token email is not verified")
+	}
+
+	return &Identity{
+		Provider:      iss.Provider,
+		Subject:       claims.Subject,
+		Email:         extra.Email,
+		EmailVerified: extra.EmailVerified != nil && *extra.EmailVerified,
+	}, nil
+}
+
+// key returns the public key with the given ID, refreshing the cached key set
+// when it has expired or does not contain the key.
+func (k *issuerKeys) key(ctx context.Context, client *http.Client, kid string) (*jose.JSONWebKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.keys == nil || time.Since(k.fetchedAt) > jwksTTL {
+		if err := k.refresh(ctx, client); err != nil && k.keys == nil {
+			return nil, err
+		}
+	}
+	if key := k.lookup(kid); key != nil {
+		return key, nil
+	}
+
+	// Unknown key: the issuer may have rotated. Refetch, but not on every
+	// request carrying a bogus kid.
+	if time.Since(k.lastAttempt) >= jwksMinRefresh {
+		if err := k.refresh(ctx, client); err != nil {
+			return nil, err
+		}
+		if key := k.lookup(kid); key != nil {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q for issuer %s", kid, k.URL)
+}
+
+func (k *issuerKeys) lookup(kid string) *jose.JSONWebKey {
+	if k.keys == nil {
+		return nil
+	}
+	for _, key := range k.keys.Keys {
+		if (kid == "" || key.KeyID == kid) && key.Use != "enc" {
+			return &key
+		}
+	}
+	return nil
+}
+
+func (k *issuerKeys) refresh(ctx context.Context, client *http.Client) error {
+	k.lastAttempt = time.Now()
+
+	if k.jwksURI == "" {
+		var discovery struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		wellKnown := strings.TrimSuffix(k.URL, "/") + "/.well-known/openid-configuration"
+		if err := getJSON(ctx, client, wellKnown, &discovery); err != nil {
+			return fmt.Errorf("failed to discover issuer %s: %w", k.URL, err)
+		}
+		if discovery.Issuer != k.URL {
+			return fmt.Errorf("issuer %s advertises mismatched issuer %q", k.URL, discovery.Issuer)
+		}
+		if discovery.JWKSURI == "" {
+			return fmt.Errorf("issuer %s has no jwks_uri", k.URL)
+		}
+		k.jwksURI = discovery.JWKSURI
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := getJSON(ctx, client, k.jwksURI, &keys); err != nil {
+		return fmt.Errorf("failed to fetch keys for issuer %s: %w", k.URL, err)
+	}
+	k.keys = &keys
+	k.fetchedAt = time.Now()
+	return nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(dest)
+}
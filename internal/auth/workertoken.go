@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/uuid"
+)
+
+const (
+	workerTokenIssuer   = "jennah-gateway"
+	workerTokenAudience = "jennah-worker"
+	// WorkerTokenTTL bounds how long a signed gateway-to-worker request stays valid
+	WorkerTokenTTL = time.Minute
+)
+
+// TokenSigner issues short-lived tokens that authorize a single gateway-to-worker
+// call on behalf of a tenant. Tokens are Ed25519-signed JWTs.
+type TokenSigner struct {
+	signer jose.Signer
+}
+
+// NewTokenSignerFromFile loads a PEM-encoded PKCS#8 Ed25519 private key.
+func NewTokenSignerFromFile(path string) (*TokenSigner, error) {
+	key, err := readPEM(path, "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key %s: %w", path, err)
+	}
+	privateKey, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key %s is not an Ed25519 key", path)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.EdDSA, Key: privateKey}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: %w", err)
+	}
+	return &TokenSigner{signer: signer}, nil
+}
+
+// Sign returns a token asserting that the gateway authenticated a caller for tenantID.
+func (s *TokenSigner) Sign(tenantID string) (string, error) {
+	now := time.Now()
+	return jwt.Signed(s.signer).Claims(jwt.Claims{
+		Issuer:   workerTokenIssuer,
+		Audience: jwt.Audience{workerTokenAudience},
+		Subject:  tenantID,
+		ID:       uuid.New().String(),
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(WorkerTokenTTL)),
+	}).Serialize()
+}
+
+// TokenVerifier validates tokens issued by a TokenSigner.
+type TokenVerifier struct {
+	keys []ed25519.PublicKey
+}
+
+// NewTokenVerifierFromFiles loads one or more PEM-encoded PKIX Ed25519 public keys.
+// Accepting several keys allows the gateway's signing key to be rotated without downtime.
+func NewTokenVerifierFromFiles(paths ...string) (*TokenVerifier, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("at least one public key is required")
+	}
+	v := &TokenVerifier{}
+	for _, path := range paths {
+		key, err := readPEM(path, "PUBLIC KEY")
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := x509.ParsePKIXPublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key %s: %w", path, err)
+		}
+		publicKey, ok := parsed.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key %s is not an Ed25519 key", path)
+		}
+		v.keys = append(v.keys, publicKey)
+	}
+	return v, nil
+}
+
+// Verify checks the token's signature and expiry and returns the tenant ID it carries.
+func (v *TokenVerifier) Verify(rawToken string) (string, error) {
+	token, err := jwt.ParseSigned(rawToken, []jose.SignatureAlgorithm{jose.EdDSA})
+	if err != nil {
+		return "", fmt.Errorf("malformed token: %w", err)
+	}
+
+	var claims jwt.Claims
+	var verified bool
+	for _, key := range v.keys {
+		if err := token.Claims(crypto.PublicKey(key), &claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return "", errors.New("invalid token signature")
+	}
+
+	if claims.Expiry == nil || claims.IssuedAt == nil {
+		return "", errors.New("token has no expiry")
+	}
+	// Reject tokens whose lifetime exceeds what the gateway issues
+	if claims.Expiry.Time().Sub(claims.IssuedAt.Time()) > WorkerTokenTTL {
+		return "", errors.New("token lifetime too long")
+	}
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      workerTokenIssuer,
+		AnyAudience: jwt.Audience{workerTokenAudience},
+		Time:        time.Now(),
+	}, 5*time.Second)
+	if err != nil {
+		return "", fmt.Errorf("invalid token: %w", err)
+	}
+	if claims.Subject == "" {
+		return "", errors.New("token has no tenant")
+	}
+
+	return claims.Subject, nil
+}
+
+func readPEM(path, blockType string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != blockType {
+		return nil, fmt.Errorf("%s does not contain a PEM %q block", path, blockType)
+	}
+	return block.Bytes, nil
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+var apiKeyColumns = []string{"TenantId", "KeyId", "Name", "KeyHash", "Prefix", "CreatedBy", "CreatedAt", "ExpiresAt", "LastUsedAt", "RevokedAt"}
+
+// InsertApiKey stores a new API key. Only the key's hash is stored.
+func (c *Client) InsertApiKey(ctx context.Context, key *ApiKey) error {
+	ctx, end := instrument(ctx, "InsertApiKey")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Insert("ApiKeys",
+			[]string{"TenantId", "KeyId", "Name", "KeyHash", "Prefix", "CreatedBy", "CreatedAt", "ExpiresAt"},
+			[]interface{}{key.TenantId, key.KeyId, key.Name, key.KeyHash, key.Prefix, key.CreatedBy, spanner.CommitTimestamp, key.ExpiresAt},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert API key: %w", err)
+	}
+	return nil
+}
+
+// GetApiKey retrieves an API key by tenant ID and key ID
+func (c *Client) GetApiKey(ctx context.Context, tenantID, keyID string) (*ApiKey, error) {
+	ctx, end := instrument(ctx, "GetApiKey")
+	defer end()
+	row, err := c.client.Single().ReadRow(ctx, "ApiKeys", spanner.Key{tenantID, keyID}, apiKeyColumns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	var key ApiKey
+	if err := row.ToStruct(&key); err != nil {
+		return nil, fmt.Errorf("failed to parse API key: %w", err)
+	}
+
+	return &key, nil
+}
+
+// GetApiKeyByHash retrieves an API key by the hash of its secret.
+// Returns nil if no key matches.
+func (c *Client) GetApiKeyByHash(ctx context.Context, keyHash string) (*ApiKey, error) {
+	ctx, end := instrument(ctx, "GetApiKeyByHash")
+	defer end()
+	row, err := c.client.Single().ReadRowUsingIndex(ctx, "ApiKeys", "ApiKeysByHash", spanner.Key{keyHash}, []string{"TenantId", "KeyId"})
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil // No key found
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query API key by hash: %w", err)
+	}
+
+	var tenantID, keyID string
+	if err := row.Columns(&tenantID, &keyID); err != nil {
+		return nil, fmt.Errorf("failed to parse API key: %w", err)
+	}
+
+	return c.GetApiKey(ctx, tenantID, keyID)
+}
+
+// ListApiKeys returns all API keys for a tenant, including revoked ones
+func (c *Client) ListApiKeys(ctx context.Context, tenantID string) ([]*ApiKey, error) {
+	ctx, end := instrument(ctx, "ListApiKeys")
+	defer end()
+	iter := c.client.Single().Read(ctx, "ApiKeys", spanner.Key{tenantID}.AsPrefix(), apiKeyColumns)
+	defer iter.Stop()
+
+	var keys []*ApiKey
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate API keys: %w", err)
+		}
+
+		var key ApiKey
+		if err := row.ToStruct(&key); err != nil {
+			return nil, fmt.Errorf("failed to parse API key: %w", err)
+		}
+		keys = append(keys, &key)
+	}
+
+	return keys, nil
+}
+
+// RevokeApiKey marks an API key as revoked
+func (c *Client) RevokeApiKey(ctx context.Context, tenantID, keyID string) error {
+	ctx, end := instrument(ctx, "RevokeApiKey")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("ApiKeys",
+			[]string{"TenantId", "KeyId", "RevokedAt"},
+			[]interface{}{tenantID, keyID, spanner.CommitTimestamp},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	return nil
+}
+
+// TouchApiKey records that an API key was just used
+func (c *Client) TouchApiKey(ctx context.Context, tenantID, keyID string, usedAt time.Time) error {
+	ctx, end := instrument(ctx, "TouchApiKey")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("ApiKeys",
+			[]string{"TenantId", "KeyId", "LastUsedAt"},
+			[]interface{}{tenantID, keyID, usedAt},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update API key last use: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+var jobArtifactColumns = []string{"TenantId", "JobId", "Uri", "Output", "SizeBytes", "Crc32c", "UpdatedAt", "RecordedAt"}
+
+// RecordJobArtifacts stores the artifacts of a completed job, replacing any
+// recorded before with the same URI.
+func (c *Client) RecordJobArtifacts(ctx context.Context, artifacts []*JobArtifact) error {
+	ctx, end := instrument(ctx, "RecordJobArtifacts")
+	defer end()
+	if len(artifacts) == 0 {
+		return nil
+	}
+	mutations := make([]*spanner.Mutation, 0, len(artifacts))
+	for _, a := range artifacts {
+		mutations = append(mutations, spanner.InsertOrUpdate("JobArtifacts", jobArtifactColumns, []interface{}{
+			a.TenantId, a.JobId, a.Uri, a.Output, a.SizeBytes, a.Crc32c, a.UpdatedAt, spanner.CommitTimestamp,
+		}))
+	}
+	if _, err := c.client.Apply(ctx, mutations); err != nil {
+		return fmt.Errorf("failed to record job artifacts: %w", err)
+	}
+	return nil
+}
+
+// ListJobArtifacts returns a job's artifacts by output, then URI. A non-empty
+// output returns only that output's.
+func (c *Client) ListJobArtifacts(ctx context.Context, tenantID, jobID, output string) ([]*JobArtifact, error) {
+	ctx, end := instrument(ctx, "ListJobArtifacts")
+	defer end()
+	sql := `SELECT TenantId, JobId, Uri, Output, SizeBytes, Crc32c, UpdatedAt, RecordedAt
+	        FROM JobArtifacts
+	        WHERE TenantId = @tenantId AND JobId = @jobId`
+	params := map[string]interface{}{
+		"tenantId": tenantID,
+		"jobId":    jobID,
+	}
+	if output != "" {
+		sql += ` AND Output = @output`
+		params["output"] = output
+	}
+	stmt := spanner.Statement{SQL: sql + ` ORDER BY Output, Uri`, Params: params}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var artifacts []*JobArtifact
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list job artifacts: %w", err)
+		}
+		var artifact JobArtifact
+		if err := row.ToStruct(&artifact); err != nil {
+			return nil, fmt.Errorf("failed to parse job artifact: %w", err)
+		}
+		artifacts = append(artifacts, &artifact)
+	}
+	return artifacts, nil
+}
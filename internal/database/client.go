@@ -3,13 +3,20 @@ package database
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/alphauslabs/jennah/internal/metrics"
+	"github.com/alphauslabs/jennah/internal/tracing"
 )
 
 // Client wraps the Cloud Spanner client
 type Client struct {
-	client *spanner.Client
+	client    *spanner.Client
+	jobEvents bool // Queue JobEvents rows, see EnableJobEvents
 }
 
 // NewClient creates a new database client
@@ -31,3 +38,20 @@ func NewClient(ctx context.Context, project, instance, database string) (*Client
 func (c *Client) Close() {
 	c.client.Close()
 }
+
+// instrument starts a span for a Client method and records its latency. Call it
+// at the top of every method that talks to Spanner:
+//
+//	ctx, end := instrument(ctx, "Method")
+//	defer end()
+func instrument(ctx context.Context, method string) (context.Context, func()) {
+	start := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, "database."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "spanner")),
+	)
+	return ctx, func() {
+		span.End()
+		metrics.SpannerDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+)
+
+var jobEventColumns = []string{"TenantId", "JobId", "Sequence", "EventId", "EventType", "FromStatus", "ToStatus", "Reason",
+	"OccurredAt", "Attempts", "LastError", "NextAttemptAt", "PublishedAt"}
+
+// transitionEvents maps the status a job moves to onto the event published for it
+var transitionEvents = map[string]string{
+	JobStatusPending:   JobEventRetried,
+	JobStatusScheduled: JobEventScheduled,
+	JobStatusRunning:   JobEventStarted,
+	JobStatusCompleted: JobEventSucceeded,
+	JobStatusFailed:    JobEventFailed,
+	JobStatusCancelled: JobEventCancelled,
+	JobStatusTimedOut:  JobEventTimedOut,
+}
+
+// EnableJobEvents makes InsertJob and TransitionJobStatus queue a JobEvents
+// row for each job lifecycle event, to be published with ClaimJobEvents. Call
+// it before the client is used, on every client that writes jobs.
+func (c *Client) EnableJobEvents() {
+	c.jobEvents = true
+}
+
+// jobEventMutation returns the insert that queues a job event for publishing
+func jobEventMutation(tenantID, jobID string, sequence int64, eventType string, from *string, to string, reason *string) *spanner.Mutation {
+	return spanner.Insert("JobEvents",
+		[]string{"TenantId", "JobId", "Sequence", "EventId", "EventType", "FromStatus", "ToStatus", "Reason", "OccurredAt", "Attempts", "NextAttemptAt"},
+		[]interface{}{tenantID, jobID, sequence, uuid.New().String(), eventType, from, to, reason, spanner.CommitTimestamp, int64(0), time.Now()},
+	)
+}
+
+// transitionEventMutations returns the insert that queues the event for a
+// job's transition, numbered after the job's last event, or nothing if job
+// events are not enabled.
+func (c *Client) transitionEventMutations(ctx context.Context, txn *spanner.ReadWriteTransaction, tenantID, jobID, from, to string, reason *string) ([]*spanner.Mutation, error) {
+	if !c.jobEvents {
+		return nil, nil
+	}
+	stmt := spanner.Statement{
+		SQL: `SELECT IFNULL(MAX(Sequence), 0)
+		      FROM JobEvents
+		      WHERE TenantId = @tenantId AND JobId = @jobId`,
+		Params: map[string]interface{}{
+			"tenantId": tenantID,
+			"jobId":    jobID,
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return nil, err
+	}
+	var last int64
+	if err := row.Columns(&last); err != nil {
+		return nil, err
+	}
+	return []*spanner.Mutation{jobEventMutation(tenantID, jobID, last+1, transitionEvents[to], &from, to, reason)}, nil
+}
+
+// ClaimJobEvents returns up to limit unpublished job events that are due, and
+// pushes their next attempt back by lease so that other workers skip them
+// while they are being published. Only the oldest unpublished event of each
+// job is returned, so a job's events are published one at a time, in order,
+// even across workers; the next one is returned once it has been published.
+func (c *Client) ClaimJobEvents(ctx context.Context, limit int64, lease time.Duration) ([]*JobEvent, error) {
+	ctx, end := instrument(ctx, "ClaimJobEvents")
+	defer end()
+	var events []*JobEvent
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		events = nil
+		stmt := spanner.Statement{
+			SQL: `SELECT ` + strings.Join(jobEventColumns, ", ") + `
+			      FROM JobEvents@{FORCE_INDEX=JobEventsUnpublished} e
+			      WHERE e.NextAttemptAt <= CURRENT_TIMESTAMP()
+			        AND NOT EXISTS (
+			          SELECT 1 FROM JobEvents p
+			          WHERE p.TenantId = e.TenantId AND p.JobId = e.JobId
+			            AND p.Sequence < e.Sequence AND p.NextAttemptAt IS NOT NULL)
+			      ORDER BY e.NextAttemptAt
+			      LIMIT @limit`,
+			Params: map[string]interface{}{
+				"limit": limit,
+			},
+		}
+
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+
+		var mutations []*spanner.Mutation
+		leaseUntil := time.Now().Add(lease)
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			var event JobEvent
+			if err := row.ToStruct(&event); err != nil {
+				return err
+			}
+			events = append(events, &event)
+
+			mutations = append(mutations, spanner.Update("JobEvents",
+				[]string{"TenantId", "JobId", "Sequence", "NextAttemptAt"},
+				[]interface{}{event.TenantId, event.JobId, event.Sequence, leaseUntil},
+			))
+		}
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim job events: %w", err)
+	}
+	return events, nil
+}
+
+// RecordJobEventAttempt stores the outcome of publishing an event: its
+// Attempts, LastError, NextAttemptAt and PublishedAt. NextAttemptAt must be
+// nil once the event is published.
+func (c *Client) RecordJobEventAttempt(ctx context.Context, event *JobEvent) error {
+	ctx, end := instrument(ctx, "RecordJobEventAttempt")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("JobEvents",
+			[]string{"TenantId", "JobId", "Sequence", "Attempts", "LastError", "NextAttemptAt", "PublishedAt"},
+			[]interface{}{event.TenantId, event.JobId, event.Sequence, event.Attempts, event.LastError, event.NextAttemptAt, event.PublishedAt},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record job event attempt: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+var identityColumns = []string{"TenantId", "OAuthProvider", "OAuthUserId", "Email", "LinkedAt"}
+
+// ErrIdentityInUse is returned when linking an identity whose own tenant still holds data
+var ErrIdentityInUse = errors.New("identity belongs to a tenant that holds data besides the identity")
+
+// ErrIdentityNotLinked is returned when unlinking an identity that is not linked to the tenant
+var ErrIdentityNotLinked = errors.New("identity is not linked to this tenant")
+
+// ErrLastIdentity is returned when unlinking would leave a tenant without any identity
+var ErrLastIdentity = errors.New("cannot unlink the only identity of a tenant")
+
+// ListTenantIdentities returns the OAuth identities linked to a tenant, oldest first
+func (c *Client) ListTenantIdentities(ctx context.Context, tenantID string) ([]*TenantIdentity, error) {
+	ctx, end := instrument(ctx, "ListTenantIdentities")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT TenantId, OAuthProvider, OAuthUserId, Email, LinkedAt
+		      FROM TenantIdentities
+		      WHERE TenantId = @tenantId
+		      ORDER BY LinkedAt`,
+		Params: map[string]interface{}{
+			"tenantId": tenantID,
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var identities []*TenantIdentity
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate tenant identities: %w", err)
+		}
+
+		var identity TenantIdentity
+		if err := row.ToStruct(&identity); err != nil {
+			return nil, fmt.Errorf("failed to parse tenant identity: %w", err)
+		}
+		identities = append(identities, &identity)
+	}
+
+	return identities, nil
+}
+
+// ListAllTenantIdentities returns every linked identity, grouped by tenant ID
+func (c *Client) ListAllTenantIdentities(ctx context.Context) (map[string][]*TenantIdentity, error) {
+	ctx, end := instrument(ctx, "ListAllTenantIdentities")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT TenantId, OAuthProvider, OAuthUserId, Email, LinkedAt
+		      FROM TenantIdentities
+		      ORDER BY TenantId, LinkedAt`,
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	identities := make(map[string][]*TenantIdentity)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate tenant identities: %w", err)
+		}
+
+		var identity TenantIdentity
+		if err := row.ToStruct(&identity); err != nil {
+			return nil, fmt.Errorf("failed to parse tenant identity: %w", err)
+		}
+		identities[identity.TenantId] = append(identities[identity.TenantId], &identity)
+	}
+
+	return identities, nil
+}
+
+// LinkIdentity links an OAuth identity to tenantID. If the identity already has a
+// tenant of its own, that tenant must hold nothing but the identity; it is deleted
+// in the same transaction and its ID is returned. Returns ErrIdentityInUse otherwise.
+// Linking an identity that is already linked to tenantID is a no-op.
+func (c *Client) LinkIdentity(ctx context.Context, tenantID string, identity *TenantIdentity) (string, error) {
+	ctx, end := instrument(ctx, "LinkIdentity")
+	defer end()
+	var previousTenantID string
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		previousTenantID = ""
+
+		existing, err := readTenantByOAuth(ctx, txn, identity.OAuthProvider, identity.OAuthUserId)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.TenantId == tenantID {
+			return nil
+		}
+
+		mutations := []*spanner.Mutation{}
+		if existing != nil {
+			empty, err := tenantHoldsOnlyIdentity(ctx, txn, existing.TenantId)
+			if err != nil {
+				return err
+			}
+			if !empty {
+				return ErrIdentityInUse
+			}
+			previousTenantID = existing.TenantId
+			mutations = append(mutations, spanner.Delete("Tenants", spanner.Key{existing.TenantId}))
+		}
+
+		mutations = append(mutations, spanner.Insert("TenantIdentities", identityColumns,
+			[]interface{}{tenantID, identity.OAuthProvider, identity.OAuthUserId, identity.Email, spanner.CommitTimestamp},
+		))
+		return txn.BufferWrite(mutations)
+	})
+	if errors.Is(err, ErrIdentityInUse) {
+		return "", err
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to link identity: %w", err)
+	}
+	return previousTenantID, nil
+}
+
+// tenantHoldsOnlyIdentity reports whether a tenant has exactly one identity, no
+// rows in any other table interleaved in Tenants and no memberships in other
+// tenants, so deleting it loses nothing. Tables added under Tenants must be
+// counted here.
+func tenantHoldsOnlyIdentity(ctx context.Context, txn *spanner.ReadWriteTransaction, tenantID string) (bool, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT (SELECT COUNT(*) FROM TenantIdentities WHERE TenantId = @tenantId),
+		             (SELECT COUNT(*) FROM Jobs WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM TenantQuotas WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM TenantPolicies WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM ApiKeys WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM TenantMembers WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM TenantInvitations WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM JobTemplates WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM NotificationSubscriptions WHERE TenantId = @tenantId)
+		           + (SELECT COUNT(*) FROM TenantMembers@{FORCE_INDEX=TenantMembersByMember} WHERE MemberTenantId = @tenantId)`,
+		Params: map[string]interface{}{
+			"tenantId": tenantID,
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return false, err
+	}
+	var identities, other int64
+	if err := row.Columns(&identities, &other); err != nil {
+		return false, err
+	}
+	return identities == 1 && other == 0, nil
+}
+
+// UnlinkIdentity removes an OAuth identity from a tenant. Returns ErrIdentityNotLinked
+// if it is not linked to the tenant and ErrLastIdentity if it is the tenant's only one.
+func (c *Client) UnlinkIdentity(ctx context.Context, tenantID, oauthProvider, oauthUserId string) error {
+	ctx, end := instrument(ctx, "UnlinkIdentity")
+	defer end()
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		var count int
+		var linked bool
+		iter := txn.Read(ctx, "TenantIdentities", spanner.Key{tenantID}.AsPrefix(), []string{"OAuthProvider", "OAuthUserId"})
+		err := iter.Do(func(row *spanner.Row) error {
+			var provider, userId string
+			if err := row.Columns(&provider, &userId); err != nil {
+				return err
+			}
+			count++
+			if provider == oauthProvider && userId == oauthUserId {
+				linked = true
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if !linked {
+			return ErrIdentityNotLinked
+		}
+		if count <= 1 {
+			return ErrLastIdentity
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Delete("TenantIdentities", spanner.Key{tenantID, oauthProvider, oauthUserId}),
+		})
+	})
+	if errors.Is(err, ErrIdentityNotLinked) || errors.Is(err, ErrLastIdentity) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unlink identity: %w", err)
+	}
+	return nil
+}
@@ -3,28 +3,73 @@ package database
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
 	"google.golang.org/api/iterator"
+
+	"github.com/alphauslabs/jennah/internal/labels"
 )
 
-// InsertJob creates a new job with PENDING status
-func (c *Client) InsertJob(ctx context.Context, tenantID, jobID, imageUri string, commands []string) error {
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+// jobColumns lists the Jobs columns read into the Job struct
+var jobColumns = []string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "ScheduledAt", "StartedAt", "CompletedAt", "RetryCount", "MaxRetries", "ErrorMessage", "GcpBatchJobName", "CpuMilli", "MemoryMib", "TaskCount", "Location", "TemplateId", "TemplateRevision", "Labels", "Annotations", "ProvisioningModel", "PreemptionCount", "MaxRunDurationSeconds", "MaxQueueDurationSeconds", "Outputs", "ImageDigest"}
+
+// InsertJob creates a new job with PENDING status.
+// TenantId, JobId, ImageUri and the digest it resolved to, Commands, the
+// requested resources and provisioning model, timeouts, outputs, labels,
+// annotations and the template revision, if any, are taken from job.
+// If limits is not nil, the tenant's usage is checked against its capacity
+// limits in the same transaction as the insert, so concurrent submissions
+// cannot together exceed them; a *QuotaExceededError is returned if the job
+// does not fit.
+func (c *Client) InsertJob(ctx context.Context, job *Job, limits *TenantQuota) error {
+	ctx, end := instrument(ctx, "InsertJob")
+	defer end()
+	mutations := []*spanner.Mutation{
 		spanner.Insert("Jobs",
-			[]string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "RetryCount", "MaxRetries", "GcpBatchJobName"},
-			[]interface{}{tenantID, jobID, JobStatusPending, imageUri, commands, spanner.CommitTimestamp, spanner.CommitTimestamp, 0, 3},
+			[]string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "RetryCount", "MaxRetries", "GcpBatchJobName", "CpuMilli", "MemoryMib", "TaskCount", "TemplateId", "TemplateRevision", "Labels", "Annotations", "ProvisioningModel", "MaxRunDurationSeconds", "MaxQueueDurationSeconds", "Outputs", "ImageDigest"},
+			[]interface{}{job.TenantId, job.JobId, JobStatusPending, job.ImageUri, job.Commands, spanner.CommitTimestamp, spanner.CommitTimestamp, 0, 3, job.GcpBatchJobName, job.CpuMilli, job.MemoryMib, job.TaskCount, job.TemplateId, job.TemplateRevision, job.Labels, job.Annotations, job.ProvisioningModel, job.MaxRunDurationSeconds, job.MaxQueueDurationSeconds, job.Outputs, job.ImageDigest},
 		),
+	}
+	if c.jobEvents {
+		mutations = append(mutations, jobEventMutation(job.TenantId, job.JobId, 1, JobEventSubmitted, nil, JobStatusPending, nil))
+	}
+	if limits == nil || !limits.HasCapacityLimits() {
+		_, err := c.client.Apply(ctx, mutations)
+		return err
+	}
+
+	cpuMilli, memoryMib := int64(DefaultCpuMilli), int64(DefaultMemoryMib)
+	if job.CpuMilli != nil {
+		cpuMilli = *job.CpuMilli
+	}
+	if job.MemoryMib != nil {
+		memoryMib = *job.MemoryMib
+	}
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// The query reads the tenant's active jobs, so a concurrent insert
+		// for the same tenant aborts one of the transactions
+		usage, err := queryTenantUsage(ctx, txn, job.TenantId)
+		if err != nil {
+			return err
+		}
+		if exceeded := limits.CheckCapacity(usage, cpuMilli*job.TaskCount, memoryMib*job.TaskCount); exceeded != nil {
+			return exceeded
+		}
+		return txn.BufferWrite(mutations)
 	})
 	return err
 }
 
 // GetJob retrieves a job by tenant ID and job ID
 func (c *Client) GetJob(ctx context.Context, tenantID, jobID string) (*Job, error) {
+	ctx, end := instrument(ctx, "GetJob")
+	defer end()
 	row, err := c.client.Single().ReadRow(ctx, "Jobs",
 		spanner.Key{tenantID, jobID},
-		[]string{"TenantId", "JobId", "Status", "ImageUri", "Commands", "CreatedAt", "UpdatedAt", "ScheduledAt", "StartedAt", "CompletedAt", "RetryCount", "MaxRetries", "ErrorMessage", "GcpBatchJobName"},
+		jobColumns,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get job: %w", err)
@@ -38,16 +83,20 @@ func (c *Client) GetJob(ctx context.Context, tenantID, jobID string) (*Job, erro
 	return &job, nil
 }
 
-// ListJobs returns all jobs for a tenant
-func (c *Client) ListJobs(ctx context.Context, tenantID string) ([]*Job, error) {
+// ListJobs returns the jobs of a tenant whose labels match selector; a nil
+// selector returns all of them.
+func (c *Client) ListJobs(ctx context.Context, tenantID string, selector labels.Selector) ([]*Job, error) {
+	ctx, end := instrument(ctx, "ListJobs")
+	defer end()
+	params := map[string]interface{}{
+		"tenantId": tenantID,
+	}
 	stmt := spanner.Statement{
-		SQL: `SELECT TenantId, JobId, Status, ImageUri, Commands, CreatedAt, UpdatedAt, ScheduledAt, StartedAt, CompletedAt, RetryCount, MaxRetries, ErrorMessage, GcpBatchJobName
+		SQL: `SELECT ` + strings.Join(jobColumns, ", ") + `
 		      FROM Jobs 
-		      WHERE TenantId = @tenantId 
+		      WHERE TenantId = @tenantId` + labelConditions(selector, params) + `
 		      ORDER BY CreatedAt DESC`,
-		Params: map[string]interface{}{
-			"tenantId": tenantID,
-		},
+		Params: params,
 	}
 
 	iter := c.client.Single().Query(ctx, stmt)
@@ -75,8 +124,10 @@ func (c *Client) ListJobs(ctx context.Context, tenantID string) ([]*Job, error)
 
 // ListJobsByStatus returns jobs for a tenant filtered by status
 func (c *Client) ListJobsByStatus(ctx context.Context, tenantID, status string) ([]*Job, error) {
+	ctx, end := instrument(ctx, "ListJobsByStatus")
+	defer end()
 	stmt := spanner.Statement{
-		SQL: `SELECT TenantId, JobId, Status, ImageUri, Commands, CreatedAt, UpdatedAt, ScheduledAt, StartedAt, CompletedAt, RetryCount, MaxRetries, ErrorMessage, GcpBatchJobName
+		SQL: `SELECT ` + strings.Join(jobColumns, ", ") + `
 		      FROM Jobs@{FORCE_INDEX=JobsByStatus}
 		      WHERE TenantId = @tenantId AND Status = @status 
 		      ORDER BY CreatedAt DESC`,
@@ -109,8 +160,174 @@ func (c *Client) ListJobsByStatus(ctx context.Context, tenantID, status string)
 	return jobs, nil
 }
 
+// ListActiveJobs returns a tenant's jobs that have not reached a terminal status
+func (c *Client) ListActiveJobs(ctx context.Context, tenantID string) ([]*Job, error) {
+	ctx, end := instrument(ctx, "ListActiveJobs")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(jobColumns, ", ") + `
+		      FROM Jobs@{FORCE_INDEX=JobsByStatus}
+		      WHERE TenantId = @tenantId AND Status IN UNNEST(@statuses)
+		      ORDER BY CreatedAt DESC`,
+		Params: map[string]interface{}{
+			"tenantId": tenantID,
+			"statuses": ActiveJobStatuses,
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var jobs []*Job
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate jobs: %w", err)
+		}
+
+		var job Job
+		if err := row.ToStruct(&job); err != nil {
+			return nil, fmt.Errorf("failed to parse job: %w", err)
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// ListAllActiveJobs returns the jobs of every tenant that have not reached a
+// terminal status, oldest first
+func (c *Client) ListAllActiveJobs(ctx context.Context) ([]*Job, error) {
+	ctx, end := instrument(ctx, "ListAllActiveJobs")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(jobColumns, ", ") + `
+		      FROM Jobs
+		      WHERE Status IN UNNEST(@statuses)
+		      ORDER BY CreatedAt`,
+		Params: map[string]interface{}{
+			"statuses": ActiveJobStatuses,
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var jobs []*Job
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate jobs: %w", err)
+		}
+
+		var job Job
+		if err := row.ToStruct(&job); err != nil {
+			return nil, fmt.Errorf("failed to parse job: %w", err)
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// CountJobsByStatus returns the number of jobs in each status across all tenants
+func (c *Client) CountJobsByStatus(ctx context.Context) (map[string]int64, error) {
+	ctx, end := instrument(ctx, "CountJobsByStatus")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT Status, COUNT(*) FROM Jobs GROUP BY Status`,
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	counts := make(map[string]int64)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to count jobs: %w", err)
+		}
+
+		var status string
+		var count int64
+		if err := row.Columns(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to parse job count: %w", err)
+		}
+		counts[status] = count
+	}
+
+	return counts, nil
+}
+
+// GetRegionUsage returns the vCPU, in milli-cores, held by active jobs in each
+// location across all tenants
+func (c *Client) GetRegionUsage(ctx context.Context) (map[string]int64, error) {
+	ctx, end := instrument(ctx, "GetRegionUsage")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT Location, SUM(IFNULL(CpuMilli, @defaultCpu) * TaskCount)
+		      FROM Jobs@{FORCE_INDEX=JobsByLocation}
+		      WHERE Location IS NOT NULL AND Status IN UNNEST(@statuses)
+		      GROUP BY Location`,
+		Params: map[string]interface{}{
+			"statuses":   ActiveJobStatuses,
+			"defaultCpu": int64(DefaultCpuMilli),
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	usage := make(map[string]int64)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query region usage: %w", err)
+		}
+
+		var location string
+		var cpuMilli int64
+		if err := row.Columns(&location, &cpuMilli); err != nil {
+			return nil, fmt.Errorf("failed to parse region usage: %w", err)
+		}
+		usage[location] = cpuMilli
+	}
+
+	return usage, nil
+}
+
+// PlaceJob records the location and Batch job name a job was created with
+func (c *Client) PlaceJob(ctx context.Context, tenantID, jobID, location, gcpBatchJobName string) error {
+	ctx, end := instrument(ctx, "PlaceJob")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("Jobs",
+			[]string{"TenantId", "JobId", "Location", "GcpBatchJobName", "UpdatedAt"},
+			[]interface{}{tenantID, jobID, location, gcpBatchJobName, spanner.CommitTimestamp},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record job placement: %w", err)
+	}
+	return nil
+}
+
 // UpdateJobStatus updates the status of a job
 func (c *Client) UpdateJobStatus(ctx context.Context, tenantID, jobID, status string) error {
+	ctx, end := instrument(ctx, "UpdateJobStatus")
+	defer end()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Update("Jobs",
 			[]string{"TenantId", "JobId", "Status", "UpdatedAt"},
@@ -125,6 +342,8 @@ func (c *Client) UpdateJobStatus(ctx context.Context, tenantID, jobID, status st
 
 // CompleteJob marks a job as completed with a completion timestamp
 func (c *Client) CompleteJob(ctx context.Context, tenantID, jobID string) error {
+	ctx, end := instrument(ctx, "CompleteJob")
+	defer end()
 	now := time.Now()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Update("Jobs",
@@ -140,6 +359,8 @@ func (c *Client) CompleteJob(ctx context.Context, tenantID, jobID string) error
 
 // FailJob marks a job as failed with an error message
 func (c *Client) FailJob(ctx context.Context, tenantID, jobID, errorMessage string) error {
+	ctx, end := instrument(ctx, "FailJob")
+	defer end()
 	now := time.Now()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Update("Jobs",
@@ -155,6 +376,8 @@ func (c *Client) FailJob(ctx context.Context, tenantID, jobID, errorMessage stri
 
 // ScheduleJob marks a job as SCHEDULED with a scheduled timestamp
 func (c *Client) ScheduleJob(ctx context.Context, tenantID, jobID string) error {
+	ctx, end := instrument(ctx, "ScheduleJob")
+	defer end()
 	now := time.Now()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Update("Jobs",
@@ -170,6 +393,8 @@ func (c *Client) ScheduleJob(ctx context.Context, tenantID, jobID string) error
 
 // StartJob marks a job as RUNNING with a started timestamp
 func (c *Client) StartJob(ctx context.Context, tenantID, jobID string) error {
+	ctx, end := instrument(ctx, "StartJob")
+	defer end()
 	now := time.Now()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Update("Jobs",
@@ -183,8 +408,91 @@ func (c *Client) StartJob(ctx context.Context, tenantID, jobID string) error {
 	return nil
 }
 
+// TransitionJobStatus moves a job from one status to another and records the
+// transition, setting the timestamp that goes with the new status. It does
+// nothing and returns false if the job is no longer in from, so concurrent
+// writers such as CancelJob and the status reconciler cannot undo each other.
+func (c *Client) TransitionJobStatus(ctx context.Context, tenantID, jobID, from, to string, reason *string) (bool, error) {
+	ctx, end := instrument(ctx, "TransitionJobStatus")
+	defer end()
+	return c.transitionJobStatus(ctx, tenantID, jobID, from, to, reason, nil, nil)
+}
+
+// ResubmitPreemptedJob moves a job whose VMs were preempted from one status
+// back to PENDING, like TransitionJobStatus, and in the same transaction
+// records the Batch job it was resubmitted as, the provisioning model of that
+// job and the preemption count.
+func (c *Client) ResubmitPreemptedJob(ctx context.Context, tenantID, jobID, from, gcpBatchJobName, provisioningModel string, preemptions int64, reason string) (bool, error) {
+	ctx, end := instrument(ctx, "ResubmitPreemptedJob")
+	defer end()
+	return c.transitionJobStatus(ctx, tenantID, jobID, from, JobStatusPending, &reason,
+		[]string{"GcpBatchJobName", "ProvisioningModel", "PreemptionCount"},
+		[]interface{}{gcpBatchJobName, provisioningModel, preemptions},
+	)
+}
+
+// transitionJobStatus implements TransitionJobStatus, also setting the given
+// columns if the transition applies.
+func (c *Client) transitionJobStatus(ctx context.Context, tenantID, jobID, from, to string, reason *string, extraColumns []string, extraValues []interface{}) (bool, error) {
+	applied := false
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		applied = false
+		row, err := txn.ReadRow(ctx, "Jobs", spanner.Key{tenantID, jobID}, []string{"Status"})
+		if err != nil {
+			return err
+		}
+		var current string
+		if err := row.Columns(&current); err != nil {
+			return err
+		}
+		if current != from {
+			return nil
+		}
+
+		now := time.Now()
+		columns := append([]string{"TenantId", "JobId", "Status", "UpdatedAt"}, extraColumns...)
+		values := append([]interface{}{tenantID, jobID, to, spanner.CommitTimestamp}, extraValues...)
+		switch to {
+		case JobStatusScheduled:
+			columns, values = append(columns, "ScheduledAt"), append(values, now)
+		case JobStatusRunning:
+			columns, values = append(columns, "StartedAt"), append(values, now)
+		case JobStatusCompleted, JobStatusCancelled:
+			columns, values = append(columns, "CompletedAt"), append(values, now)
+		case JobStatusFailed, JobStatusTimedOut:
+			columns, values = append(columns, "CompletedAt", "ErrorMessage"), append(values, now, reason)
+		}
+
+		notifications, err := notificationMutations(ctx, txn, tenantID, jobID, from, to, reason)
+		if err != nil {
+			return err
+		}
+		events, err := c.transitionEventMutations(ctx, txn, tenantID, jobID, from, to, reason)
+		if err != nil {
+			return err
+		}
+
+		applied = true
+		mutations := []*spanner.Mutation{
+			spanner.Update("Jobs", columns, values),
+			spanner.Insert("JobStateTransitions",
+				[]string{"TenantId", "JobId", "TransitionId", "FromStatus", "ToStatus", "TransitionedAt", "Reason"},
+				[]interface{}{tenantID, jobID, uuid.New().String(), from, to, spanner.CommitTimestamp, reason},
+			),
+		}
+		mutations = append(mutations, notifications...)
+		return txn.BufferWrite(append(mutations, events...))
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to transition job status: %w", err)
+	}
+	return applied, nil
+}
+
 // CancelJob marks a job as CANCELLED
 func (c *Client) CancelJob(ctx context.Context, tenantID, jobID string) error {
+	ctx, end := instrument(ctx, "CancelJob")
+	defer end()
 	now := time.Now()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Update("Jobs",
@@ -200,6 +508,8 @@ func (c *Client) CancelJob(ctx context.Context, tenantID, jobID string) error {
 
 // DeleteJob removes a job
 func (c *Client) DeleteJob(ctx context.Context, tenantID, jobID string) error {
+	ctx, end := instrument(ctx, "DeleteJob")
+	defer end()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Delete("Jobs", spanner.Key{tenantID, jobID}),
 	})
@@ -208,3 +518,32 @@ func (c *Client) DeleteJob(ctx context.Context, tenantID, jobID string) error {
 	}
 	return nil
 }
+
+// labelConditions turns a label selector into AND-ed conditions on the Labels
+// column, which holds "key=value" strings, adding their parameters to params.
+func labelConditions(selector labels.Selector, params map[string]interface{}) string {
+	var sql strings.Builder
+	for i, req := range selector {
+		param := fmt.Sprintf("label%d", i)
+		var pairs []string
+		for _, value := range req.Values {
+			pairs = append(pairs, req.Key+"="+value)
+		}
+
+		switch req.Operator {
+		case labels.Equals, labels.In:
+			sql.WriteString(fmt.Sprintf(" AND EXISTS (SELECT 1 FROM UNNEST(Labels) AS l WHERE l IN UNNEST(@%s))", param))
+			params[param] = pairs
+		case labels.NotEquals, labels.NotIn:
+			sql.WriteString(fmt.Sprintf(" AND NOT EXISTS (SELECT 1 FROM UNNEST(Labels) AS l WHERE l IN UNNEST(@%s))", param))
+			params[param] = pairs
+		case labels.Exists:
+			sql.WriteString(fmt.Sprintf(" AND EXISTS (SELECT 1 FROM UNNEST(Labels) AS l WHERE STARTS_WITH(l, @%s))", param))
+			params[param] = req.Key + "="
+		case labels.DoesNotExist:
+			sql.WriteString(fmt.Sprintf(" AND NOT EXISTS (SELECT 1 FROM UNNEST(Labels) AS l WHERE STARTS_WITH(l, @%s))", param))
+			params[param] = req.Key + "="
+		}
+	}
+	return sql.String()
+}
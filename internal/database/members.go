@@ -0,0 +1,226 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+var memberColumns = []string{"TenantId", "MemberTenantId", "Role", "MemberEmail", "AddedBy", "CreatedAt", "UpdatedAt"}
+
+var invitationColumns = []string{"TenantId", "InvitationId", "Email", "Role", "InvitedBy", "CreatedAt", "ExpiresAt", "AcceptedAt"}
+
+// ErrInvitationUnavailable is returned when an invitation was already accepted or has expired
+var ErrInvitationUnavailable = errors.New("invitation has already been accepted or has expired")
+
+// GetTenantMember retrieves a member of a tenant by their personal tenant ID.
+// Returns nil if the user is not a member.
+func (c *Client) GetTenantMember(ctx context.Context, tenantID, memberTenantID string) (*TenantMember, error) {
+	ctx, end := instrument(ctx, "GetTenantMember")
+	defer end()
+	row, err := c.client.Single().ReadRow(ctx, "TenantMembers", spanner.Key{tenantID, memberTenantID}, memberColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil // Not a member
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant member: %w", err)
+	}
+
+	var member TenantMember
+	if err := row.ToStruct(&member); err != nil {
+		return nil, fmt.Errorf("failed to parse tenant member: %w", err)
+	}
+
+	return &member, nil
+}
+
+// ListTenantMembers returns all members of a tenant
+func (c *Client) ListTenantMembers(ctx context.Context, tenantID string) ([]*TenantMember, error) {
+	ctx, end := instrument(ctx, "ListTenantMembers")
+	defer end()
+	iter := c.client.Single().Read(ctx, "TenantMembers", spanner.Key{tenantID}.AsPrefix(), memberColumns)
+	return collectMembers(iter)
+}
+
+// ListMembershipsByMember returns the memberships held by a user, identified by their personal tenant ID
+func (c *Client) ListMembershipsByMember(ctx context.Context, memberTenantID string) ([]*TenantMember, error) {
+	ctx, end := instrument(ctx, "ListMembershipsByMember")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT TenantId, MemberTenantId, Role, MemberEmail, AddedBy, CreatedAt, UpdatedAt
+		      FROM TenantMembers@{FORCE_INDEX=TenantMembersByMember}
+		      WHERE MemberTenantId = @memberTenantId`,
+		Params: map[string]interface{}{
+			"memberTenantId": memberTenantID,
+		},
+	}
+	iter := c.client.Single().Query(ctx, stmt)
+	return collectMembers(iter)
+}
+
+func collectMembers(iter *spanner.RowIterator) ([]*TenantMember, error) {
+	defer iter.Stop()
+
+	var members []*TenantMember
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate tenant members: %w", err)
+		}
+
+		var member TenantMember
+		if err := row.ToStruct(&member); err != nil {
+			return nil, fmt.Errorf("failed to parse tenant member: %w", err)
+		}
+		members = append(members, &member)
+	}
+
+	return members, nil
+}
+
+// UpdateTenantMemberRole changes a member's role
+func (c *Client) UpdateTenantMemberRole(ctx context.Context, tenantID, memberTenantID, role string) error {
+	ctx, end := instrument(ctx, "UpdateTenantMemberRole")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("TenantMembers",
+			[]string{"TenantId", "MemberTenantId", "Role", "UpdatedAt"},
+			[]interface{}{tenantID, memberTenantID, role, spanner.CommitTimestamp},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update tenant member role: %w", err)
+	}
+	return nil
+}
+
+// DeleteTenantMember removes a member from a tenant
+func (c *Client) DeleteTenantMember(ctx context.Context, tenantID, memberTenantID string) error {
+	ctx, end := instrument(ctx, "DeleteTenantMember")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Delete("TenantMembers", spanner.Key{tenantID, memberTenantID}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete tenant member: %w", err)
+	}
+	return nil
+}
+
+// InsertInvitation creates a new pending invitation
+func (c *Client) InsertInvitation(ctx context.Context, inv *TenantInvitation) error {
+	ctx, end := instrument(ctx, "InsertInvitation")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Insert("TenantInvitations",
+			[]string{"TenantId", "InvitationId", "Email", "Role", "InvitedBy", "CreatedAt", "ExpiresAt"},
+			[]interface{}{inv.TenantId, inv.InvitationId, inv.Email, inv.Role, inv.InvitedBy, spanner.CommitTimestamp, inv.ExpiresAt},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert invitation: %w", err)
+	}
+	return nil
+}
+
+// ListPendingInvitationsByEmail returns unaccepted, unexpired invitations addressed to an email
+func (c *Client) ListPendingInvitationsByEmail(ctx context.Context, email string) ([]*TenantInvitation, error) {
+	ctx, end := instrument(ctx, "ListPendingInvitationsByEmail")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT TenantId, InvitationId, Email, Role, InvitedBy, CreatedAt, ExpiresAt, AcceptedAt
+		      FROM TenantInvitations@{FORCE_INDEX=InvitationsByEmail}
+		      WHERE Email = @email AND AcceptedAt IS NULL AND ExpiresAt > CURRENT_TIMESTAMP()
+		      ORDER BY CreatedAt DESC`,
+		Params: map[string]interface{}{
+			"email": email,
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var invitations []*TenantInvitation
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate invitations: %w", err)
+		}
+
+		var inv TenantInvitation
+		if err := row.ToStruct(&inv); err != nil {
+			return nil, fmt.Errorf("failed to parse invitation: %w", err)
+		}
+		invitations = append(invitations, &inv)
+	}
+
+	return invitations, nil
+}
+
+// GetInvitation retrieves an invitation by tenant ID and invitation ID
+func (c *Client) GetInvitation(ctx context.Context, tenantID, invitationID string) (*TenantInvitation, error) {
+	ctx, end := instrument(ctx, "GetInvitation")
+	defer end()
+	row, err := c.client.Single().ReadRow(ctx, "TenantInvitations", spanner.Key{tenantID, invitationID}, invitationColumns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	var inv TenantInvitation
+	if err := row.ToStruct(&inv); err != nil {
+		return nil, fmt.Errorf("failed to parse invitation: %w", err)
+	}
+
+	return &inv, nil
+}
+
+// AcceptInvitation atomically marks an invitation accepted and adds the member.
+// Returns ErrInvitationUnavailable if it was already accepted or has expired.
+func (c *Client) AcceptInvitation(ctx context.Context, tenantID, invitationID string, member *TenantMember) error {
+	ctx, end := instrument(ctx, "AcceptInvitation")
+	defer end()
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, "TenantInvitations", spanner.Key{tenantID, invitationID}, []string{"ExpiresAt", "AcceptedAt"})
+		if err != nil {
+			return err
+		}
+		var expiresAt time.Time
+		var acceptedAt spanner.NullTime
+		if err := row.Columns(&expiresAt, &acceptedAt); err != nil {
+			return err
+		}
+		if acceptedAt.Valid || time.Now().After(expiresAt) {
+			return ErrInvitationUnavailable
+		}
+
+		// Accepting again with a different role replaces the existing membership
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update("TenantInvitations",
+				[]string{"TenantId", "InvitationId", "AcceptedAt"},
+				[]interface{}{tenantID, invitationID, spanner.CommitTimestamp},
+			),
+			spanner.InsertOrUpdate("TenantMembers",
+				[]string{"TenantId", "MemberTenantId", "Role", "MemberEmail", "AddedBy", "CreatedAt", "UpdatedAt"},
+				[]interface{}{tenantID, member.MemberTenantId, member.Role, member.MemberEmail, member.AddedBy, spanner.CommitTimestamp, spanner.CommitTimestamp},
+			),
+		})
+	})
+	if errors.Is(err, ErrInvitationUnavailable) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	return nil
+}
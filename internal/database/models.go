@@ -4,30 +4,163 @@ import "time"
 
 // Tenant represents an organization/team using the platform
 type Tenant struct {
+	TenantId      string     `spanner:"TenantId"`
+	UserEmail     string     `spanner:"UserEmail"`
+	CreatedAt     time.Time  `spanner:"CreatedAt"`
+	UpdatedAt     time.Time  `spanner:"UpdatedAt"`
+	DisplayName   *string    `spanner:"DisplayName"`
+	ContactEmail  *string    `spanner:"ContactEmail"`
+	SuspendedAt   *time.Time `spanner:"SuspendedAt"`
+	SuspendReason *string    `spanner:"SuspendReason"`
+}
+
+// TenantIdentity is an OAuth identity that signs in to a tenant. A tenant may
+// have several, e.g. a Google account and a GitHub account of the same person.
+type TenantIdentity struct {
 	TenantId      string    `spanner:"TenantId"`
-	UserEmail     string    `spanner:"UserEmail"`
 	OAuthProvider string    `spanner:"OAuthProvider"`
 	OAuthUserId   string    `spanner:"OAuthUserId"`
-	CreatedAt     time.Time `spanner:"CreatedAt"`
-	UpdatedAt     time.Time `spanner:"UpdatedAt"`
+	Email         string    `spanner:"Email"`
+	LinkedAt      time.Time `spanner:"LinkedAt"`
 }
 
 // Job represents a deployment job
 type Job struct {
-	TenantId        string     `spanner:"TenantId"`
-	JobId           string     `spanner:"JobId"`
-	Status          string     `spanner:"Status"`
-	ImageUri        string     `spanner:"ImageUri"`
-	Commands        []string   `spanner:"Commands"`
-	CreatedAt       time.Time  `spanner:"CreatedAt"`
-	UpdatedAt       time.Time  `spanner:"UpdatedAt"`
-	ScheduledAt     *time.Time `spanner:"ScheduledAt"`
-	StartedAt       *time.Time `spanner:"StartedAt"`
-	CompletedAt     *time.Time `spanner:"CompletedAt"`
-	RetryCount      int64      `spanner:"RetryCount"`
-	MaxRetries      int64      `spanner:"MaxRetries"`
-	ErrorMessage    *string    `spanner:"ErrorMessage"`
-	GcpBatchJobName *string    `spanner:"GcpBatchJobName"`
+	TenantId                string     `spanner:"TenantId"`
+	JobId                   string     `spanner:"JobId"`
+	Status                  string     `spanner:"Status"`
+	ImageUri                string     `spanner:"ImageUri"`
+	Commands                []string   `spanner:"Commands"`
+	CreatedAt               time.Time  `spanner:"CreatedAt"`
+	UpdatedAt               time.Time  `spanner:"UpdatedAt"`
+	ScheduledAt             *time.Time `spanner:"ScheduledAt"`
+	StartedAt               *time.Time `spanner:"StartedAt"`
+	CompletedAt             *time.Time `spanner:"CompletedAt"`
+	RetryCount              int64      `spanner:"RetryCount"`
+	MaxRetries              int64      `spanner:"MaxRetries"`
+	ErrorMessage            *string    `spanner:"ErrorMessage"`
+	GcpBatchJobName         *string    `spanner:"GcpBatchJobName"`
+	CpuMilli                *int64     `spanner:"CpuMilli"`
+	MemoryMib               *int64     `spanner:"MemoryMib"`
+	TaskCount               int64      `spanner:"TaskCount"`
+	Location                *string    `spanner:"Location"`
+	TemplateId              *string    `spanner:"TemplateId"`
+	TemplateRevision        *int64     `spanner:"TemplateRevision"`
+	Labels                  []string   `spanner:"Labels"`            // Sorted "key=value" strings, see labels.Join
+	Annotations             []string   `spanner:"Annotations"`       // Sorted "key=value" strings, see labels.Join
+	ProvisioningModel       *string    `spanner:"ProvisioningModel"` // nil is ProvisioningStandard
+	PreemptionCount         int64      `spanner:"PreemptionCount"`
+	MaxRunDurationSeconds   *int64     `spanner:"MaxRunDurationSeconds"`   // Per task; nil is no limit
+	MaxQueueDurationSeconds *int64     `spanner:"MaxQueueDurationSeconds"` // From CreatedAt; nil is no limit
+	Outputs                 []string   `spanner:"Outputs"`                 // Sorted "name=gs://bucket/pattern" strings, see labels.Join
+	ImageDigest             *string    `spanner:"ImageDigest"`             // "sha256:..." ImageUri resolved to at submission
+}
+
+// JobTemplate is a named, versioned job definition owned by a tenant
+type JobTemplate struct {
+	TenantId       string    `spanner:"TenantId"`
+	TemplateId     string    `spanner:"TemplateId"`
+	Name           string    `spanner:"Name"`
+	Description    *string   `spanner:"Description"`
+	LatestRevision int64     `spanner:"LatestRevision"`
+	CreatedAt      time.Time `spanner:"CreatedAt"`
+	UpdatedAt      time.Time `spanner:"UpdatedAt"`
+}
+
+// JobTemplateRevision is an immutable snapshot of a job template. Env vars and
+// parameters are stored as JSON, so the struct is read column by column.
+type JobTemplateRevision struct {
+	TenantId   string              `spanner:"TenantId"`
+	TemplateId string              `spanner:"TemplateId"`
+	Revision   int64               `spanner:"Revision"`
+	ImageUri   string              `spanner:"ImageUri"`
+	EnvVars    map[string]string   `spanner:"-"`
+	CpuMilli   *int64              `spanner:"CpuMilli"`
+	MemoryMib  *int64              `spanner:"MemoryMib"`
+	TaskCount  int64               `spanner:"TaskCount"`
+	Parameters []TemplateParameter `spanner:"-"`
+	CreatedBy  *string             `spanner:"CreatedBy"`
+	CreatedAt  time.Time           `spanner:"CreatedAt"`
+}
+
+// TemplateParameter is a typed value supplied when a job is launched from a
+// template, referenced as ${name} in the image URI and env var values.
+type TemplateParameter struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"` // One of the TemplateParamType constants
+	Default     string `json:"default,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// TenantQuota holds the limits applied to a tenant. A value of 0 means unlimited.
+type TenantQuota struct {
+	TenantId                string    `spanner:"TenantId"`
+	MaxConcurrentJobs       int64     `spanner:"MaxConcurrentJobs"`
+	MaxSubmissionsPerMinute int64     `spanner:"MaxSubmissionsPerMinute"`
+	MaxCpuMilli             int64     `spanner:"MaxCpuMilli"`
+	MaxMemoryMib            int64     `spanner:"MaxMemoryMib"`
+	MaxTaskCount            int64     `spanner:"MaxTaskCount"`
+	UpdatedAt               time.Time `spanner:"UpdatedAt"`
+}
+
+// TenantPolicy holds a tenant's job policy rules, applied on top of the
+// gateway's global policy. Empty arrays, false and 0 turn a rule off.
+type TenantPolicy struct {
+	TenantId              string    `spanner:"TenantId"`
+	AllowedRegistries     []string  `spanner:"AllowedRegistries"`
+	RequireDigest         bool      `spanner:"RequireDigest"`
+	MaxCpuMilli           int64     `spanner:"MaxCpuMilli"`
+	MaxMemoryMib          int64     `spanner:"MaxMemoryMib"`
+	MaxTaskCount          int64     `spanner:"MaxTaskCount"`
+	MaxRunDurationSeconds int64     `spanner:"MaxRunDurationSeconds"`
+	ForbiddenOptions      []string  `spanner:"ForbiddenOptions"`
+	RequiredLabels        []string  `spanner:"RequiredLabels"`
+	UpdatedAt             time.Time `spanner:"UpdatedAt"`
+}
+
+// TenantUsage is the resource usage of a tenant's active (non-terminal) jobs
+type TenantUsage struct {
+	ActiveJobs int64
+	CpuMilli   int64
+	MemoryMib  int64
+}
+
+// ApiKey is a tenant-scoped credential for machine clients
+type ApiKey struct {
+	TenantId   string     `spanner:"TenantId"`
+	KeyId      string     `spanner:"KeyId"`
+	Name       string     `spanner:"Name"`
+	KeyHash    string     `spanner:"KeyHash"`
+	Prefix     string     `spanner:"Prefix"`
+	CreatedBy  *string    `spanner:"CreatedBy"`
+	CreatedAt  time.Time  `spanner:"CreatedAt"`
+	ExpiresAt  *time.Time `spanner:"ExpiresAt"`
+	LastUsedAt *time.Time `spanner:"LastUsedAt"`
+	RevokedAt  *time.Time `spanner:"RevokedAt"`
+}
+
+// TenantMember grants a user access to a tenant other than their personal one
+type TenantMember struct {
+	TenantId       string    `spanner:"TenantId"`
+	MemberTenantId string    `spanner:"MemberTenantId"`
+	Role           string    `spanner:"Role"`
+	MemberEmail    string    `spanner:"MemberEmail"`
+	AddedBy        *string   `spanner:"AddedBy"`
+	CreatedAt      time.Time `spanner:"CreatedAt"`
+	UpdatedAt      time.Time `spanner:"UpdatedAt"`
+}
+
+// TenantInvitation is a pending offer of membership, addressed to an email
+type TenantInvitation struct {
+	TenantId     string     `spanner:"TenantId"`
+	InvitationId string     `spanner:"InvitationId"`
+	Email        string     `spanner:"Email"`
+	Role         string     `spanner:"Role"`
+	InvitedBy    string     `spanner:"InvitedBy"`
+	CreatedAt    time.Time  `spanner:"CreatedAt"`
+	ExpiresAt    time.Time  `spanner:"ExpiresAt"`
+	AcceptedAt   *time.Time `spanner:"AcceptedAt"`
 }
 
 // JobStateTransition tracks state changes for audit trail
@@ -41,6 +174,90 @@ type JobStateTransition struct {
 	Reason         *string   `spanner:"Reason"`
 }
 
+// NotificationSubscription is a tenant's webhook for job status changes
+type NotificationSubscription struct {
+	TenantId       string    `spanner:"TenantId"`
+	SubscriptionId string    `spanner:"SubscriptionId"`
+	Url            string    `spanner:"Url"`
+	Secret         string    `spanner:"Secret"`
+	Statuses       []string  `spanner:"Statuses"` // Job statuses whose transitions are sent
+	Format         string    `spanner:"Format"`
+	CreatedBy      *string   `spanner:"CreatedBy"`
+	CreatedAt      time.Time `spanner:"CreatedAt"`
+}
+
+// NotificationDelivery is one job status change sent, or to be sent, to a subscription
+type NotificationDelivery struct {
+	TenantId         string     `spanner:"TenantId"`
+	SubscriptionId   string     `spanner:"SubscriptionId"`
+	DeliveryId       string     `spanner:"DeliveryId"`
+	JobId            string     `spanner:"JobId"`
+	FromStatus       string     `spanner:"FromStatus"`
+	ToStatus         string     `spanner:"ToStatus"`
+	Reason           *string    `spanner:"Reason"`
+	Status           string     `spanner:"Status"`
+	Attempts         int64      `spanner:"Attempts"`
+	LastResponseCode *int64     `spanner:"LastResponseCode"`
+	LastError        *string    `spanner:"LastError"`
+	CreatedAt        time.Time  `spanner:"CreatedAt"`
+	LastAttemptAt    *time.Time `spanner:"LastAttemptAt"`
+	DeliveredAt      *time.Time `spanner:"DeliveredAt"`
+	NextAttemptAt    *time.Time `spanner:"NextAttemptAt"`
+}
+
+// JobEvent is a job lifecycle event, queued for publishing in the transaction
+// that caused it
+type JobEvent struct {
+	TenantId      string     `spanner:"TenantId"`
+	JobId         string     `spanner:"JobId"`
+	Sequence      int64      `spanner:"Sequence"`
+	EventId       string     `spanner:"EventId"`
+	EventType     string     `spanner:"EventType"`
+	FromStatus    *string    `spanner:"FromStatus"`
+	ToStatus      string     `spanner:"ToStatus"`
+	Reason        *string    `spanner:"Reason"`
+	OccurredAt    time.Time  `spanner:"OccurredAt"`
+	Attempts      int64      `spanner:"Attempts"`
+	LastError     *string    `spanner:"LastError"`
+	NextAttemptAt *time.Time `spanner:"NextAttemptAt"`
+	PublishedAt   *time.Time `spanner:"PublishedAt"`
+}
+
+// JobUsage is the resources a finished job used. The requested figures follow
+// from the job's resources; the others from the VMs GCP Batch ran it on, and
+// are nil when those are unknown.
+type JobUsage struct {
+	TenantId                  string    `spanner:"TenantId"`
+	JobId                     string    `spanner:"JobId"`
+	CompletedAt               time.Time `spanner:"CompletedAt"`
+	Status                    string    `spanner:"Status"`
+	Region                    *string   `spanner:"Region"`
+	MachineType               *string   `spanner:"MachineType"`
+	ProvisioningModel         *string   `spanner:"ProvisioningModel"`
+	Instances                 *int64    `spanner:"Instances"`
+	TaskCount                 int64     `spanner:"TaskCount"`
+	RunSeconds                float64   `spanner:"RunSeconds"`
+	RequestedVcpuSeconds      float64   `spanner:"RequestedVcpuSeconds"`
+	RequestedMemoryGibSeconds float64   `spanner:"RequestedMemoryGibSeconds"`
+	VcpuSeconds               *float64  `spanner:"VcpuSeconds"`
+	MemoryGibSeconds          *float64  `spanner:"MemoryGibSeconds"`
+	RecordedAt                time.Time `spanner:"RecordedAt"`
+	Labels                    []string  `spanner:"Labels"` // The job's, read with ListJobUsage
+}
+
+// JobArtifact is an object that matched one of a completed job's declared
+// outputs
+type JobArtifact struct {
+	TenantId   string    `spanner:"TenantId"`
+	JobId      string    `spanner:"JobId"`
+	Uri        string    `spanner:"Uri"`
+	Output     string    `spanner:"Output"`
+	SizeBytes  int64     `spanner:"SizeBytes"`
+	Crc32c     string    `spanner:"Crc32c"`
+	UpdatedAt  time.Time `spanner:"UpdatedAt"`
+	RecordedAt time.Time `spanner:"RecordedAt"`
+}
+
 // JobStatus constants
 const (
 	JobStatusPending   = "PENDING"
@@ -49,4 +266,62 @@ const (
 	JobStatusCompleted = "COMPLETED"
 	JobStatusFailed    = "FAILED"
 	JobStatusCancelled = "CANCELLED"
+	JobStatusTimedOut  = "TIMED_OUT"
+)
+
+// ActiveJobStatuses are the non-terminal statuses, in which a job may still hold
+// GCP Batch resources
+var ActiveJobStatuses = []string{JobStatusPending, JobStatusScheduled, JobStatusRunning}
+
+// JobEvent types, matching the jennah.events.v1.JobEvent.Type names
+const (
+	JobEventSubmitted = "SUBMITTED"
+	JobEventScheduled = "SCHEDULED"
+	JobEventStarted   = "STARTED"
+	JobEventRetried   = "RETRIED"
+	JobEventSucceeded = "SUCCEEDED"
+	JobEventFailed    = "FAILED"
+	JobEventCancelled = "CANCELLED"
+	JobEventTimedOut  = "TIMED_OUT"
+)
+
+// Notification payload formats
+const (
+	NotificationFormatJSON  = "json"
+	NotificationFormatSlack = "slack"
+)
+
+// Notification delivery statuses
+const (
+	DeliveryStatusPending    = "PENDING"
+	DeliveryStatusDelivered  = "DELIVERED"
+	DeliveryStatusDeadLetter = "DEAD_LETTER"
+)
+
+// Template parameter types
+const (
+	TemplateParamString = "string"
+	TemplateParamInt    = "int"
+	TemplateParamBool   = "bool"
+)
+
+// Tenant member roles, from most to least privileged
+const (
+	RoleOwner     = "owner"
+	RoleAdmin     = "admin"
+	RoleSubmitter = "submitter"
+	RoleViewer    = "viewer"
+)
+
+// Provisioning models of the VMs a job runs on, as named by GCP Batch
+const (
+	ProvisioningStandard    = "STANDARD"
+	ProvisioningSpot        = "SPOT"
+	ProvisioningPreemptible = "PREEMPTIBLE"
+)
+
+// Default per-task compute resources, matching the GCP Batch defaults
+const (
+	DefaultCpuMilli  = 2000
+	DefaultMemoryMib = 2000
 )
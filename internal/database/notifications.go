@@ -0,0 +1,275 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+var subscriptionColumns = []string{"TenantId", "SubscriptionId", "Url", "Secret", "Statuses", "Format", "CreatedBy", "CreatedAt"}
+
+var deliveryColumns = []string{"TenantId", "SubscriptionId", "DeliveryId", "JobId", "FromStatus", "ToStatus", "Reason", "Status",
+	"Attempts", "LastResponseCode", "LastError", "CreatedAt", "LastAttemptAt", "DeliveredAt", "NextAttemptAt"}
+
+// DueNotification is a delivery claimed for sending, with the subscription it goes to
+type DueNotification struct {
+	Delivery     NotificationDelivery
+	Subscription NotificationSubscription
+}
+
+// InsertNotificationSubscription stores a new subscription. sub.CreatedAt is set
+// from the commit timestamp.
+func (c *Client) InsertNotificationSubscription(ctx context.Context, sub *NotificationSubscription) error {
+	ctx, end := instrument(ctx, "InsertNotificationSubscription")
+	defer end()
+	commitTs, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Insert("NotificationSubscriptions", subscriptionColumns,
+			[]interface{}{sub.TenantId, sub.SubscriptionId, sub.Url, sub.Secret, sub.Statuses, sub.Format, sub.CreatedBy, spanner.CommitTimestamp},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert notification subscription: %w", err)
+	}
+	sub.CreatedAt = commitTs
+	return nil
+}
+
+// GetNotificationSubscription retrieves a subscription.
+// Returns nil if the tenant has no subscription with that ID.
+func (c *Client) GetNotificationSubscription(ctx context.Context, tenantID, subscriptionID string) (*NotificationSubscription, error) {
+	ctx, end := instrument(ctx, "GetNotificationSubscription")
+	defer end()
+	row, err := c.client.Single().ReadRow(ctx, "NotificationSubscriptions", spanner.Key{tenantID, subscriptionID}, subscriptionColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification subscription: %w", err)
+	}
+
+	var sub NotificationSubscription
+	if err := row.ToStruct(&sub); err != nil {
+		return nil, fmt.Errorf("failed to parse notification subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+// ListNotificationSubscriptions returns all subscriptions of a tenant
+func (c *Client) ListNotificationSubscriptions(ctx context.Context, tenantID string) ([]*NotificationSubscription, error) {
+	ctx, end := instrument(ctx, "ListNotificationSubscriptions")
+	defer end()
+	iter := c.client.Single().Read(ctx, "NotificationSubscriptions", spanner.Key{tenantID}.AsPrefix(), subscriptionColumns)
+	defer iter.Stop()
+
+	var subs []*NotificationSubscription
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate notification subscriptions: %w", err)
+		}
+
+		var sub NotificationSubscription
+		if err := row.ToStruct(&sub); err != nil {
+			return nil, fmt.Errorf("failed to parse notification subscription: %w", err)
+		}
+		subs = append(subs, &sub)
+	}
+
+	return subs, nil
+}
+
+// DeleteNotificationSubscription deletes a subscription and its delivery
+// history, including deliveries not yet sent.
+func (c *Client) DeleteNotificationSubscription(ctx context.Context, tenantID, subscriptionID string) error {
+	ctx, end := instrument(ctx, "DeleteNotificationSubscription")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Delete("NotificationSubscriptions", spanner.Key{tenantID, subscriptionID}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete notification subscription: %w", err)
+	}
+	return nil
+}
+
+// notificationMutations returns the inserts that queue a delivery of a job's
+// transition to every subscription of the tenant that asked for status to.
+// They are written in the transaction that records the transition, so a
+// transition is never lost between being recorded and being queued.
+func notificationMutations(ctx context.Context, txn *spanner.ReadWriteTransaction, tenantID, jobID, from, to string, reason *string) ([]*spanner.Mutation, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT SubscriptionId
+		      FROM NotificationSubscriptions
+		      WHERE TenantId = @tenantId AND @status IN UNNEST(Statuses)`,
+		Params: map[string]interface{}{
+			"tenantId": tenantID,
+			"status":   to,
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	var mutations []*spanner.Mutation
+	now := time.Now()
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var subscriptionID string
+		if err := row.Columns(&subscriptionID); err != nil {
+			return nil, err
+		}
+		mutations = append(mutations, spanner.Insert("NotificationDeliveries",
+			[]string{"TenantId", "SubscriptionId", "DeliveryId", "JobId", "FromStatus", "ToStatus", "Reason", "Status", "Attempts", "CreatedAt", "NextAttemptAt"},
+			[]interface{}{tenantID, subscriptionID, uuid.New().String(), jobID, from, to, reason, DeliveryStatusPending, int64(0), spanner.CommitTimestamp, now},
+		))
+	}
+	return mutations, nil
+}
+
+// ClaimDueNotificationDeliveries returns up to limit pending deliveries whose
+// next attempt is due, oldest first, and pushes their next attempt back by
+// lease so that other workers skip them while they are being sent. A delivery
+// whose sender dies is picked up again once the lease expires.
+func (c *Client) ClaimDueNotificationDeliveries(ctx context.Context, limit int64, lease time.Duration) ([]*DueNotification, error) {
+	ctx, end := instrument(ctx, "ClaimDueNotificationDeliveries")
+	defer end()
+	var due []*DueNotification
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		due = nil
+		stmt := spanner.Statement{
+			SQL: `SELECT d.TenantId, d.SubscriptionId, d.DeliveryId, d.JobId, d.FromStatus, d.ToStatus, d.Reason,
+			             d.Attempts, d.CreatedAt, s.Url, s.Secret, s.Format
+			      FROM NotificationDeliveries@{FORCE_INDEX=NotificationDeliveriesDue} d
+			      JOIN NotificationSubscriptions s ON s.TenantId = d.TenantId AND s.SubscriptionId = d.SubscriptionId
+			      WHERE d.NextAttemptAt <= CURRENT_TIMESTAMP()
+			      ORDER BY d.NextAttemptAt
+			      LIMIT @limit`,
+			Params: map[string]interface{}{
+				"limit": limit,
+			},
+		}
+
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+
+		var mutations []*spanner.Mutation
+		leaseUntil := time.Now().Add(lease)
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			var n DueNotification
+			d, s := &n.Delivery, &n.Subscription
+			err = row.Columns(&d.TenantId, &d.SubscriptionId, &d.DeliveryId, &d.JobId, &d.FromStatus, &d.ToStatus, &d.Reason,
+				&d.Attempts, &d.CreatedAt, &s.Url, &s.Secret, &s.Format)
+			if err != nil {
+				return err
+			}
+			d.Status = DeliveryStatusPending
+			s.TenantId, s.SubscriptionId = d.TenantId, d.SubscriptionId
+			due = append(due, &n)
+
+			mutations = append(mutations, spanner.Update("NotificationDeliveries",
+				[]string{"TenantId", "SubscriptionId", "DeliveryId", "NextAttemptAt"},
+				[]interface{}{d.TenantId, d.SubscriptionId, d.DeliveryId, leaseUntil},
+			))
+		}
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim notification deliveries: %w", err)
+	}
+	return due, nil
+}
+
+// RecordNotificationAttempt stores the outcome of sending a delivery: its
+// Status, Attempts, LastResponseCode, LastError, LastAttemptAt, DeliveredAt and
+// NextAttemptAt, which must be nil unless the delivery is still PENDING.
+func (c *Client) RecordNotificationAttempt(ctx context.Context, d *NotificationDelivery) error {
+	ctx, end := instrument(ctx, "RecordNotificationAttempt")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("NotificationDeliveries",
+			[]string{"TenantId", "SubscriptionId", "DeliveryId", "Status", "Attempts", "LastResponseCode", "LastError", "LastAttemptAt", "DeliveredAt", "NextAttemptAt"},
+			[]interface{}{d.TenantId, d.SubscriptionId, d.DeliveryId, d.Status, d.Attempts, d.LastResponseCode, d.LastError, d.LastAttemptAt, d.DeliveredAt, d.NextAttemptAt},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record notification attempt: %w", err)
+	}
+	return nil
+}
+
+// ListNotificationDeliveries returns up to limit of a tenant's deliveries,
+// newest first. Empty subscriptionID, jobID and status match any.
+func (c *Client) ListNotificationDeliveries(ctx context.Context, tenantID, subscriptionID, jobID, status string, limit int64) ([]*NotificationDelivery, error) {
+	ctx, end := instrument(ctx, "ListNotificationDeliveries")
+	defer end()
+	params := map[string]interface{}{
+		"tenantId": tenantID,
+		"limit":    limit,
+	}
+	conditions := ""
+	if subscriptionID != "" {
+		conditions += " AND SubscriptionId = @subscriptionId"
+		params["subscriptionId"] = subscriptionID
+	}
+	if jobID != "" {
+		conditions += " AND JobId = @jobId"
+		params["jobId"] = jobID
+	}
+	if status != "" {
+		conditions += " AND Status = @status"
+		params["status"] = status
+	}
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(deliveryColumns, ", ") + `
+		      FROM NotificationDeliveries@{FORCE_INDEX=NotificationDeliveriesByTenant}
+		      WHERE TenantId = @tenantId` + conditions + `
+		      ORDER BY CreatedAt DESC
+		      LIMIT @limit`,
+		Params: params,
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var deliveries []*NotificationDelivery
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate notification deliveries: %w", err)
+		}
+
+		var delivery NotificationDelivery
+		if err := row.ToStruct(&delivery); err != nil {
+			return nil, fmt.Errorf("failed to parse notification delivery: %w", err)
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+var tenantPolicyColumns = []string{"TenantId", "AllowedRegistries", "RequireDigest", "MaxCpuMilli", "MaxMemoryMib",
+	"MaxTaskCount", "MaxRunDurationSeconds", "ForbiddenOptions", "RequiredLabels", "UpdatedAt"}
+
+// GetTenantPolicy retrieves the job policy for a tenant.
+// Returns nil if the tenant has no policy of its own.
+func (c *Client) GetTenantPolicy(ctx context.Context, tenantID string) (*TenantPolicy, error) {
+	ctx, end := instrument(ctx, "GetTenantPolicy")
+	defer end()
+	row, err := c.client.Single().ReadRow(ctx, "TenantPolicies", spanner.Key{tenantID}, tenantPolicyColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil // No tenant policy
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant policy: %w", err)
+	}
+
+	var policy TenantPolicy
+	if err := row.ToStruct(&policy); err != nil {
+		return nil, fmt.Errorf("failed to parse tenant policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// UpsertTenantPolicy creates or replaces the job policy for a tenant
+func (c *Client) UpsertTenantPolicy(ctx context.Context, policy *TenantPolicy) error {
+	ctx, end := instrument(ctx, "UpsertTenantPolicy")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.InsertOrUpdate("TenantPolicies", tenantPolicyColumns,
+			[]interface{}{policy.TenantId, policy.AllowedRegistries, policy.RequireDigest, policy.MaxCpuMilli, policy.MaxMemoryMib,
+				policy.MaxTaskCount, policy.MaxRunDurationSeconds, policy.ForbiddenOptions, policy.RequiredLabels, spanner.CommitTimestamp},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert tenant policy: %w", err)
+	}
+	return nil
+}
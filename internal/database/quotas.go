@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// GetTenantQuota retrieves the quota for a tenant.
+// Returns nil if the tenant has no explicit quota.
+func (c *Client) GetTenantQuota(ctx context.Context, tenantID string) (*TenantQuota, error) {
+	ctx, end := instrument(ctx, "GetTenantQuota")
+	defer end()
+	row, err := c.client.Single().ReadRow(ctx, "TenantQuotas",
+		spanner.Key{tenantID},
+		[]string{"TenantId", "MaxConcurrentJobs", "MaxSubmissionsPerMinute", "MaxCpuMilli", "MaxMemoryMib", "MaxTaskCount", "UpdatedAt"},
+	)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil // No explicit quota
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant quota: %w", err)
+	}
+
+	var quota TenantQuota
+	if err := row.ToStruct(&quota); err != nil {
+		return nil, fmt.Errorf("failed to parse tenant quota: %w", err)
+	}
+
+	return &quota, nil
+}
+
+// UpsertTenantQuota creates or replaces the quota for a tenant
+func (c *Client) UpsertTenantQuota(ctx context.Context, quota *TenantQuota) error {
+	ctx, end := instrument(ctx, "UpsertTenantQuota")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.InsertOrUpdate("TenantQuotas",
+			[]string{"TenantId", "MaxConcurrentJobs", "MaxSubmissionsPerMinute", "MaxCpuMilli", "MaxMemoryMib", "MaxTaskCount", "UpdatedAt"},
+			[]interface{}{quota.TenantId, quota.MaxConcurrentJobs, quota.MaxSubmissionsPerMinute, quota.MaxCpuMilli, quota.MaxMemoryMib, quota.MaxTaskCount, spanner.CommitTimestamp},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert tenant quota: %w", err)
+	}
+	return nil
+}
+
+// QuotaExceededError is a capacity quota a job would take its tenant over.
+type QuotaExceededError struct {
+	Quota       string // e.g. "max_concurrent_jobs"
+	Description string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota %s exceeded: %s", e.Quota, e.Description)
+}
+
+// HasCapacityLimits reports whether the quota limits active jobs, CPU or memory.
+func (q *TenantQuota) HasCapacityLimits() bool {
+	return q.MaxConcurrentJobs > 0 || q.MaxCpuMilli > 0 || q.MaxMemoryMib > 0
+}
+
+// CheckCapacity returns the capacity quota that one more job, holding cpuMilli
+// and memoryMib in total across its tasks, would exceed on top of usage, or nil.
+func (q *TenantQuota) CheckCapacity(usage *TenantUsage, cpuMilli, memoryMib int64) *QuotaExceededError {
+	if q.MaxConcurrentJobs > 0 && usage.ActiveJobs+1 > q.MaxConcurrentJobs {
+		return &QuotaExceededError{"max_concurrent_jobs",
+			fmt.Sprintf("%d jobs active, limit is %d", usage.ActiveJobs, q.MaxConcurrentJobs)}
+	}
+	if q.MaxCpuMilli > 0 && usage.CpuMilli+cpuMilli > q.MaxCpuMilli {
+		return &QuotaExceededError{"max_cpu_milli",
+			fmt.Sprintf("%d cpu milli in flight plus %d requested exceeds limit of %d", usage.CpuMilli, cpuMilli, q.MaxCpuMilli)}
+	}
+	if q.MaxMemoryMib > 0 && usage.MemoryMib+memoryMib > q.MaxMemoryMib {
+		return &QuotaExceededError{"max_memory_mib",
+			fmt.Sprintf("%d MiB in flight plus %d requested exceeds limit of %d", usage.MemoryMib, memoryMib, q.MaxMemoryMib)}
+	}
+	return nil
+}
+
+// spannerQuerier is the transaction method used for queries, so helpers can
+// run in both read-only and read-write transactions.
+type spannerQuerier interface {
+	Query(ctx context.Context, statement spanner.Statement) *spanner.RowIterator
+}
+
+// GetTenantUsage sums the resources held by a tenant's active (PENDING, SCHEDULED, RUNNING) jobs
+func (c *Client) GetTenantUsage(ctx context.Context, tenantID string) (*TenantUsage, error) {
+	ctx, end := instrument(ctx, "GetTenantUsage")
+	defer end()
+	return queryTenantUsage(ctx, c.client.Single(), tenantID)
+}
+
+func queryTenantUsage(ctx context.Context, txn spannerQuerier, tenantID string) (*TenantUsage, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT COUNT(*),
+		             IFNULL(SUM(IFNULL(CpuMilli, @defaultCpu) * TaskCount), 0),
+		             IFNULL(SUM(IFNULL(MemoryMib, @defaultMemory) * TaskCount), 0)
+		      FROM Jobs
+		      WHERE TenantId = @tenantId AND Status IN UNNEST(@statuses)`,
+		Params: map[string]interface{}{
+			"tenantId":      tenantID,
+			"statuses":      ActiveJobStatuses,
+			"defaultCpu":    int64(DefaultCpuMilli),
+			"defaultMemory": int64(DefaultMemoryMib),
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tenant usage: %w", err)
+	}
+
+	var usage TenantUsage
+	if err := row.Columns(&usage.ActiveJobs, &usage.CpuMilli, &usage.MemoryMib); err != nil {
+		return nil, fmt.Errorf("failed to parse tenant usage: %w", err)
+	}
+
+	return &usage, nil
+}
@@ -0,0 +1,205 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+var templateColumns = []string{"TenantId", "TemplateId", "Name", "Description", "LatestRevision", "CreatedAt", "UpdatedAt"}
+
+var templateRevisionColumns = []string{"TenantId", "TemplateId", "Revision", "ImageUri", "EnvVars", "CpuMilli", "MemoryMib", "TaskCount", "Parameters", "CreatedBy", "CreatedAt"}
+
+// CreateJobTemplateRevision stores rev as the next revision of the tenant's
+// template called name, creating the template at revision 1 if it does not
+// exist. The template's description is replaced when description is non-nil.
+// rev.TenantId, TemplateId, Revision and CreatedAt are set from the stored row.
+func (c *Client) CreateJobTemplateRevision(ctx context.Context, tenantID, name string, description *string, rev *JobTemplateRevision) (*JobTemplate, error) {
+	ctx, end := instrument(ctx, "CreateJobTemplateRevision")
+	defer end()
+
+	envVars, err := json.Marshal(rev.EnvVars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template env vars: %w", err)
+	}
+	parameters, err := json.Marshal(rev.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template parameters: %w", err)
+	}
+
+	var template *JobTemplate
+	var created bool
+	commitTs, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		existing, err := readJobTemplateByName(ctx, txn, tenantID, name)
+		if err != nil {
+			return err
+		}
+
+		var mutations []*spanner.Mutation
+		created = existing == nil
+		if created {
+			template = &JobTemplate{
+				TenantId:       tenantID,
+				TemplateId:     uuid.New().String(),
+				Name:           name,
+				Description:    description,
+				LatestRevision: 1,
+			}
+			mutations = append(mutations, spanner.Insert("JobTemplates", templateColumns,
+				[]interface{}{tenantID, template.TemplateId, name, description, int64(1), spanner.CommitTimestamp, spanner.CommitTimestamp},
+			))
+		} else {
+			template = existing
+			template.LatestRevision++
+			if description != nil {
+				template.Description = description
+			}
+			mutations = append(mutations, spanner.Update("JobTemplates",
+				[]string{"TenantId", "TemplateId", "Description", "LatestRevision", "UpdatedAt"},
+				[]interface{}{tenantID, template.TemplateId, template.Description, template.LatestRevision, spanner.CommitTimestamp},
+			))
+		}
+
+		mutations = append(mutations, spanner.Insert("JobTemplateRevisions", templateRevisionColumns,
+			[]interface{}{tenantID, template.TemplateId, template.LatestRevision, rev.ImageUri, string(envVars),
+				rev.CpuMilli, rev.MemoryMib, rev.TaskCount, string(parameters), rev.CreatedBy, spanner.CommitTimestamp},
+		))
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job template revision: %w", err)
+	}
+
+	if created {
+		template.CreatedAt = commitTs
+	}
+	template.UpdatedAt = commitTs
+	rev.TenantId = tenantID
+	rev.TemplateId = template.TemplateId
+	rev.Revision = template.LatestRevision
+	rev.CreatedAt = commitTs
+	return template, nil
+}
+
+// GetJobTemplateByName retrieves a tenant's template by name.
+// Returns nil if no template has that name.
+func (c *Client) GetJobTemplateByName(ctx context.Context, tenantID, name string) (*JobTemplate, error) {
+	ctx, end := instrument(ctx, "GetJobTemplateByName")
+	defer end()
+	txn := c.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	template, err := readJobTemplateByName(ctx, txn, tenantID, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job template: %w", err)
+	}
+	return template, nil
+}
+
+// readJobTemplateByName looks up a template by name within txn. It returns nil
+// if the tenant has no template with that name.
+func readJobTemplateByName(ctx context.Context, txn spannerReader, tenantID, name string) (*JobTemplate, error) {
+	row, err := txn.ReadRowUsingIndex(ctx, "JobTemplates", "JobTemplatesByName", spanner.Key{tenantID, name}, []string{"TemplateId"})
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var templateID string
+	if err := row.Columns(&templateID); err != nil {
+		return nil, err
+	}
+
+	row, err = txn.ReadRow(ctx, "JobTemplates", spanner.Key{tenantID, templateID}, templateColumns)
+	if err != nil {
+		return nil, err
+	}
+	var template JobTemplate
+	if err := row.ToStruct(&template); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// GetJobTemplateRevision retrieves one revision of a template
+func (c *Client) GetJobTemplateRevision(ctx context.Context, tenantID, templateID string, revision int64) (*JobTemplateRevision, error) {
+	ctx, end := instrument(ctx, "GetJobTemplateRevision")
+	defer end()
+	row, err := c.client.Single().ReadRow(ctx, "JobTemplateRevisions", spanner.Key{tenantID, templateID, revision}, templateRevisionColumns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job template revision: %w", err)
+	}
+
+	var rev JobTemplateRevision
+	var envVars, parameters string
+	err = row.Columns(&rev.TenantId, &rev.TemplateId, &rev.Revision, &rev.ImageUri, &envVars,
+		&rev.CpuMilli, &rev.MemoryMib, &rev.TaskCount, &parameters, &rev.CreatedBy, &rev.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse job template revision: %w", err)
+	}
+	if err := json.Unmarshal([]byte(envVars), &rev.EnvVars); err != nil {
+		return nil, fmt.Errorf("failed to decode template env vars: %w", err)
+	}
+	if err := json.Unmarshal([]byte(parameters), &rev.Parameters); err != nil {
+		return nil, fmt.Errorf("failed to decode template parameters: %w", err)
+	}
+
+	return &rev, nil
+}
+
+// ListJobTemplates returns all templates of a tenant, by name
+func (c *Client) ListJobTemplates(ctx context.Context, tenantID string) ([]*JobTemplate, error) {
+	ctx, end := instrument(ctx, "ListJobTemplates")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT TenantId, TemplateId, Name, Description, LatestRevision, CreatedAt, UpdatedAt
+		      FROM JobTemplates
+		      WHERE TenantId = @tenantId
+		      ORDER BY Name`,
+		Params: map[string]interface{}{
+			"tenantId": tenantID,
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var templates []*JobTemplate
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate job templates: %w", err)
+		}
+
+		var template JobTemplate
+		if err := row.ToStruct(&template); err != nil {
+			return nil, fmt.Errorf("failed to parse job template: %w", err)
+		}
+		templates = append(templates, &template)
+	}
+
+	return templates, nil
+}
+
+// DeleteJobTemplate deletes a template and all its revisions. Jobs launched from
+// it keep their TemplateId and TemplateRevision.
+func (c *Client) DeleteJobTemplate(ctx context.Context, tenantID, templateID string) error {
+	ctx, end := instrument(ctx, "DeleteJobTemplate")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Delete("JobTemplates", spanner.Key{tenantID, templateID}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete job template: %w", err)
+	}
+	return nil
+}
@@ -3,30 +3,47 @@ package database
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
 )
 
-// InsertTenant creates a new tenant
+var tenantColumns = []string{"TenantId", "UserEmail", "CreatedAt", "UpdatedAt", "DisplayName", "ContactEmail", "SuspendedAt", "SuspendReason"}
+
+// InsertTenant creates a new tenant with its first linked OAuth identity
 func (c *Client) InsertTenant(ctx context.Context, tenantID, userEmail, oauthProvider, oauthUserId string) error {
-	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Insert("Tenants",
-			[]string{"TenantId", "UserEmail", "OAuthProvider", "OAuthUserId", "CreatedAt", "UpdatedAt"},
-			[]interface{}{tenantID, userEmail, oauthProvider, oauthUserId, spanner.CommitTimestamp, spanner.CommitTimestamp},
-		),
-	})
+	ctx, end := instrument(ctx, "InsertTenant")
+	defer end()
+	_, err := c.client.Apply(ctx, insertTenantMutations(tenantID, userEmail, oauthProvider, oauthUserId))
 	if err != nil {
 		return fmt.Errorf("failed to insert tenant: %w", err)
 	}
 	return nil
 }
 
+func insertTenantMutations(tenantID, userEmail, oauthProvider, oauthUserId string) []*spanner.Mutation {
+	return []*spanner.Mutation{
+		spanner.Insert("Tenants",
+			[]string{"TenantId", "UserEmail", "CreatedAt", "UpdatedAt"},
+			[]interface{}{tenantID, userEmail, spanner.CommitTimestamp, spanner.CommitTimestamp},
+		),
+		spanner.Insert("TenantIdentities",
+			[]string{"TenantId", "OAuthProvider", "OAuthUserId", "Email", "LinkedAt"},
+			[]interface{}{tenantID, oauthProvider, oauthUserId, userEmail, spanner.CommitTimestamp},
+		),
+	}
+}
+
 // GetTenant retrieves a tenant by ID
 func (c *Client) GetTenant(ctx context.Context, tenantID string) (*Tenant, error) {
+	ctx, end := instrument(ctx, "GetTenant")
+	defer end()
 	row, err := c.client.Single().ReadRow(ctx, "Tenants",
 		spanner.Key{tenantID},
-		[]string{"TenantId", "UserEmail", "OAuthProvider", "OAuthUserId", "CreatedAt", "UpdatedAt"},
+		tenantColumns,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tenant: %w", err)
@@ -42,8 +59,10 @@ func (c *Client) GetTenant(ctx context.Context, tenantID string) (*Tenant, error
 
 // ListTenants returns all tenants
 func (c *Client) ListTenants(ctx context.Context) ([]*Tenant, error) {
+	ctx, end := instrument(ctx, "ListTenants")
+	defer end()
 	stmt := spanner.Statement{
-		SQL: `SELECT TenantId, UserEmail, OAuthProvider, OAuthUserId, CreatedAt, UpdatedAt FROM Tenants ORDER BY CreatedAt DESC`,
+		SQL: `SELECT ` + strings.Join(tenantColumns, ", ") + ` FROM Tenants ORDER BY CreatedAt DESC`,
 	}
 
 	iter := c.client.Single().Query(ctx, stmt)
@@ -69,42 +88,222 @@ func (c *Client) ListTenants(ctx context.Context) ([]*Tenant, error) {
 	return tenants, nil
 }
 
-// GetTenantByOAuth retrieves a tenant by OAuth provider and user ID
+// GetTenantByOAuth retrieves the tenant an OAuth identity is linked to
 func (c *Client) GetTenantByOAuth(ctx context.Context, oauthProvider, oauthUserId string) (*Tenant, error) {
-	stmt := spanner.Statement{
-		SQL: `SELECT TenantId, UserEmail, OAuthProvider, OAuthUserId, CreatedAt, UpdatedAt 
-		      FROM Tenants 
-		      WHERE OAuthProvider = @provider AND OAuthUserId = @userId 
-		      LIMIT 1`,
-		Params: map[string]interface{}{
-			"provider": oauthProvider,
-			"userId":   oauthUserId,
-		},
-	}
+	ctx, end := instrument(ctx, "GetTenantByOAuth")
+	defer end()
+	txn := c.client.ReadOnlyTransaction()
+	defer txn.Close()
 
-	iter := c.client.Single().Query(ctx, stmt)
-	defer iter.Stop()
+	tenant, err := readTenantByOAuth(ctx, txn, oauthProvider, oauthUserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tenant by OAuth: %w", err)
+	}
+	return tenant, nil
+}
 
-	row, err := iter.Next()
-	if err == iterator.Done {
-		return nil, nil // No tenant found
+// readTenantByOAuth looks up an identity's tenant within txn. It returns nil
+// if the identity is not linked to any tenant.
+func readTenantByOAuth(ctx context.Context, txn spannerReader, oauthProvider, oauthUserId string) (*Tenant, error) {
+	row, err := txn.ReadRowUsingIndex(ctx, "TenantIdentities", "TenantIdentitiesByOAuth",
+		spanner.Key{oauthProvider, oauthUserId}, []string{"TenantId"})
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to query tenant by OAuth: %w", err)
+		return nil, err
+	}
+	var tenantID string
+	if err := row.Columns(&tenantID); err != nil {
+		return nil, err
 	}
 
+	row, err = txn.ReadRow(ctx, "Tenants", spanner.Key{tenantID}, tenantColumns)
+	if err != nil {
+		return nil, err
+	}
 	var tenant Tenant
 	if err := row.ToStruct(&tenant); err != nil {
-		return nil, fmt.Errorf("failed to parse tenant: %w", err)
+		return nil, err
 	}
-
 	return &tenant, nil
 }
 
-// DeleteTenant removes a tenant and all its jobs (CASCADE)
-func (c *Client) DeleteTenant(ctx context.Context, tenantID string) error {
+// spannerReader is the subset of transaction methods used for point reads, so
+// helpers can run in both read-only and read-write transactions.
+type spannerReader interface {
+	ReadRow(ctx context.Context, table string, key spanner.Key, columns []string) (*spanner.Row, error)
+	ReadRowUsingIndex(ctx context.Context, table, index string, key spanner.Key, columns []string) (*spanner.Row, error)
+}
+
+// GetOrCreateTenantByOAuth returns the tenant for an OAuth identity, creating it
+// with newTenantID if none exists. The lookup and insert run in one read-write
+// transaction, and TenantIdentitiesByOAuth is a unique index, so concurrent first
+// logins on different gateway instances always converge on a single persisted tenant.
+// The boolean result reports whether the tenant was created by this call.
+func (c *Client) GetOrCreateTenantByOAuth(ctx context.Context, oauthProvider, oauthUserId, userEmail, newTenantID string) (*Tenant, bool, error) {
+	ctx, end := instrument(ctx, "GetOrCreateTenantByOAuth")
+	defer end()
+	var tenant *Tenant
+	var created bool
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		var err error
+		tenant, err = readTenantByOAuth(ctx, txn, oauthProvider, oauthUserId)
+		if err != nil || tenant != nil {
+			created = false
+			return err
+		}
+
+		created = true
+		tenant = &Tenant{
+			TenantId:  newTenantID,
+			UserEmail: userEmail,
+		}
+		return txn.BufferWrite(insertTenantMutations(newTenantID, userEmail, oauthProvider, oauthUserId))
+	})
+	if spanner.ErrCode(err) == codes.AlreadyExists {
+		// Another instance committed the same identity first; return its tenant
+		tenant, err := c.GetTenantByOAuth(ctx, oauthProvider, oauthUserId)
+		if err != nil {
+			return nil, false, err
+		}
+		if tenant == nil {
+			return nil, false, fmt.Errorf("tenant for %s/%s not found after conflicting insert", oauthProvider, oauthUserId)
+		}
+		return tenant, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get or create tenant: %w", err)
+	}
+	return tenant, created, nil
+}
+
+// SuspendTenant marks a tenant as suspended. Suspending an already suspended
+// tenant keeps the original suspension time and replaces the reason.
+func (c *Client) SuspendTenant(ctx context.Context, tenantID, reason string) error {
+	ctx, end := instrument(ctx, "SuspendTenant")
+	defer end()
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, "Tenants", spanner.Key{tenantID}, []string{"SuspendedAt"})
+		if err != nil {
+			return err
+		}
+		var suspendedAt spanner.NullTime
+		if err := row.Columns(&suspendedAt); err != nil {
+			return err
+		}
+		at := time.Now()
+		if suspendedAt.Valid {
+			at = suspendedAt.Time
+		}
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update("Tenants",
+				[]string{"TenantId", "SuspendedAt", "SuspendReason", "UpdatedAt"},
+				[]interface{}{tenantID, at, reason, spanner.CommitTimestamp},
+			),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to suspend tenant: %w", err)
+	}
+	return nil
+}
+
+// ResumeTenant clears a tenant's suspension
+func (c *Client) ResumeTenant(ctx context.Context, tenantID string) error {
+	ctx, end := instrument(ctx, "ResumeTenant")
+	defer end()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
-		spanner.Delete("Tenants", spanner.Key{tenantID}),
+		spanner.Update("Tenants",
+			[]string{"TenantId", "SuspendedAt", "SuspendReason", "UpdatedAt"},
+			[]interface{}{tenantID, nil, nil, spanner.CommitTimestamp},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resume tenant: %w", err)
+	}
+	return nil
+}
+
+// UpdateTenantProfile sets a tenant's display name and contact email.
+// Nil arguments leave the column unchanged; empty strings clear it.
+func (c *Client) UpdateTenantProfile(ctx context.Context, tenantID string, displayName, contactEmail *string) error {
+	ctx, end := instrument(ctx, "UpdateTenantProfile")
+	defer end()
+	columns := []string{"TenantId", "UpdatedAt"}
+	values := []interface{}{tenantID, spanner.CommitTimestamp}
+	if displayName != nil {
+		columns = append(columns, "DisplayName")
+		values = append(values, nullableString(*displayName))
+	}
+	if contactEmail != nil {
+		columns = append(columns, "ContactEmail")
+		values = append(values, nullableString(*contactEmail))
+	}
+
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Update("Tenants", columns, values),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update tenant: %w", err)
+	}
+	return nil
+}
+
+func nullableString(s string) spanner.NullString {
+	return spanner.NullString{StringVal: s, Valid: s != ""}
+}
+
+// CountTenantOutbox counts the tenant's job events not yet published and
+// notification deliveries still pending. DeleteTenant would drop them with the
+// jobs and subscriptions they are interleaved under.
+func (c *Client) CountTenantOutbox(ctx context.Context, tenantID string) (events, deliveries int64, err error) {
+	ctx, end := instrument(ctx, "CountTenantOutbox")
+	defer end()
+	stmt := spanner.Statement{
+		SQL: `SELECT
+		        (SELECT COUNT(*) FROM JobEvents WHERE TenantId = @tenantId AND NextAttemptAt IS NOT NULL),
+		        (SELECT COUNT(*) FROM NotificationDeliveries WHERE TenantId = @tenantId AND Status = @pending)`,
+		Params: map[string]interface{}{"tenantId": tenantID, "pending": DeliveryStatusPending},
+	}
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count tenant outbox: %w", err)
+	}
+	if err := row.Columns(&events, &deliveries); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse tenant outbox counts: %w", err)
+	}
+	return events, deliveries, nil
+}
+
+// DeleteTenant removes a tenant and all its jobs (CASCADE), along with the
+// tenant's memberships in other tenants, which are not interleaved under it.
+// Queued job events and notification deliveries go with them, so callers
+// should wait for CountTenantOutbox to reach zero. Live GCP Batch jobs are not
+// touched; callers must cancel them first.
+func (c *Client) DeleteTenant(ctx context.Context, tenantID string) error {
+	ctx, end := instrument(ctx, "DeleteTenant")
+	defer end()
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		iter := txn.ReadUsingIndex(ctx, "TenantMembers", "TenantMembersByMember",
+			spanner.Key{tenantID}.AsPrefix(), []string{"TenantId", "MemberTenantId"})
+		mutations := []*spanner.Mutation{}
+		err := iter.Do(func(row *spanner.Row) error {
+			var parentID, memberID string
+			if err := row.Columns(&parentID, &memberID); err != nil {
+				return err
+			}
+			mutations = append(mutations, spanner.Delete("TenantMembers", spanner.Key{parentID, memberID}))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		mutations = append(mutations, spanner.Delete("Tenants", spanner.Key{tenantID}))
+		return txn.BufferWrite(mutations)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete tenant: %w", err)
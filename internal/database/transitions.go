@@ -10,6 +10,8 @@ import (
 
 // RecordStateTransition creates a new state transition record
 func (c *Client) RecordStateTransition(ctx context.Context, tenantID, jobID, transitionID string, fromStatus *string, toStatus string, reason *string) error {
+	ctx, end := instrument(ctx, "RecordStateTransition")
+	defer end()
 	_, err := c.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Insert("JobStateTransitions",
 			[]string{"TenantId", "JobId", "TransitionId", "FromStatus", "ToStatus", "TransitionedAt", "Reason"},
@@ -24,6 +26,8 @@ func (c *Client) RecordStateTransition(ctx context.Context, tenantID, jobID, tra
 
 // GetJobTransitions retrieves all state transitions for a job
 func (c *Client) GetJobTransitions(ctx context.Context, tenantID, jobID string) ([]*JobStateTransition, error) {
+	ctx, end := instrument(ctx, "GetJobTransitions")
+	defer end()
 	stmt := spanner.Statement{
 		SQL: `SELECT TenantId, JobId, TransitionId, FromStatus, ToStatus, TransitionedAt, Reason 
 		      FROM JobStateTransitions 
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/alphauslabs/jennah/internal/labels"
+)
+
+var jobUsageColumns = []string{"TenantId", "JobId", "CompletedAt", "Status", "Region", "MachineType", "ProvisioningModel",
+	"Instances", "TaskCount", "RunSeconds", "RequestedVcpuSeconds", "RequestedMemoryGibSeconds", "VcpuSeconds",
+	"MemoryGibSeconds", "RecordedAt"}
+
+// RecordJobUsage stores the resources a finished job used, replacing any
+// recorded before.
+func (c *Client) RecordJobUsage(ctx context.Context, usage *JobUsage) error {
+	ctx, end := instrument(ctx, "RecordJobUsage")
+	defer end()
+	_, err := c.client.Apply(ctx, []*spanner.Mutation{
+		spanner.InsertOrUpdate("JobUsage", jobUsageColumns, []interface{}{
+			usage.TenantId, usage.JobId, usage.CompletedAt, usage.Status, usage.Region, usage.MachineType, usage.ProvisioningModel,
+			usage.Instances, usage.TaskCount, usage.RunSeconds, usage.RequestedVcpuSeconds, usage.RequestedMemoryGibSeconds,
+			usage.VcpuSeconds, usage.MemoryGibSeconds, spanner.CommitTimestamp,
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record job usage: %w", err)
+	}
+	return nil
+}
+
+// ListJobUsage returns the usage of the jobs that completed in [start, end)
+// and whose labels match selector, with the jobs' labels. An empty tenantID
+// returns the jobs of every tenant.
+func (c *Client) ListJobUsage(ctx context.Context, tenantID string, start, end time.Time, selector labels.Selector) ([]*JobUsage, error) {
+	ctx, endSpan := instrument(ctx, "ListJobUsage")
+	defer endSpan()
+	params := map[string]interface{}{
+		"start": start,
+		"end":   end,
+	}
+	columns := make([]string, 0, len(jobUsageColumns)+1)
+	for _, column := range jobUsageColumns {
+		columns = append(columns, "u."+column)
+	}
+	sql := `SELECT ` + strings.Join(append(columns, "j.Labels"), ", ") + `
+	        FROM JobUsage u
+	        JOIN Jobs j ON j.TenantId = u.TenantId AND j.JobId = u.JobId
+	        WHERE u.CompletedAt >= @start AND u.CompletedAt < @end`
+	if tenantID != "" {
+		sql += ` AND u.TenantId = @tenantId`
+		params["tenantId"] = tenantID
+	}
+	stmt := spanner.Statement{
+		SQL:    sql + labelConditions(selector, params) + ` ORDER BY u.CompletedAt`,
+		Params: params,
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var usages []*JobUsage
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate job usage: %w", err)
+		}
+
+		var usage JobUsage
+		if err := row.ToStruct(&usage); err != nil {
+			return nil, fmt.Errorf("failed to parse job usage: %w", err)
+		}
+		usages = append(usages, &usage)
+	}
+	return usages, nil
+}
@@ -0,0 +1,165 @@
+// Package egress guards outbound requests to URLs that tenants choose, such as
+// notification webhooks, so that they cannot reach the worker's own network:
+// loopback, private, link-local (including the metadata server) and
+// unspecified addresses are refused when connecting, after DNS resolution, so
+// a name that later resolves elsewhere does not get around the check. An
+// operator may allow some internal hosts and networks anyway, such as a local
+// test server, with an Allowlist.
+package egress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned when a connection to an internal address is refused.
+var ErrBlockedAddress = errors.New("address is not publicly routable")
+
+// sharedAddressSpace is 100.64.0.0/10, used for carrier-grade NAT and by some
+// cloud networks internally.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsBlocked reports whether ip is an internal address that tenant-chosen URLs
+// may not reach.
+func IsBlocked(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		ip.IsUnspecified() || sharedAddressSpace.Contains(ip)
+}
+
+// Allowlist is the internal hosts and networks that tenant-chosen URLs may
+// reach anyway. A nil Allowlist allows none.
+type Allowlist struct {
+	hosts    []string
+	prefixes []netip.Prefix
+}
+
+// ParseAllowlist parses host names, IP addresses and CIDRs, such as
+// localhost, 127.0.0.1 or 10.0.0.0/8. It returns nil for no entries.
+func ParseAllowlist(entries []string) (*Allowlist, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	a := &Allowlist{}
+	for _, entry := range entries {
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			a.prefixes = append(a.prefixes, prefix.Masked())
+			continue
+		}
+		if ip, err := netip.ParseAddr(entry); err == nil {
+			ip = ip.Unmap()
+			a.prefixes = append(a.prefixes, netip.PrefixFrom(ip, ip.BitLen()))
+			continue
+		}
+		host := normalizeHost(entry)
+		if host == "" || strings.ContainsAny(host, ":/@ ") {
+			return nil, fmt.Errorf("allowed host %q must be a host name, IP address or CIDR", entry)
+		}
+		a.hosts = append(a.hosts, host)
+	}
+	return a, nil
+}
+
+// allowsHost reports whether host, a name or IP literal, is allowed.
+func (a *Allowlist) allowsHost(host string) bool {
+	if a == nil {
+		return false
+	}
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return a.allowsAddr(ip)
+	}
+	return slices.Contains(a.hosts, normalizeHost(host))
+}
+
+// allowsAddr reports whether ip is in an allowed network.
+func (a *Allowlist) allowsAddr(ip netip.Addr) bool {
+	if a == nil {
+		return false
+	}
+	ip = ip.Unmap()
+	for _, prefix := range a.prefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// control refuses connections to blocked addresses outside the allowed networks.
+func (a *Allowlist) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, address)
+	}
+	if IsBlocked(addrPort.Addr()) && !a.allowsAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// Control is a net.Dialer Control function that refuses connections to
+// blocked addresses. It sees the resolved address being dialed.
+func Control(network, address string, c syscall.RawConn) error {
+	return (*Allowlist)(nil).control(network, address, c)
+}
+
+// NewClient returns an HTTP client for tenant-chosen URLs: it only connects to
+// public addresses, ignores proxy settings, which would connect on its behalf,
+// and does not follow redirects, which could point anywhere. Hosts and
+// networks in allow are connected to whatever they resolve to.
+func NewClient(timeout time.Duration, allow *Allowlist) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: allow.control}
+	allowedDialer := &net.Dialer{Timeout: timeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		if host, _, err := net.SplitHostPort(address); err == nil && allow.allowsHost(host) {
+			return allowedDialer.DialContext(ctx, network, address)
+		}
+		return dialer.DialContext(ctx, network, address)
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// CheckURL checks that rawURL is an absolute http or https URL whose host is
+// not obviously internal: a blocked IP literal, localhost or the metadata
+// server, unless allow lists it. Names are only resolved when connecting, by
+// Control.
+func CheckURL(rawURL string, allow *Allowlist) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+	host := normalizeHost(u.Hostname())
+	if allow.allowsHost(host) {
+		return nil
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && IsBlocked(ip) {
+		return fmt.Errorf("url host %s is not a public address", host)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || host == "metadata.google.internal" || strings.HasSuffix(host, ".internal") {
+		return fmt.Errorf("url host %s is not a public address", host)
+	}
+	return nil
+}
+
+// normalizeHost lowercases host and drops a trailing dot, so that names compare equal.
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
@@ -0,0 +1,152 @@
+package egress
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsBlocked(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"8.8.8.8", false},
+		{"2001:4860:4860::8888", false},
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"192.168.1.1", true},
+		{"169.254.169.254", true},
+		{"fe80::1", true},
+		{"100.64.0.1", true},
+		{"0.0.0.0", true},
+		{"::ffff:127.0.0.1", true},
+		{"224.0.0.1", true},
+	}
+	for _, tt := range tests {
+		if got := IsBlocked(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("IsBlocked(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	allow, err := ParseAllowlist([]string{"hooks.test", "10.0.0.0/8", "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		url   string
+		allow *Allowlist
+		ok    bool
+	}{
+		{"https://hooks.example.com/jennah", nil, true},
+		{"http://203.0.113.7:8080/hook", nil, true},
+		{"ftp://hooks.example.com/", nil, false},
+		{"/relative", nil, false},
+		{"https://", nil, false},
+		{"http://127.0.0.1/", nil, false},
+		{"http://[::1]:8080/", nil, false},
+		{"http://localhost:8080/", nil, false},
+		{"http://LOCALHOST./", nil, false},
+		{"http://app.localhost/", nil, false},
+		{"http://169.254.169.254/computeMetadata/v1/", nil, false},
+		{"http://metadata.google.internal/", nil, false},
+		{"http://db.internal/", nil, false},
+		{"http://10.0.0.5/", nil, false},
+		{"http://10.0.0.5/", allow, true},
+		{"http://127.0.0.1:9000/", allow, true},
+		{"http://127.0.0.2:9000/", allow, false},
+		{"http://Hooks.Test./hook", allow, true},
+		{"http://localhost/", allow, false},
+		{"http://169.254.169.254/", allow, false},
+	}
+	for _, tt := range tests {
+		err := CheckURL(tt.url, tt.allow)
+		if (err == nil) != tt.ok {
+			t.Errorf("CheckURL(%q, allowed %v) = %v, want ok %v", tt.url, tt.allow != nil, err, tt.ok)
+		}
+	}
+}
+
+func TestParseAllowlist(t *testing.T) {
+	if a, err := ParseAllowlist(nil); a != nil || err != nil {
+		t.Errorf("ParseAllowlist(nil) = %v, %v, want nil, nil", a, err)
+	}
+	for _, entry := range []string{"", "localhost:8080", "http://localhost", "10.0.0.0/33", "user@host"} {
+		if _, err := ParseAllowlist([]string{entry}); err == nil {
+			t.Errorf("ParseAllowlist(%q) succeeded, want an error", entry)
+		}
+	}
+}
+
+func TestClientRefusesInternalAddresses(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(time.Second, nil).Get(srv.URL)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("Get(%s) error = %v, want ErrBlockedAddress", srv.URL, err)
+	}
+	if hits != 0 {
+		t.Fatalf("server got %d requests, want none", hits)
+	}
+
+	tests := []struct {
+		name    string
+		entries []string
+		url     string
+	}{
+		{"network", []string{"127.0.0.0/8"}, srv.URL},
+		{"address", []string{"127.0.0.1"}, srv.URL},
+		{"host name", []string{"localhost"}, strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allow, err := ParseAllowlist(tt.entries)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := NewClient(time.Second, allow).Get(tt.url)
+			if err != nil {
+				t.Fatalf("Get(%s) allowing %v: %v", tt.url, tt.entries, err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestClientDoesNotFollowRedirects(t *testing.T) {
+	var followed bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/elsewhere", http.StatusFound)
+	})
+	mux.HandleFunc("/elsewhere", func(w http.ResponseWriter, r *http.Request) {
+		followed = true
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	allow, err := ParseAllowlist([]string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := NewClient(time.Second, allow).Get(srv.URL + "/hook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound || followed {
+		t.Errorf("status = %d, followed = %v, want %d without following", resp.StatusCode, followed, http.StatusFound)
+	}
+}
@@ -0,0 +1,25 @@
+// Package events publishes job lifecycle events, jennah.events.v1.JobEvent
+// messages, to a message bus that other systems such as a data warehouse read
+// them from. The workers queue events in Spanner with the change that caused
+// them and publish them with a Publisher; see the worker README.
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/alphauslabs/jennah/gen/proto/events/v1"
+)
+
+// Publisher publishes job events. A nil error from Publish means the bus has
+// stored the event; on an error the caller publishes it again later, so events
+// are delivered at least once. Events of the same job are delivered in the
+// order they were published.
+type Publisher interface {
+	Publish(ctx context.Context, event *eventsv1.JobEvent) error
+	Close() error
+}
+
+// OrderingKey is the key that orders a job's events: its job ID.
+func OrderingKey(event *eventsv1.JobEvent) string {
+	return event.JobId
+}
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	eventsv1 "github.com/alphauslabs/jennah/gen/proto/events/v1"
+)
+
+// MemoryPublisher keeps published events in memory, for tests and local runs.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []*eventsv1.JobEvent
+	err    error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish stores a copy of event, or returns the error set with SetError.
+func (p *MemoryPublisher) Publish(ctx context.Context, event *eventsv1.JobEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, proto.Clone(event).(*eventsv1.JobEvent))
+	return nil
+}
+
+// SetError makes Publish fail with err, to simulate an unavailable bus, until
+// it is called again with nil.
+func (p *MemoryPublisher) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+// Events returns the events published so far, oldest first.
+func (p *MemoryPublisher) Events() []*eventsv1.JobEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*eventsv1.JobEvent(nil), p.events...)
+}
+
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	eventsv1 "github.com/alphauslabs/jennah/gen/proto/events/v1"
+)
+
+func TestMemoryPublisherOrdering(t *testing.T) {
+	ctx := context.Background()
+	p := NewMemoryPublisher()
+	published := []*eventsv1.JobEvent{
+		{EventId: "a1", JobId: "job-a", Sequence: 1, Status: "PENDING"},
+		{EventId: "b1", JobId: "job-b", Sequence: 1, Status: "PENDING"},
+		{EventId: "a2", JobId: "job-a", Sequence: 2, Status: "SCHEDULED"},
+		{EventId: "a3", JobId: "job-a", Sequence: 3, Status: "RUNNING"},
+		{EventId: "b2", JobId: "job-b", Sequence: 2, Status: "CANCELLED"},
+	}
+	for _, event := range published {
+		if err := p.Publish(ctx, event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Publish keeps a copy, so later changes to the caller's event do not leak in
+	published[0].Status = "FAILED"
+
+	got := p.Events()
+	if len(got) != len(published) {
+		t.Fatalf("got %d events, want %d", len(got), len(published))
+	}
+	for i, event := range got {
+		if event.EventId != published[i].EventId {
+			t.Errorf("event %d = %s, want %s", i, event.EventId, published[i].EventId)
+		}
+	}
+	if got[0].Status != "PENDING" {
+		t.Errorf("stored event status = %s, want the PENDING it was published with", got[0].Status)
+	}
+
+	last := make(map[string]int64)
+	for _, event := range got {
+		key := OrderingKey(event)
+		if event.Sequence <= last[key] {
+			t.Errorf("job %s: sequence %d after %d", key, event.Sequence, last[key])
+		}
+		last[key] = event.Sequence
+	}
+}
+
+func TestMemoryPublisherRedelivery(t *testing.T) {
+	ctx := context.Background()
+	unavailable := errors.New("bus unavailable")
+	event := &eventsv1.JobEvent{EventId: "a1", JobId: "job-a", Sequence: 1}
+
+	tests := []struct {
+		name   string
+		err    error // set before the attempt
+		want   error
+		stored int // events stored after the attempt
+	}{
+		{"bus down", unavailable, unavailable, 0},
+		{"still down", unavailable, unavailable, 0},
+		{"recovered", nil, nil, 1},
+		// An acknowledgement lost after the bus stored the event makes the
+		// caller publish it again; consumers dedupe by event_id
+		{"redelivered", nil, nil, 2},
+	}
+	p := NewMemoryPublisher()
+	for _, tt := range tests {
+		p.SetError(tt.err)
+		if err := p.Publish(ctx, event); !errors.Is(err, tt.want) {
+			t.Fatalf("%s: Publish = %v, want %v", tt.name, err, tt.want)
+		}
+		got := p.Events()
+		if len(got) != tt.stored {
+			t.Fatalf("%s: %d events stored, want %d", tt.name, len(got), tt.stored)
+		}
+		for _, stored := range got {
+			if stored.EventId != event.EventId {
+				t.Errorf("%s: stored event %s, want %s", tt.name, stored.EventId, event.EventId)
+			}
+		}
+	}
+}
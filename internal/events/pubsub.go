@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/pubsub/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	eventsv1 "github.com/alphauslabs/jennah/gen/proto/events/v1"
+)
+
+// PubSubPublisher publishes job events to a GCP Pub/Sub topic as JSON, with the
+// job ID as ordering key. Subscriptions must enable message ordering for the
+// order to be kept.
+type PubSubPublisher struct {
+	client    *pubsub.Client
+	publisher *pubsub.Publisher
+}
+
+// NewPubSubPublisher publishes to topic, a topic ID in project or a full
+// "projects/P/topics/T" name. The topic must exist.
+func NewPubSubPublisher(ctx context.Context, project, topic string) (*PubSubPublisher, error) {
+	client, err := pubsub.NewClient(ctx, project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Pub/Sub client: %w", err)
+	}
+	publisher := client.Publisher(topic)
+	publisher.EnableMessageOrdering = true
+	return &PubSubPublisher{client: client, publisher: publisher}, nil
+}
+
+// Publish publishes event and waits for Pub/Sub to store it. Attributes carry
+// the schema, type and IDs so subscribers can filter without decoding the data.
+func (p *PubSubPublisher) Publish(ctx context.Context, event *eventsv1.JobEvent) error {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode job event: %w", err)
+	}
+	key := OrderingKey(event)
+	result := p.publisher.Publish(ctx, &pubsub.Message{
+		Data:        data,
+		OrderingKey: key,
+		Attributes: map[string]string{
+			"schema":       string(proto.MessageName(event)),
+			"content_type": "application/json",
+			"event_type":   event.Type.String(),
+			"tenant_id":    event.TenantId,
+			"job_id":       event.JobId,
+		},
+	})
+	if _, err := result.Get(ctx); err != nil {
+		// A failed publish pauses its ordering key so later events cannot
+		// overtake it; resume it so the retry can go out
+		p.publisher.ResumePublish(key)
+		return fmt.Errorf("failed to publish job event: %w", err)
+	}
+	return nil
+}
+
+// Close flushes pending events and releases the client.
+func (p *PubSubPublisher) Close() error {
+	p.publisher.Stop()
+	return p.client.Close()
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/binary"
 
 	"github.com/buraksezer/consistent"
+
+	"github.com/alphauslabs/jennah/internal/metrics"
 )
 
 type Member string
@@ -42,7 +44,18 @@ func NewRouter(members []string) *Router {
 }
 
 func (r *Router) GetWorkerIP(tenantID string) string {
+	owner := r.Owner(tenantID)
+	if owner == "" {
+		metrics.RouterRequests.WithLabelValues("none").Inc()
+		return ""
+	}
+	metrics.RouterRequests.WithLabelValues(owner).Inc()
+	return owner
+}
 
+// Owner returns the worker a tenant is assigned to, or "" if there are no
+// workers. Unlike GetWorkerIP, it does not count as a routed request.
+func (r *Router) Owner(tenantID string) string {
 	member := r.ring.LocateKey([]byte(tenantID))
 	if member == nil {
 		return ""
@@ -0,0 +1,97 @@
+// Package imageref parses container image references such as
+// "asia-docker.pkg.dev/labs-169405/jobs/report:1.4" or
+// "ubuntu@sha256:<hex>", the way Docker and OCI registries read them.
+package imageref
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DockerHub is the registry of references that do not name one, e.g. "ubuntu".
+const DockerHub = "docker.io"
+
+var (
+	digestPattern = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+	tagPattern    = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	// Path components are lowercase, separated by ".", "_", "__" or runs of "-"
+	componentPattern = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+)
+
+// Reference is a parsed image reference. Tag and Digest are empty when the
+// reference does not have them.
+type Reference struct {
+	Registry   string // Host and optional port, e.g. "asia.gcr.io" or "localhost:5000"
+	Repository string // Path within the registry, e.g. "labs-169405/report"
+	Tag        string
+	Digest     string // "sha256:<hex>"
+}
+
+// Parse parses an image reference. A reference without a registry is on
+// Docker Hub, where single-component names are under "library/".
+func Parse(ref string) (Reference, error) {
+	var r Reference
+	if ref == "" {
+		return r, fmt.Errorf("image reference is empty")
+	}
+
+	name := ref
+	if at := strings.LastIndex(name, "@"); at >= 0 {
+		name, r.Digest = name[:at], name[at+1:]
+		if !digestPattern.MatchString(r.Digest) {
+			return r, fmt.Errorf("image %q: digest must be sha256: followed by 64 lowercase hex digits", ref)
+		}
+	}
+	if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+		name, r.Tag = name[:colon], name[colon+1:]
+		if !tagPattern.MatchString(r.Tag) {
+			return r, fmt.Errorf("image %q: invalid tag %q", ref, r.Tag)
+		}
+	}
+
+	// The first component is a registry if it looks like a host: it has a
+	// dot or a port, or is localhost
+	first, rest, found := strings.Cut(name, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		r.Registry, r.Repository = first, rest
+	} else {
+		r.Registry, r.Repository = DockerHub, name
+		if !found {
+			r.Repository = "library/" + name
+		}
+	}
+	for _, component := range strings.Split(r.Repository, "/") {
+		if !componentPattern.MatchString(component) {
+			return r, fmt.Errorf("image %q: invalid repository %q", ref, r.Repository)
+		}
+	}
+	return r, nil
+}
+
+// Name is the registry and repository, without tag or digest, e.g.
+// "docker.io/library/ubuntu".
+func (r Reference) Name() string {
+	return r.Registry + "/" + r.Repository
+}
+
+// String formats the reference in full, with its tag and digest if set.
+func (r Reference) String() string {
+	s := r.Name()
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// Pinned is the reference by digest alone, e.g.
+// "docker.io/library/ubuntu@sha256:<hex>". It is empty if Digest is.
+func (r Reference) Pinned() string {
+	if r.Digest == "" {
+		return ""
+	}
+	return r.Name() + "@" + r.Digest
+}
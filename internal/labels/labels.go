@@ -0,0 +1,113 @@
+// Package labels validates job labels and annotations and parses
+// Kubernetes-style label selectors such as "team=billing,env!=dev".
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Limits from the GCP label rules, which apply since labels are copied to Batch jobs
+const (
+	MaxLabels      = 64
+	maxKeyLength   = 63
+	maxValueLength = 63
+)
+
+// Annotations are never sent to GCP, so they only have size limits
+const (
+	MaxAnnotations           = 64
+	maxAnnotationKeyLength   = 253
+	maxAnnotationValueLength = 4096
+)
+
+var (
+	keyPattern   = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+	valuePattern = regexp.MustCompile(`^[a-z0-9_-]*$`)
+)
+
+// Validate checks labels against the GCP label rules: at most 64 labels, keys of
+// 1-63 lowercase letters, digits, underscores or hyphens starting with a letter,
+// and values of at most 63 of the same characters. Keys starting with "goog" are
+// reserved by Google.
+func Validate(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("at most %d labels are allowed, got %d", MaxLabels, len(labels))
+	}
+	var errs []error
+	for _, key := range sortedKeys(labels) {
+		if err := validateKey(key); err != nil {
+			errs = append(errs, err)
+		}
+		if err := validateValue(key, labels[key]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateKey(key string) error {
+	if len(key) > maxKeyLength || !keyPattern.MatchString(key) {
+		return fmt.Errorf("label key %q must be 1-%d lowercase letters, digits, underscores or hyphens, starting with a letter", key, maxKeyLength)
+	}
+	if strings.HasPrefix(key, "goog") {
+		return fmt.Errorf("label key %q uses the reserved prefix goog", key)
+	}
+	return nil
+}
+
+func validateValue(key, value string) error {
+	if len(value) > maxValueLength || !valuePattern.MatchString(value) {
+		return fmt.Errorf("label %q: value %q must be at most %d lowercase letters, digits, underscores or hyphens", key, value, maxValueLength)
+	}
+	return nil
+}
+
+// ValidateAnnotations checks annotation sizes. Keys may not contain "=" or
+// whitespace, since annotations are stored as key=value strings.
+func ValidateAnnotations(annotations map[string]string) error {
+	if len(annotations) > MaxAnnotations {
+		return fmt.Errorf("at most %d annotations are allowed, got %d", MaxAnnotations, len(annotations))
+	}
+	var errs []error
+	for _, key := range sortedKeys(annotations) {
+		if key == "" || len(key) > maxAnnotationKeyLength || strings.ContainsAny(key, "= \t\r\n") {
+			errs = append(errs, fmt.Errorf("annotation key %q must be 1-%d characters without '=' or whitespace", key, maxAnnotationKeyLength))
+		}
+		if len(annotations[key]) > maxAnnotationValueLength {
+			errs = append(errs, fmt.Errorf("annotation %q: value must be at most %d bytes", key, maxAnnotationValueLength))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Join encodes a map as sorted "key=value" strings, the form labels and
+// annotations are stored in.
+func Join(m map[string]string) []string {
+	pairs := make([]string, 0, len(m))
+	for _, key := range sortedKeys(m) {
+		pairs = append(pairs, key+"="+m[key])
+	}
+	return pairs
+}
+
+// Split decodes "key=value" strings written by Join.
+func Split(pairs []string) map[string]string {
+	if len(pairs) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, _ := strings.Cut(pair, "=")
+		m[key] = value
+	}
+	return m
+}
+
+func sortedKeys(m map[string]string) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,146 @@
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Operator is how a Requirement compares a label
+type Operator string
+
+const (
+	Equals       Operator = "="
+	NotEquals    Operator = "!="
+	In           Operator = "in"
+	NotIn        Operator = "notin"
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+)
+
+// Requirement is one comma-separated term of a selector
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string // One value for Equals and NotEquals, none for Exists and DoesNotExist
+}
+
+// Selector matches labels that meet all of its requirements. An empty selector
+// matches everything.
+type Selector []Requirement
+
+// setPattern matches set-based requirements such as "env in (prod, staging)"
+var setPattern = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// ParseSelector parses a Kubernetes-style label selector. Supported terms are
+// key=value (or key==value), key!=value, key in (v1,v2), key notin (v1,v2),
+// key and !key. As in Kubernetes, != and notin also match jobs without the key.
+func ParseSelector(s string) (Selector, error) {
+	terms, err := splitTerms(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var selector Selector
+	for _, term := range terms {
+		req, err := parseRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, req)
+	}
+	return selector, nil
+}
+
+// splitTerms splits a selector on the commas that are not inside parentheses.
+func splitTerms(s string) ([]string, error) {
+	var terms []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("label selector %q has an unmatched ')'", s)
+			}
+		case ',':
+			if depth == 0 {
+				terms = append(terms, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("label selector %q has an unmatched '('", s)
+	}
+	terms = append(terms, s[start:])
+
+	if len(terms) == 1 && strings.TrimSpace(terms[0]) == "" {
+		return nil, nil
+	}
+	for i, term := range terms {
+		terms[i] = strings.TrimSpace(term)
+		if terms[i] == "" {
+			return nil, fmt.Errorf("label selector %q has an empty term", s)
+		}
+	}
+	return terms, nil
+}
+
+func parseRequirement(term string) (Requirement, error) {
+	var req Requirement
+	switch {
+	case strings.HasPrefix(term, "!") && !strings.Contains(term, "="):
+		req = Requirement{Key: strings.TrimSpace(term[1:]), Operator: DoesNotExist}
+	case setPattern.MatchString(term):
+		m := setPattern.FindStringSubmatch(term)
+		req = Requirement{Key: m[1], Operator: Operator(m[2])}
+		for _, value := range strings.Split(m[3], ",") {
+			req.Values = append(req.Values, strings.TrimSpace(value))
+		}
+	case strings.Contains(term, "!="):
+		key, value, _ := strings.Cut(term, "!=")
+		req = Requirement{Key: strings.TrimSpace(key), Operator: NotEquals, Values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(term, "="):
+		key, value, _ := strings.Cut(term, "=")
+		value = strings.TrimPrefix(value, "=")
+		req = Requirement{Key: strings.TrimSpace(key), Operator: Equals, Values: []string{strings.TrimSpace(value)}}
+	default:
+		req = Requirement{Key: term, Operator: Exists}
+	}
+
+	if len(req.Key) > maxKeyLength || !keyPattern.MatchString(req.Key) {
+		return req, fmt.Errorf("label selector term %q: %q is not a valid label key", term, req.Key)
+	}
+	for _, value := range req.Values {
+		if err := validateValue(req.Key, value); err != nil {
+			return req, fmt.Errorf("label selector term %q: %w", term, err)
+		}
+	}
+	return req, nil
+}
+
+// Matches reports whether labels meet every requirement of the selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, req := range s {
+		value, ok := labels[req.Key]
+		var match bool
+		switch req.Operator {
+		case Equals, In:
+			match = ok && slices.Contains(req.Values, value)
+		case NotEquals, NotIn:
+			match = !ok || !slices.Contains(req.Values, value)
+		case Exists:
+			match = ok
+		case DoesNotExist:
+			match = !ok
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the request ID from clients to the gateway, from the
+// gateway to workers, and back in responses.
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength bounds request IDs accepted from callers
+const maxRequestIDLength = 128
+
+// NewInterceptor starts a request scope on handlers and logs one line per RPC
+// with its procedure, code and duration. The request ID is taken from the
+// X-Request-Id header when it is well formed and generated otherwise; it is
+// echoed in the response. On clients it forwards the current request ID.
+func NewInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				if requestId := RequestID(ctx); requestId != "" {
+					req.Header().Set(RequestIDHeader, requestId)
+				}
+				return next(ctx, req)
+			}
+
+			requestId := req.Header().Get(RequestIDHeader)
+			if !validRequestID(requestId) {
+				requestId = uuid.New().String()
+			}
+			ctx = WithRequestID(ctx, requestId)
+
+			start := time.Now()
+			resp, err := next(ctx, req)
+			if resp != nil {
+				resp.Header().Set(RequestIDHeader, requestId)
+			}
+
+			attrs := []any{
+				"procedure", req.Spec().Procedure,
+				"peer", req.Peer().Addr,
+				"duration_ms", time.Since(start).Milliseconds(),
+			}
+			if err == nil {
+				slog.InfoContext(ctx, "RPC completed", append(attrs, "code", "ok")...)
+				return resp, nil
+			}
+
+			code := connect.CodeOf(err)
+			level := slog.LevelWarn
+			if code == connect.CodeInternal || code == connect.CodeUnknown || code == connect.CodeUnavailable {
+				level = slog.LevelError
+			}
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				connectErr.Meta().Set(RequestIDHeader, requestId)
+			}
+			slog.Log(ctx, level, "RPC failed", append(attrs, "code", code.String(), "error", err)...)
+			return resp, err
+		}
+	})
+}
+
+// validRequestID accepts short printable ASCII IDs, so callers cannot inject
+// log lines or oversized values.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
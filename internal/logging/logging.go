@@ -0,0 +1,155 @@
+// Package logging configures structured slog output for the gateway and worker
+// and carries per-request attributes (request_id, tenant_id, job_id) in the context.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Supported output formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// redacted replaces the value of attributes that may hold credentials
+const redacted = "[REDACTED]"
+
+// secretKeys are attribute keys whose values are never written, whatever the caller passes
+var secretKeys = []string{"authorization", "token", "id_token", "api_key", "key_secret", "secret", "password", "signing_key"}
+
+// Setup installs the default slog logger writing to stderr in format at level
+// (debug, info, warn or error). The standard log package is routed through it.
+func Setup(format, level string) error {
+	logger, err := New(os.Stderr, format, level)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+	return nil
+}
+
+// New returns a logger writing to w that adds request attributes from the
+// context and redacts secret attributes.
+func New(w io.Writer, format, level string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: use debug, info, warn or error", level)
+	}
+
+	options := &slog.HandlerOptions{Level: lvl, ReplaceAttr: redact}
+	var handler slog.Handler
+	switch format {
+	case "", FormatText:
+		handler = slog.NewTextHandler(w, options)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, options)
+	default:
+		return nil, fmt.Errorf("invalid log format %q: use %s or %s", format, FormatText, FormatJSON)
+	}
+	return slog.New(contextHandler{handler}), nil
+}
+
+func redact(groups []string, a slog.Attr) slog.Attr {
+	if slices.Contains(secretKeys, strings.ToLower(a.Key)) {
+		return slog.String(a.Key, redacted)
+	}
+	return a
+}
+
+// EnvVars logs the names of environment variables passed to a job. Values may
+// hold credentials and are never logged.
+func EnvVars(env map[string]string) slog.Attr {
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return slog.Any("env_vars", names)
+}
+
+type scopeKey struct{}
+
+// scope holds the attributes of one request. It is shared by everything below
+// the logging interceptor, so IDs learned deep in a handler still appear on the
+// interceptor's completion line.
+type scope struct {
+	mu        sync.Mutex
+	requestId string
+	tenantId  string
+	jobId     string
+}
+
+// WithRequestID starts a request scope carrying requestId.
+func WithRequestID(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &scope{requestId: requestId})
+}
+
+// RequestID returns the request ID of the current request, or "".
+func RequestID(ctx context.Context) string {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return ""
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requestId
+}
+
+// SetTenantID attaches tenant_id to every later log line of the current request.
+// It does nothing outside a request.
+func SetTenantID(ctx context.Context, tenantId string) {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		s.mu.Lock()
+		s.tenantId = tenantId
+		s.mu.Unlock()
+	}
+}
+
+// SetJobID attaches job_id to every later log line of the current request.
+// It does nothing outside a request.
+func SetJobID(ctx context.Context, jobId string) {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		s.mu.Lock()
+		s.jobId = jobId
+		s.mu.Unlock()
+	}
+}
+
+// contextHandler adds the request scope's attributes to each record.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		s.mu.Lock()
+		if s.requestId != "" {
+			r.AddAttrs(slog.String("request_id", s.requestId))
+		}
+		if s.tenantId != "" {
+			r.AddAttrs(slog.String("tenant_id", s.tenantId))
+		}
+		if s.jobId != "" {
+			r.AddAttrs(slog.String("job_id", s.jobId))
+		}
+		s.mu.Unlock()
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
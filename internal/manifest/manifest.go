@@ -0,0 +1,166 @@
+// Package manifest reads job manifests: versioned YAML or JSON files that
+// describe a job, so that job definitions can be kept in version control.
+// See docs/job-manifest.md for the format.
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gopkg.in/yaml.v3"
+
+	jennahv1 "github.com/alphauslabs/jennah/gen/proto"
+)
+
+// Supported manifest versions and kinds
+const (
+	APIVersionV1 = "jennah/v1"
+	KindJob      = "Job"
+)
+
+var supportedVersions = []string{APIVersionV1}
+
+// Job is a jennah/v1 Job manifest. Field names follow the API's JSON names.
+type Job struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Kind       string   `yaml:"kind"`
+	Metadata   Metadata `yaml:"metadata"`
+	Spec       JobSpec  `yaml:"spec"`
+}
+
+// Metadata holds what identifies and describes a job rather than what it runs.
+type Metadata struct {
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// JobSpec is what a job runs and where.
+type JobSpec struct {
+	Image             string            `yaml:"image"`
+	Env               map[string]string `yaml:"env,omitempty"`
+	Resources         Resources         `yaml:"resources,omitempty"`
+	TaskCount         int64             `yaml:"taskCount,omitempty"`
+	Regions           Regions           `yaml:"regions,omitempty"`
+	ProvisioningModel string            `yaml:"provisioningModel,omitempty"`
+	Timeouts          Timeouts          `yaml:"timeouts,omitempty"`
+	Outputs           map[string]string `yaml:"outputs,omitempty"`
+}
+
+// Resources are per task. Zero values use the GCP Batch defaults.
+type Resources struct {
+	CpuMilli  int64 `yaml:"cpuMilli,omitempty"`
+	MemoryMib int64 `yaml:"memoryMib,omitempty"`
+}
+
+// Timeouts bound how long a job may take, as durations such as "1h30m". Zero
+// values are no limit.
+type Timeouts struct {
+	MaxRunDuration   time.Duration `yaml:"maxRunDuration,omitempty"`
+	MaxQueueDuration time.Duration `yaml:"maxQueueDuration,omitempty"`
+}
+
+// Regions restricts and orders the GCP regions a job may be placed in.
+type Regions struct {
+	Allowed   []string `yaml:"allowed,omitempty"`
+	Preferred []string `yaml:"preferred,omitempty"`
+}
+
+// header is read first to pick the decoder for the manifest's version.
+type header struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+}
+
+// Parse reads a YAML or JSON job manifest. Unknown fields are errors, so that
+// typos are not silently ignored.
+func Parse(data []byte) (*Job, error) {
+	var h header
+	if err := yaml.Unmarshal(data, &h); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	switch {
+	case h.APIVersion == "":
+		return nil, fmt.Errorf("manifest has no apiVersion; use %s", APIVersionV1)
+	case h.APIVersion != APIVersionV1:
+		return nil, fmt.Errorf("unsupported apiVersion %q; supported: %s", h.APIVersion, strings.Join(supportedVersions, ", "))
+	case h.Kind != KindJob:
+		return nil, fmt.Errorf("unsupported kind %q for %s; supported: %s", h.Kind, h.APIVersion, KindJob)
+	}
+
+	var job Job
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&job); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := job.validate(); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// validate runs the checks that need no server. ValidateJob runs the rest.
+func (j *Job) validate() error {
+	var errs []error
+	if j.Spec.Image == "" {
+		errs = append(errs, errors.New("spec.image is required"))
+	}
+	if j.Spec.Resources.CpuMilli < 0 || j.Spec.Resources.MemoryMib < 0 {
+		errs = append(errs, errors.New("spec.resources must not be negative"))
+	}
+	if j.Spec.TaskCount < 0 {
+		errs = append(errs, errors.New("spec.taskCount must not be negative"))
+	}
+	if j.Spec.Timeouts.MaxRunDuration < 0 || j.Spec.Timeouts.MaxQueueDuration < 0 {
+		errs = append(errs, errors.New("spec.timeouts must not be negative"))
+	}
+	for _, name := range slices.Sorted(maps.Keys(j.Spec.Outputs)) {
+		if !strings.HasPrefix(j.Spec.Outputs[name], "gs://") {
+			errs = append(errs, fmt.Errorf("spec.outputs.%s must be a gs:// URI pattern", name))
+		}
+	}
+	switch j.Spec.ProvisioningModel {
+	case "", "STANDARD", "SPOT", "PREEMPTIBLE":
+	default:
+		errs = append(errs, fmt.Errorf("spec.provisioningModel %q must be STANDARD, SPOT or PREEMPTIBLE", j.Spec.ProvisioningModel))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid manifest: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// SubmitJobRequest converts the manifest to the request that submits it.
+func (j *Job) SubmitJobRequest() *jennahv1.SubmitJobRequest {
+	req := &jennahv1.SubmitJobRequest{
+		ImageUri:          j.Spec.Image,
+		EnvVars:           j.Spec.Env,
+		TaskCount:         j.Spec.TaskCount,
+		AllowedRegions:    j.Spec.Regions.Allowed,
+		PreferredRegions:  j.Spec.Regions.Preferred,
+		Labels:            j.Metadata.Labels,
+		Annotations:       j.Metadata.Annotations,
+		ProvisioningModel: j.Spec.ProvisioningModel,
+		Outputs:           j.Spec.Outputs,
+	}
+	if j.Spec.Resources != (Resources{}) {
+		req.Resources = &jennahv1.ResourceRequirements{
+			CpuMilli:  j.Spec.Resources.CpuMilli,
+			MemoryMib: j.Spec.Resources.MemoryMib,
+		}
+	}
+	if j.Spec.Timeouts.MaxRunDuration > 0 {
+		req.MaxRunDuration = durationpb.New(j.Spec.Timeouts.MaxRunDuration)
+	}
+	if j.Spec.Timeouts.MaxQueueDuration > 0 {
+		req.MaxQueueDuration = durationpb.New(j.Spec.Timeouts.MaxQueueDuration)
+	}
+	return req
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// NewInterceptor records the latency and outcome of every unary RPC. Install it
+// as the outermost interceptor on handlers, so requests rejected by auth are
+// counted, and on clients to measure outgoing calls.
+func NewInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+
+			histogram := RPCServerDuration
+			if req.Spec().IsClient {
+				histogram = RPCClientDuration
+			}
+			histogram.WithLabelValues(req.Spec().Procedure, codeLabel(err)).Observe(time.Since(start).Seconds())
+			return resp, err
+		}
+	})
+}
+
+func codeLabel(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return connect.CodeOf(err).String()
+}
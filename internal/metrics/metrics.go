@@ -0,0 +1,134 @@
+// Package metrics defines the Prometheus metrics exported by the gateway and
+// the worker on /metrics.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "jennah"
+
+var (
+	// RPCServerDuration is the latency of handled RPCs, by procedure and connect code.
+	RPCServerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_server_duration_seconds",
+		Help:      "Latency of handled RPCs by procedure and connect code (\"ok\" on success).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"procedure", "code"})
+
+	// RPCClientDuration is the latency of outgoing RPCs, such as gateway-to-worker calls.
+	RPCClientDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_client_duration_seconds",
+		Help:      "Latency of outgoing RPCs by procedure and connect code (\"ok\" on success).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"procedure", "code"})
+
+	// RouterRequests counts tenant requests routed to each worker.
+	RouterRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "router_requests_total",
+		Help:      "Tenant requests routed to each worker by the consistent hashing router.",
+	}, []string{"worker"})
+
+	// SpannerDuration is the latency of database.Client methods.
+	SpannerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "spanner_call_duration_seconds",
+		Help:      "Latency of Spanner calls by database.Client method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// BatchDuration is the latency of GCP Batch API calls, by method and gRPC code.
+	BatchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "batch_call_duration_seconds",
+		Help:      "Latency of GCP Batch API calls by method and gRPC code (\"OK\" on success).",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"method", "code"})
+
+	// JobPlacements counts attempts to create a Batch job in a region, by result:
+	// "created", "quota_exceeded" (RESOURCE_EXHAUSTED, the next region is tried) or "error".
+	JobPlacements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_placements_total",
+		Help:      "Attempts to create a Batch job in each region by result.",
+	}, []string{"region", "result"})
+
+	// NotificationDeliveries counts webhook delivery attempts by result:
+	// "delivered", "retry" (scheduled again with backoff) or "dead_letter".
+	NotificationDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "notification_deliveries_total",
+		Help:      "Job notification webhook delivery attempts by result.",
+	}, []string{"result"})
+
+	// JobEventsPublished counts job event publish attempts by result: "published" or "error".
+	JobEventsPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_events_published_total",
+		Help:      "Job event publish attempts by result.",
+	}, []string{"result"})
+
+	// JobPreemptions counts jobs whose Spot or preemptible VMs were preempted, by
+	// result: "resubmitted", "fallback" (resubmitted on STANDARD VMs) or "error"
+	// (resubmission failed and the job was failed).
+	JobPreemptions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_preemptions_total",
+		Help:      "Jobs whose VMs were preempted by result.",
+	}, []string{"result"})
+
+	// JobTimeouts counts jobs that ended TIMED_OUT, by limit: "run" (a task
+	// exceeded max_run_duration) or "queue" (still queued after
+	// max_queue_duration).
+	JobTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_timeouts_total",
+		Help:      "Jobs that timed out by limit.",
+	}, []string{"limit"})
+
+	// Jobs is the number of jobs in each status, refreshed periodically by the worker.
+	Jobs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "jobs",
+		Help:      "Number of jobs in each status across all tenants.",
+	}, []string{"status"})
+)
+
+// Handler serves the default registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveBatch records a GCP Batch API call that started at start.
+func ObserveBatch(method string, start time.Time, err error) {
+	BatchDuration.WithLabelValues(method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+}
+
+// RegisterCache exports hit and miss counters for a cache. stats is called on
+// every scrape and must return monotonically increasing totals. The hit ratio is
+// rate(hits) / (rate(hits) + rate(misses)).
+func RegisterCache(name string, stats func() (hits, misses uint64)) {
+	for _, result := range []string{"hit", "miss"} {
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "cache_requests_total",
+			Help:        "Cache lookups by cache and result.",
+			ConstLabels: prometheus.Labels{"cache": name, "result": result},
+		}, func() float64 {
+			hits, misses := stats()
+			if result == "hit" {
+				return float64(hits)
+			}
+			return float64(misses)
+		}))
+	}
+}
@@ -0,0 +1,226 @@
+// Package policy checks jobs against rules set by platform admins: which
+// registries images may come from, whether they must be pinned by digest, how
+// many resources a job may ask for, which privileged options it may use and
+// which labels it must carry. See docs/job-policy.md.
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/alphauslabs/jennah/internal/imageref"
+	"github.com/alphauslabs/jennah/internal/labels"
+)
+
+// Rule names, as reported in violations.
+const (
+	RuleAllowedRegistries = "allowed_registries"
+	RuleRequireDigest     = "require_digest"
+	RuleMaxCpuMilli       = "max_cpu_milli"
+	RuleMaxMemoryMib      = "max_memory_mib"
+	RuleMaxTaskCount      = "max_task_count"
+	RuleMaxRunDuration    = "max_run_duration"
+	RuleForbiddenOptions  = "forbidden_options"
+	RuleRequiredLabels    = "required_labels"
+)
+
+// Privileged options a policy may forbid.
+const (
+	// OptionOutputs is declaring outputs, which the worker lists in Cloud
+	// Storage with its own credentials.
+	OptionOutputs = "outputs"
+	// OptionCredentialEnvVars is setting environment variables that change
+	// where the job's Google credentials come from, such as
+	// GOOGLE_APPLICATION_CREDENTIALS or GCE_METADATA_HOST.
+	OptionCredentialEnvVars = "credential_env_vars"
+)
+
+// Options lists the options a policy may forbid.
+var Options = []string{OptionOutputs, OptionCredentialEnvVars}
+
+// credentialEnvPrefixes are the environment variable prefixes covered by
+// OptionCredentialEnvVars.
+var credentialEnvPrefixes = []string{"GOOGLE_", "GCE_METADATA_", "CLOUDSDK_"}
+
+// Policy is a set of rules. Zero values turn a rule off.
+type Policy struct {
+	// Registries, optionally followed by a path, that images must come from,
+	// e.g. "asia.gcr.io/labs-169405". Images without a registry are on docker.io.
+	AllowedRegistries []string      `yaml:"allowedRegistries,omitempty"`
+	RequireDigest     bool          `yaml:"requireDigest,omitempty"`
+	MaxCpuMilli       int64         `yaml:"maxCpuMilli,omitempty"`    // Per task
+	MaxMemoryMib      int64         `yaml:"maxMemoryMib,omitempty"`   // Per task
+	MaxTaskCount      int64         `yaml:"maxTaskCount,omitempty"`   // Per job
+	MaxRunDuration    time.Duration `yaml:"maxRunDuration,omitempty"` // Jobs must set a max_run_duration of at most this
+	ForbiddenOptions  []string      `yaml:"forbiddenOptions,omitempty"`
+	RequiredLabels    []string      `yaml:"requiredLabels,omitempty"` // Label keys jobs must set to a non-empty value
+}
+
+// Job is what a policy is checked against: a job submission, with resources
+// and task count defaulted.
+type Job struct {
+	ImageUri       string
+	CpuMilli       int64
+	MemoryMib      int64
+	TaskCount      int64
+	MaxRunDuration time.Duration // 0 when unset
+	EnvVars        map[string]string
+	Outputs        map[string]string
+	Labels         map[string]string
+}
+
+// Violation is a rule a job breaks.
+type Violation struct {
+	Rule        string
+	Description string
+}
+
+func (v *Violation) Error() string {
+	return v.Rule + ": " + v.Description
+}
+
+// Load reads a YAML policy. Unknown fields are errors, so that a misspelt
+// rule is not silently off.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy: %w", err)
+	}
+
+	var p Policy
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&p); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Validate checks that the rules themselves are well-formed.
+func (p *Policy) Validate() error {
+	for _, registry := range p.AllowedRegistries {
+		if registry == "" || strings.Contains(registry, "://") || strings.ContainsAny(registry, "@ ") {
+			return fmt.Errorf("allowedRegistries: %q must be a registry host, optionally followed by a path", registry)
+		}
+	}
+	if p.MaxCpuMilli < 0 || p.MaxMemoryMib < 0 || p.MaxTaskCount < 0 || p.MaxRunDuration < 0 {
+		return errors.New("policy limits must not be negative")
+	}
+	for _, option := range p.ForbiddenOptions {
+		if !slices.Contains(Options, option) {
+			return fmt.Errorf("forbiddenOptions: unknown option %q; must be one of %v", option, Options)
+		}
+	}
+	required := make(map[string]string, len(p.RequiredLabels))
+	for _, key := range p.RequiredLabels {
+		required[key] = ""
+	}
+	if err := labels.Validate(required); err != nil {
+		return fmt.Errorf("requiredLabels: %w", err)
+	}
+	return nil
+}
+
+// IsEmpty reports whether the policy has no rules.
+func (p *Policy) IsEmpty() bool {
+	return p == nil || (len(p.AllowedRegistries) == 0 && !p.RequireDigest &&
+		p.MaxCpuMilli == 0 && p.MaxMemoryMib == 0 && p.MaxTaskCount == 0 && p.MaxRunDuration == 0 &&
+		len(p.ForbiddenOptions) == 0 && len(p.RequiredLabels) == 0)
+}
+
+// Check returns the first rule the job breaks, or nil if it breaks none. An
+// image reference that cannot be parsed is returned as an error when a rule
+// needs it. A nil policy allows everything.
+func (p *Policy) Check(job *Job) (*Violation, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	if len(p.AllowedRegistries) > 0 || p.RequireDigest {
+		ref, err := imageref.Parse(job.ImageUri)
+		if err != nil {
+			return nil, err
+		}
+		if len(p.AllowedRegistries) > 0 && !slices.ContainsFunc(p.AllowedRegistries, func(registry string) bool {
+			name := ref.Name()
+			return name == registry || strings.HasPrefix(name, strings.TrimSuffix(registry, "/")+"/")
+		}) {
+			return &Violation{RuleAllowedRegistries,
+				fmt.Sprintf("image %s is not from an allowed registry (%s)", ref.Name(), strings.Join(p.AllowedRegistries, ", "))}, nil
+		}
+		if p.RequireDigest && ref.Digest == "" {
+			return &Violation{RuleRequireDigest,
+				fmt.Sprintf("image %s must be pinned by digest, e.g. %s@sha256:...", job.ImageUri, ref.Name())}, nil
+		}
+	}
+
+	if p.MaxCpuMilli > 0 && job.CpuMilli > p.MaxCpuMilli {
+		return &Violation{RuleMaxCpuMilli,
+			fmt.Sprintf("job requests %d cpu milli per task, limit is %d", job.CpuMilli, p.MaxCpuMilli)}, nil
+	}
+	if p.MaxMemoryMib > 0 && job.MemoryMib > p.MaxMemoryMib {
+		return &Violation{RuleMaxMemoryMib,
+			fmt.Sprintf("job requests %d MiB per task, limit is %d", job.MemoryMib, p.MaxMemoryMib)}, nil
+	}
+	if p.MaxTaskCount > 0 && job.TaskCount > p.MaxTaskCount {
+		return &Violation{RuleMaxTaskCount,
+			fmt.Sprintf("job requests %d tasks, limit is %d", job.TaskCount, p.MaxTaskCount)}, nil
+	}
+	if p.MaxRunDuration > 0 {
+		if job.MaxRunDuration == 0 {
+			return &Violation{RuleMaxRunDuration,
+				fmt.Sprintf("job must set a max_run_duration of at most %s", p.MaxRunDuration)}, nil
+		}
+		if job.MaxRunDuration > p.MaxRunDuration {
+			return &Violation{RuleMaxRunDuration,
+				fmt.Sprintf("job sets max_run_duration %s, limit is %s", job.MaxRunDuration, p.MaxRunDuration)}, nil
+		}
+	}
+
+	for _, option := range p.ForbiddenOptions {
+		if description := usesOption(job, option); description != "" {
+			return &Violation{RuleForbiddenOptions, description}, nil
+		}
+	}
+
+	for _, key := range p.RequiredLabels {
+		if job.Labels[key] == "" {
+			return &Violation{RuleRequiredLabels,
+				fmt.Sprintf("job must have the label %q (required: %s)", key, strings.Join(p.RequiredLabels, ", "))}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// usesOption describes how the job uses a forbidden option, or returns "" if
+// it does not.
+func usesOption(job *Job, option string) string {
+	switch option {
+	case OptionOutputs:
+		if len(job.Outputs) > 0 {
+			return "job may not declare outputs"
+		}
+	case OptionCredentialEnvVars:
+		for _, name := range slices.Sorted(maps.Keys(job.EnvVars)) {
+			for _, prefix := range credentialEnvPrefixes {
+				if strings.HasPrefix(strings.ToUpper(name), prefix) {
+					return fmt.Sprintf("job may not set the credential environment variable %s", name)
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,294 @@
+// Package registry resolves container image tags to the digests they point
+// to, through the OCI distribution API that Docker Hub, Artifact Registry,
+// Container Registry and local registries all serve.
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/alphauslabs/jennah/internal/imageref"
+)
+
+// Errors returned by Resolve, wrapped with the image they are about.
+var (
+	ErrNotFound     = errors.New("image not found")
+	ErrUnauthorized = errors.New("not authorized to pull image")
+	// ErrLoopbackRegistry is returned for registries on the worker's own
+	// host, such as localhost:5000, that are not listed as insecure
+	ErrLoopbackRegistry = errors.New("loopback registries are not allowed")
+)
+
+// manifestTypes are the manifest media types asked for, so that multi-platform
+// images resolve to their index rather than one platform's manifest.
+var manifestTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// Largest manifest read when a registry does not report the digest itself.
+const maxManifestBytes = 4 << 20
+
+// Resolver resolves image references against their registries.
+type Resolver struct {
+	client   *http.Client
+	tokens   oauth2.TokenSource
+	insecure map[string]bool
+}
+
+// NewResolver creates a Resolver. tokens, if not nil, supplies Google access
+// tokens for gcr.io and pkg.dev registries; other registries are accessed
+// anonymously. insecureRegistries are reached over plain HTTP, and are the
+// only loopback registries, such as localhost:5000, that may be used.
+func NewResolver(tokens oauth2.TokenSource, insecureRegistries []string) *Resolver {
+	insecure := make(map[string]bool, len(insecureRegistries))
+	for _, registry := range insecureRegistries {
+		insecure[registry] = true
+	}
+	return &Resolver{
+		client:   &http.Client{Timeout: 30 * time.Second},
+		tokens:   tokens,
+		insecure: insecure,
+	}
+}
+
+// Resolve returns ref with its Digest set to the digest of the manifest its
+// tag points to, "latest" if it has none. References already pinned by digest
+// are returned as they are, without contacting the registry.
+func (r *Resolver) Resolve(ctx context.Context, ref imageref.Reference) (imageref.Reference, error) {
+	if ref.Digest != "" {
+		return ref, nil
+	}
+	tag := ref.Tag
+	if tag == "" {
+		tag = "latest"
+	}
+
+	if !r.insecure[ref.Registry] && isLoopback(ref.Registry) {
+		return ref, fmt.Errorf("%w: %s is not in the worker's insecure registries", ErrLoopbackRegistry, ref.Registry)
+	}
+
+	manifestURL := r.baseURL(ref.Registry) + "/v2/" + ref.Repository + "/manifests/" + tag
+	resp, err := r.get(ctx, http.MethodHead, manifestURL, ref)
+	if err != nil {
+		return ref, err
+	}
+	resp.Body.Close()
+	digest := resp.Header.Get("Docker-Content-Digest")
+
+	if digest == "" {
+		// The header is optional; the digest is then that of the manifest itself
+		resp, err := r.get(ctx, http.MethodGet, manifestURL, ref)
+		if err != nil {
+			return ref, err
+		}
+		defer resp.Body.Close()
+		hash := sha256.New()
+		if _, err := io.Copy(hash, io.LimitReader(resp.Body, maxManifestBytes)); err != nil {
+			return ref, fmt.Errorf("failed to read manifest of %s: %w", ref, err)
+		}
+		digest = "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	}
+
+	resolved := ref
+	resolved.Digest = digest
+	if _, err := imageref.Parse(resolved.String()); err != nil {
+		return ref, fmt.Errorf("registry returned an invalid digest for %s: %w", ref, err)
+	}
+	return resolved, nil
+}
+
+// baseURL is where a registry serves the distribution API.
+func (r *Resolver) baseURL(registry string) string {
+	if r.insecure[registry] {
+		return "http://" + registry
+	}
+	if registry == imageref.DockerHub {
+		return "https://registry-1.docker.io"
+	}
+	return "https://" + registry
+}
+
+// isLoopback reports whether a registry is on the worker's own host: localhost
+// or a loopback or unspecified IP address, on any port.
+func isLoopback(registry string) bool {
+	host := registry
+	if h, _, err := net.SplitHostPort(registry); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip, err := netip.ParseAddr(host)
+	return err == nil && (ip.Unmap().IsLoopback() || ip.IsUnspecified())
+}
+
+// isGoogle reports whether a registry takes Google access tokens.
+func isGoogle(registry string) bool {
+	return registry == "gcr.io" || strings.HasSuffix(registry, ".gcr.io") || strings.HasSuffix(registry, "-docker.pkg.dev")
+}
+
+// get requests a manifest, answering an authentication challenge if the
+// registry sends one, and returns a 200 response.
+func (r *Resolver) get(ctx context.Context, method, manifestURL string, ref imageref.Reference) (*http.Response, error) {
+	resp, err := r.do(ctx, method, manifestURL, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		authorization, err := r.authorize(ctx, resp.Header.Get("WWW-Authenticate"), ref)
+		if err != nil {
+			return nil, err
+		}
+		if resp, err = r.do(ctx, method, manifestURL, authorization); err != nil {
+			return nil, err
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s", ErrUnauthorized, ref)
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("registry %s returned %s for %s", ref.Registry, resp.Status, ref)
+	}
+}
+
+func (r *Resolver) do(ctx context.Context, method, url, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach registry: %w", err)
+	}
+	return resp, nil
+}
+
+// authorize answers a WWW-Authenticate challenge with an Authorization header
+// value. Bearer challenges are exchanged for a pull token at the registry's
+// token service; Basic ones are answered with a Google access token.
+func (r *Resolver) authorize(ctx context.Context, challenge string, ref imageref.Reference) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	username, password, err := r.credentials(ref.Registry)
+	if err != nil {
+		return "", err
+	}
+
+	switch scheme {
+	case "basic":
+		if username == "" {
+			return "", fmt.Errorf("%w: %s requires credentials", ErrUnauthorized, ref.Registry)
+		}
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(username, password)
+		return req.Header.Get("Authorization"), nil
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return "", fmt.Errorf("registry %s sent a bearer challenge without a valid realm", ref.Registry)
+		}
+		query := realm.Query()
+		if service := params["service"]; service != "" {
+			query.Set("service", service)
+		}
+		query.Set("scope", "repository:"+ref.Repository+":pull")
+		realm.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		if username != "" {
+			req.SetBasicAuth(username, password)
+		}
+		resp, err := r.client.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("failed to get registry token: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("%w: %s token service returned %s", ErrUnauthorized, ref.Registry, resp.Status)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return "", fmt.Errorf("failed to parse registry token: %w", err)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		return "Bearer " + token.Token, nil
+	default:
+		return "", fmt.Errorf("%w: unsupported authentication scheme %q from %s", ErrUnauthorized, scheme, ref.Registry)
+	}
+}
+
+// credentials returns the username and password for a registry: a Google
+// access token for Google registries, none for others.
+func (r *Resolver) credentials(registry string) (username, password string, err error) {
+	if r.tokens == nil || !isGoogle(registry) {
+		return "", "", nil
+	}
+	token, err := r.tokens.Token()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get Google access token: %w", err)
+	}
+	return "oauth2accesstoken", token.AccessToken, nil
+}
+
+// parseChallenge splits a WWW-Authenticate header such as
+// `Bearer realm="https://auth.docker.io/token",service="registry.docker.io"`
+// into its lowercased scheme and parameters.
+func parseChallenge(header string) (scheme string, params map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params = make(map[string]string)
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				break
+			}
+			params[strings.ToLower(strings.TrimSpace(key))] = value[1 : end+1]
+			rest = strings.TrimPrefix(value[end+2:], ",")
+		} else {
+			value, rest, _ = strings.Cut(value, ",")
+			params[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
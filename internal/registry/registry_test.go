@@ -0,0 +1,164 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alphauslabs/jennah/internal/imageref"
+)
+
+const (
+	headDigest    = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	privateDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	manifest      = `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[]}`
+)
+
+// newTestRegistry serves a few repositories over plain HTTP and counts the
+// requests it gets.
+func newTestRegistry(t *testing.T) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var requests atomic.Int64
+	mux := http.NewServeMux()
+	// Reports the digest in a header, so a HEAD is enough
+	mux.HandleFunc("/v2/team/app/manifests/v1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Docker-Content-Digest", headDigest)
+	})
+	// Reports no digest, so the manifest is hashed
+	mux.HandleFunc("/v2/team/plain/manifests/latest", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(manifest))
+		}
+	})
+	// Requires a bearer token from /token
+	mux.HandleFunc("/v2/team/private/manifests/v1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer pull-token" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="http://`+r.Host+`/token",service="test-registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", privateDigest)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:team/private:pull" || r.URL.Query().Get("service") != "test-registry" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"token":"pull-token"}`))
+	})
+	mux.HandleFunc("/v2/team/denied/manifests/v1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestResolve(t *testing.T) {
+	srv, _ := newTestRegistry(t)
+	host := srv.Listener.Addr().String()
+	resolver := NewResolver(nil, []string{host})
+
+	sum := sha256.Sum256([]byte(manifest))
+	tests := []struct {
+		name       string
+		repository string
+		tag        string
+		want       string
+		err        error
+	}{
+		{"digest header", "team/app", "v1", headDigest, nil},
+		{"hashed manifest", "team/plain", "", "sha256:" + hex.EncodeToString(sum[:]), nil},
+		{"bearer token", "team/private", "v1", privateDigest, nil},
+		{"not found", "team/app", "v2", "", ErrNotFound},
+		{"forbidden", "team/denied", "v1", "", ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := imageref.Reference{Registry: host, Repository: tt.repository, Tag: tt.tag}
+			got, err := resolver.Resolve(context.Background(), ref)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Resolve(%s) error = %v, want %v", ref, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve(%s): %v", ref, err)
+			}
+			if got.Digest != tt.want || got.Tag != tt.tag || got.Repository != tt.repository {
+				t.Errorf("Resolve(%s) = %s, want digest %s with the tag kept", ref, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveLoopbackRegistries(t *testing.T) {
+	srv, requests := newTestRegistry(t)
+	host := srv.Listener.Addr().String()
+	port := host[strings.LastIndex(host, ":")+1:]
+
+	// Only the listed registry is insecure; the same server under other names is refused
+	resolver := NewResolver(nil, []string{"localhost:" + port})
+	for _, registry := range []string{host, "127.0.0.2:" + port, "[::1]:" + port, "app.localhost:" + port, "0.0.0.0:" + port} {
+		ref := imageref.Reference{Registry: registry, Repository: "team/app", Tag: "v1"}
+		if _, err := resolver.Resolve(context.Background(), ref); !errors.Is(err, ErrLoopbackRegistry) {
+			t.Errorf("Resolve(%s) error = %v, want ErrLoopbackRegistry", ref, err)
+		}
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("registry got %d requests, want none", n)
+	}
+
+	ref := imageref.Reference{Registry: "localhost:" + port, Repository: "team/app", Tag: "v1"}
+	got, err := resolver.Resolve(context.Background(), ref)
+	if err != nil || got.Digest != headDigest {
+		t.Errorf("Resolve(%s) = %s, %v, want digest %s", ref, got, err, headDigest)
+	}
+}
+
+func TestResolvePinned(t *testing.T) {
+	srv, requests := newTestRegistry(t)
+	ref := imageref.Reference{Registry: srv.Listener.Addr().String(), Repository: "team/app", Digest: headDigest}
+
+	got, err := NewResolver(nil, nil).Resolve(context.Background(), ref)
+	if err != nil || got != ref {
+		t.Errorf("Resolve(%s) = %s, %v, want it unchanged", ref, got, err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("registry got %d requests, want none", n)
+	}
+}
+
+func TestIsLoopback(t *testing.T) {
+	tests := []struct {
+		registry string
+		want     bool
+	}{
+		{"localhost", true},
+		{"localhost:5000", true},
+		{"LOCALHOST.:5000", true},
+		{"registry.localhost", true},
+		{"127.0.0.1:5000", true},
+		{"[::1]:5000", true},
+		{"[::ffff:127.0.0.1]:5000", true},
+		{"0.0.0.0:5000", true},
+		{"docker.io", false},
+		{"asia-northeast1-docker.pkg.dev", false},
+		{"10.0.0.5:5000", false},
+	}
+	for _, tt := range tests {
+		if got := isLoopback(tt.registry); got != tt.want {
+			t.Errorf("isLoopback(%s) = %v, want %v", tt.registry, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// GCSStore lists objects in Cloud Storage.
+type GCSStore struct {
+	client *storage.Client
+}
+
+// NewGCSStore creates a store that uses Application Default Credentials.
+func NewGCSStore(ctx context.Context) (*GCSStore, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Cloud Storage client: %w", err)
+	}
+	return &GCSStore{client: client}, nil
+}
+
+func (s *GCSStore) List(ctx context.Context, bucket string, query Query) ([]Object, error) {
+	q := &storage.Query{Prefix: query.Prefix, Delimiter: query.Delimiter}
+	if err := q.SetAttrSelection([]string{"Name", "Size", "CRC32C", "Updated"}); err != nil {
+		return nil, err
+	}
+
+	var objects []Object
+	it := s.client.Bucket(bucket).Objects(ctx, q)
+	for query.Limit == 0 || len(objects) < query.Limit {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list gs://%s/%s: %w", bucket, query.Prefix, err)
+		}
+		if attrs.Name == "" {
+			// A prefix standing for the objects below the delimiter
+			continue
+		}
+		objects = append(objects, Object{
+			Bucket:  bucket,
+			Name:    attrs.Name,
+			Size:    attrs.Size,
+			CRC32C:  attrs.CRC32C,
+			Updated: attrs.Updated,
+		})
+	}
+	return objects, nil
+}
+
+func (s *GCSStore) Close() error {
+	return s.client.Close()
+}
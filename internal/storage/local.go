@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// LocalStore serves objects from a directory, for tests and local runs: the
+// object gs://bucket/a/b.csv is the file <root>/bucket/a/b.csv.
+type LocalStore struct {
+	root string
+}
+
+func NewLocalStore(root string) *LocalStore {
+	return &LocalStore{root: root}
+}
+
+func (s *LocalStore) List(ctx context.Context, bucket string, query Query) ([]Object, error) {
+	bucketDir := filepath.Join(s.root, bucket)
+	prefix := query.Prefix
+	// Only the directory the prefix ends in, and those below it, can match
+	dir := bucketDir
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = filepath.Join(bucketDir, filepath.FromSlash(prefix[:i]))
+	}
+
+	var objects []Object
+	err := filepath.WalkDir(dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if query.Limit > 0 && len(objects) == query.Limit {
+			return fs.SkipAll
+		}
+		if entry.IsDir() && file != dir && query.Delimiter == "/" {
+			// Everything below has a "/" after the prefix
+			return fs.SkipDir
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(bucketDir, file)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok || (query.Delimiter != "" && strings.Contains(rest, query.Delimiter)) {
+			return nil
+		}
+		object, err := localObject(file)
+		if err != nil {
+			return err
+		}
+		object.Bucket, object.Name = bucket, name
+		objects = append(objects, object)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gs://%s/%s: %w", bucket, prefix, err)
+	}
+	slices.SortFunc(objects, func(a, b Object) int { return strings.Compare(a.Name, b.Name) })
+	return objects, nil
+}
+
+// localObject reads a file's size, checksum and modification time.
+func localObject(file string) (Object, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return Object{}, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return Object{}, err
+	}
+	hash := crc32.New(castagnoli)
+	if _, err := io.Copy(hash, f); err != nil {
+		return Object{}, err
+	}
+	return Object{Size: info.Size(), CRC32C: hash.Sum32(), Updated: info.ModTime()}, nil
+}
+
+func (s *LocalStore) Close() error {
+	return nil
+}
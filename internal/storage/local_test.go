@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// writeObjects creates the named objects of bucket under root, each containing its name.
+func writeObjects(t *testing.T, root, bucket string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		file := filepath.Join(root, bucket, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLocalStoreList(t *testing.T) {
+	root := t.TempDir()
+	writeObjects(t, root, "outputs",
+		"t1/job/report.csv",
+		"t1/job/summary.csv",
+		"t1/job/logs/run.log",
+		"t1/job/logs/deep/trace.log",
+		"t1/jobs.txt",
+		"t2/job/report.csv",
+	)
+	writeObjects(t, root, "other", "t1/job/report.csv")
+	store := NewLocalStore(root)
+
+	tests := []struct {
+		name   string
+		bucket string
+		query  Query
+		want   []string
+	}{
+		{"prefix", "outputs", Query{Prefix: "t1/job/"},
+			[]string{"t1/job/logs/deep/trace.log", "t1/job/logs/run.log", "t1/job/report.csv", "t1/job/summary.csv"}},
+		{"delimiter", "outputs", Query{Prefix: "t1/job/", Delimiter: "/"},
+			[]string{"t1/job/report.csv", "t1/job/summary.csv"}},
+		{"partial name", "outputs", Query{Prefix: "t1/job"},
+			[]string{"t1/job/logs/deep/trace.log", "t1/job/logs/run.log", "t1/job/report.csv", "t1/job/summary.csv", "t1/jobs.txt"}},
+		{"partial name with delimiter", "outputs", Query{Prefix: "t1/job", Delimiter: "/"},
+			[]string{"t1/jobs.txt"}},
+		{"subdirectory", "outputs", Query{Prefix: "t1/job/logs/", Delimiter: "/"},
+			[]string{"t1/job/logs/run.log"}},
+		{"limit", "outputs", Query{Prefix: "t1/job/", Delimiter: "/", Limit: 1},
+			[]string{"t1/job/report.csv"}},
+		{"whole bucket", "other", Query{},
+			[]string{"t1/job/report.csv"}},
+		{"missing prefix", "outputs", Query{Prefix: "t3/"}, nil},
+		{"missing bucket", "missing", Query{Prefix: "t1/"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects, err := store.List(context.Background(), tt.bucket, tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, object := range objects {
+				if object.Bucket != tt.bucket {
+					t.Errorf("object %s is in bucket %s, want %s", object.Name, object.Bucket, tt.bucket)
+				}
+				got = append(got, object.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("List(%s, %+v) = %v, want %v", tt.bucket, tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalStoreObject(t *testing.T) {
+	root := t.TempDir()
+	writeObjects(t, root, "outputs", "t1/report.csv")
+
+	objects, err := NewLocalStore(root).List(context.Background(), "outputs", Query{Prefix: "t1/report.csv"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 1 {
+		t.Fatalf("got %d objects, want 1", len(objects))
+	}
+	object := objects[0]
+	content := []byte("t1/report.csv")
+	if object.Size != int64(len(content)) || object.CRC32C != crc32.Checksum(content, castagnoli) || object.Updated.IsZero() {
+		t.Errorf("object = %+v, want size %d and the file's CRC32C and time", object, len(content))
+	}
+	if object.URI() != "gs://outputs/t1/report.csv" {
+		t.Errorf("URI = %s, want gs://outputs/t1/report.csv", object.URI())
+	}
+}
@@ -0,0 +1,69 @@
+// Package storage reads the objects jobs write to Cloud Storage, so that the
+// workers can record a job's outputs as artifacts once it completes. Objects
+// are read through a Store: GCSStore in production, LocalStore for tests and
+// local runs.
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Store lists objects in buckets.
+type Store interface {
+	// List returns the objects in bucket that query selects, in name order.
+	// It stops listing once it has query.Limit of them.
+	List(ctx context.Context, bucket string, query Query) ([]Object, error)
+	Close() error
+}
+
+// Query selects the objects List returns.
+type Query struct {
+	Prefix string // Names start with this
+	// Delimiter, if set, leaves out objects whose names contain it after
+	// Prefix, so that only one "directory" is listed, not those below it
+	Delimiter string
+	Limit     int // Most objects returned; 0 is no limit
+}
+
+// Object is a stored object and what identifies its contents.
+type Object struct {
+	Bucket  string
+	Name    string
+	Size    int64
+	CRC32C  uint32
+	Updated time.Time
+}
+
+// URI returns the object's gs:// URI.
+func (o Object) URI() string {
+	return "gs://" + o.Bucket + "/" + o.Name
+}
+
+// Checksum returns the object's CRC32C as Cloud Storage reports it: the
+// big-endian bytes in base64, as in gcloud storage objects describe.
+func (o Object) Checksum() string {
+	return EncodeCRC32C(o.CRC32C)
+}
+
+// EncodeCRC32C encodes a CRC32C checksum as Cloud Storage does.
+func EncodeCRC32C(sum uint32) string {
+	return base64.StdEncoding.EncodeToString(binary.BigEndian.AppendUint32(nil, sum))
+}
+
+// ParseURI splits a gs://bucket/name URI. name may be empty.
+func ParseURI(uri string) (bucket, name string, err error) {
+	rest, ok := strings.CutPrefix(uri, "gs://")
+	if !ok {
+		return "", "", fmt.Errorf("%q is not a gs:// URI", uri)
+	}
+	bucket, name, _ = strings.Cut(rest, "/")
+	if bucket == "" {
+		return "", "", fmt.Errorf("%q has no bucket", uri)
+	}
+	return bucket, name, nil
+}
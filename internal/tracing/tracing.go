@@ -0,0 +1,91 @@
+// Package tracing configures OpenTelemetry tracing for the gateway and worker.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Supported exporters
+const (
+	ExporterNone   = "none"   // Tracing disabled
+	ExporterOTLP   = "otlp"   // OTLP over gRPC; configured with the standard OTEL_EXPORTER_OTLP_* env vars
+	ExporterStdout = "stdout" // Pretty-printed spans on stdout, for local testing
+)
+
+const instrumentationName = "github.com/alphauslabs/jennah"
+
+// Setup installs a global tracer provider for serviceName and W3C trace context
+// propagation. sampleRatio applies to new traces; spans with a sampled parent are
+// always recorded, so workers follow the gateway's decision. The returned function
+// flushes pending spans and must be called on shutdown.
+func Setup(ctx context.Context, serviceName, exporter string, sampleRatio float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var spanExporter sdktrace.SpanExporter
+	var err error
+	switch exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		spanExporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q: use %s, %s or %s", exporter, ExporterNone, ExporterOTLP, ExporterStdout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer used for Jennah's own spans.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// NewInterceptor traces connect RPCs and propagates trace context in request
+// headers. Set trustRemote only on servers whose callers are trusted, such as
+// workers behind the gateway, so their spans join the caller's trace instead of
+// starting a new one linked to it.
+func NewInterceptor(trustRemote bool) (connect.Interceptor, error) {
+	options := []otelconnect.Option{otelconnect.WithoutMetrics()}
+	if trustRemote {
+		options = append(options, otelconnect.WithTrustRemote())
+	}
+	interceptor, err := otelconnect.NewInterceptor(options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing interceptor: %w", err)
+	}
+	return interceptor, nil
+}
@@ -0,0 +1,82 @@
+// Package usage works out what jobs use and cost: the vCPUs and memory of the
+// Compute Engine machine types GCP Batch runs them on, and prices from a
+// configurable price table. See docs/usage.md.
+package usage
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Machine is the vCPUs and memory of a machine type.
+type Machine struct {
+	Vcpus     float64
+	MemoryGib float64
+}
+
+// sharedCore machine types get a fraction of a vCPU; they are billed for that
+// fraction.
+var sharedCore = map[string]Machine{
+	"e2-micro":  {Vcpus: 0.25, MemoryGib: 1},
+	"e2-small":  {Vcpus: 0.5, MemoryGib: 2},
+	"e2-medium": {Vcpus: 1, MemoryGib: 4},
+	"f1-micro":  {Vcpus: 0.2, MemoryGib: 0.6},
+	"g1-small":  {Vcpus: 0.5, MemoryGib: 1.7},
+}
+
+// gibPerVcpu is the memory per vCPU of each predefined machine class. Families
+// that differ from the common ratios are listed as "family-class".
+var gibPerVcpu = map[string]float64{
+	"standard":    4,
+	"highmem":     8,
+	"highcpu":     1,
+	"n1-standard": 3.75,
+	"n1-highmem":  6.5,
+	"n1-highcpu":  0.9,
+	"c2d-highcpu": 2,
+	"c3-highcpu":  2,
+	"c3d-highcpu": 2,
+	"c4-highcpu":  2,
+	"n4-highcpu":  2,
+}
+
+// ParseMachineType returns the shape of a predefined machine type such as
+// "n2-standard-4", a custom one such as "n2-custom-6-24576", or a shared-core
+// one such as "e2-small". ok is false for types it does not know, such as
+// accelerator-optimized ones.
+func ParseMachineType(machineType string) (m Machine, ok bool) {
+	if m, ok := sharedCore[machineType]; ok {
+		return m, true
+	}
+
+	parts := strings.Split(machineType, "-")
+	// Custom types: [family-]custom-<vCPUs>-<memory MiB>[-ext]
+	for i, part := range parts {
+		if part != "custom" || i+2 >= len(parts) {
+			continue
+		}
+		vcpus, err1 := strconv.Atoi(parts[i+1])
+		mib, err2 := strconv.Atoi(parts[i+2])
+		if err1 != nil || err2 != nil || vcpus <= 0 || mib <= 0 {
+			return Machine{}, false
+		}
+		return Machine{Vcpus: float64(vcpus), MemoryGib: float64(mib) / 1024}, true
+	}
+
+	// Predefined types: <family>-<class>-<vCPUs>
+	if len(parts) != 3 {
+		return Machine{}, false
+	}
+	vcpus, err := strconv.Atoi(parts[2])
+	if err != nil || vcpus <= 0 {
+		return Machine{}, false
+	}
+	ratio, ok := gibPerVcpu[parts[0]+"-"+parts[1]]
+	if !ok {
+		ratio, ok = gibPerVcpu[parts[1]]
+	}
+	if !ok {
+		return Machine{}, false
+	}
+	return Machine{Vcpus: float64(vcpus), MemoryGib: float64(vcpus) * ratio}, true
+}
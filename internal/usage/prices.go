@@ -0,0 +1,75 @@
+package usage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PriceTable prices vCPU and memory time by region and provisioning model.
+type PriceTable struct {
+	Currency string  `yaml:"currency"`
+	Prices   []Price `yaml:"prices"`
+}
+
+// Price is the hourly price of a vCPU and of a GiB of memory. An empty Region
+// or ProvisioningModel matches any.
+type Price struct {
+	Region            string  `yaml:"region,omitempty"`
+	ProvisioningModel string  `yaml:"provisioningModel,omitempty"`
+	VcpuHour          float64 `yaml:"vcpuHour"`
+	MemoryGibHour     float64 `yaml:"memoryGibHour"`
+}
+
+// provisioningModels are the GCP Batch provisioning models a price may be for.
+var provisioningModels = []string{"STANDARD", "SPOT", "PREEMPTIBLE"}
+
+// LoadPriceTable reads a YAML price table. Unknown fields are errors, so that
+// typos are not silently priced at zero.
+func LoadPriceTable(path string) (*PriceTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price table: %w", err)
+	}
+
+	var table PriceTable
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&table); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse price table: %w", err)
+	}
+	if table.Currency == "" {
+		return nil, errors.New("price table has no currency")
+	}
+	for i, price := range table.Prices {
+		if price.ProvisioningModel != "" && !slices.Contains(provisioningModels, price.ProvisioningModel) {
+			return nil, fmt.Errorf("prices[%d]: unknown provisioningModel %q; must be one of %v", i, price.ProvisioningModel, provisioningModels)
+		}
+		if price.VcpuHour < 0 || price.MemoryGibHour < 0 {
+			return nil, fmt.Errorf("prices[%d]: prices must not be negative", i)
+		}
+	}
+	return &table, nil
+}
+
+// Cost estimates the cost of vCPU and memory time in a region under a
+// provisioning model, using the first matching price, so specific prices must
+// come before general ones. ok is false if no price matches. A nil table
+// prices nothing.
+func (t *PriceTable) Cost(region, provisioningModel string, vcpuSeconds, memoryGibSeconds float64) (cost float64, ok bool) {
+	if t == nil {
+		return 0, false
+	}
+	for _, price := range t.Prices {
+		if (price.Region == "" || price.Region == region) &&
+			(price.ProvisioningModel == "" || price.ProvisioningModel == provisioningModel) {
+			return (vcpuSeconds*price.VcpuHour + memoryGibSeconds*price.MemoryGibHour) / 3600, true
+		}
+	}
+	return 0, false
+}